@@ -0,0 +1,270 @@
+// Package academicstest provides in-process fake of gRPC academics
+// service for integration tests of clients.
+package academicstest
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/academics/pb"
+)
+
+const listenerBufferSize = 1 << 20
+
+// Server is fake academics service served over in-memory listener,
+// so tests need neither network nor real service. Academics are set
+// with With* methods, which can be called while server is serving.
+type Server struct {
+	pb.UnimplementedAcademicsServiceServer
+
+	mu        sync.RWMutex
+	teachers  map[string]bool
+	students  map[string]bool
+	groups    map[string][]string
+	fullNames map[string]string
+	emails    map[string]string
+	failure   error
+
+	listener *bufconn.Listener
+	server   *grpc.Server
+}
+
+// NewServer starts serving fake academics service,
+// server should be closed after test.
+func NewServer() *Server {
+	s := &Server{
+		teachers:  make(map[string]bool),
+		students:  make(map[string]bool),
+		groups:    make(map[string][]string),
+		fullNames: make(map[string]string),
+		emails:    make(map[string]string),
+		listener:  bufconn.Listen(listenerBufferSize),
+		server:    grpc.NewServer(),
+	}
+
+	pb.RegisterAcademicsServiceServer(s.server, s)
+
+	go func() {
+		_ = s.server.Serve(s.listener)
+	}()
+
+	return s
+}
+
+// Dial connects client to server over in-memory listener.
+func (s *Server) Dial(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(
+		ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+func (s *Server) Close() {
+	s.server.Stop()
+}
+
+func (s *Server) WithTeachers(teacherIDs ...string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range teacherIDs {
+		s.teachers[t] = true
+	}
+
+	return s
+}
+
+func (s *Server) WithStudents(studentIDs ...string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, st := range studentIDs {
+		s.students[st] = true
+	}
+
+	return s
+}
+
+// WithFullNames sets full names of academics returned in their profiles.
+func (s *Server) WithFullNames(fullNames map[string]string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, name := range fullNames {
+		s.fullNames[id] = name
+	}
+
+	return s
+}
+
+// WithGroupStudents puts students to group, group and students become existing.
+func (s *Server) WithGroupStudents(groupID string, studentIDs ...string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups[groupID] = studentIDs
+
+	for _, st := range studentIDs {
+		s.students[st] = true
+	}
+
+	return s
+}
+
+// WithStudentEmail sets email of student, student becomes existing.
+func (s *Server) WithStudentEmail(studentID, email string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.students[studentID] = true
+	s.emails[email] = studentID
+
+	return s
+}
+
+// FailWith makes every call fail with given gRPC code,
+// codes.OK makes server answer again.
+func (s *Server) FailWith(code codes.Code) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failure = nil
+	if code != codes.OK {
+		s.failure = status.Error(code, "academics service fails")
+	}
+
+	return s
+}
+
+func (s *Server) TeacherExists(_ context.Context, req *pb.TeacherExistsRequest) (*pb.ExistenceResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	return &pb.ExistenceResponse{Exists: s.teachers[req.GetTeacherId()]}, nil
+}
+
+func (s *Server) StudentExists(_ context.Context, req *pb.StudentExistsRequest) (*pb.ExistenceResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	return &pb.ExistenceResponse{Exists: s.students[req.GetStudentId()]}, nil
+}
+
+func (s *Server) MissingTeachers(
+	_ context.Context,
+	req *pb.MissingTeachersRequest,
+) (*pb.MissingAcademicsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	return &pb.MissingAcademicsResponse{MissingIds: missing(s.teachers, req.GetTeacherIds())}, nil
+}
+
+func (s *Server) MissingStudents(
+	_ context.Context,
+	req *pb.MissingStudentsRequest,
+) (*pb.MissingAcademicsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	return &pb.MissingAcademicsResponse{MissingIds: missing(s.students, req.GetStudentIds())}, nil
+}
+
+func missing(existing map[string]bool, ids []string) []string {
+	var missingIDs []string
+
+	for _, id := range ids {
+		if !existing[id] {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return missingIDs
+}
+
+func (s *Server) GroupExists(_ context.Context, req *pb.GroupExistsRequest) (*pb.ExistenceResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	_, ok := s.groups[req.GetGroupId()]
+
+	return &pb.ExistenceResponse{Exists: ok}, nil
+}
+
+func (s *Server) AcademicProfiles(
+	_ context.Context,
+	req *pb.AcademicProfilesRequest,
+) (*pb.AcademicProfilesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	resp := &pb.AcademicProfilesResponse{}
+
+	for _, id := range req.GetAcademicIds() {
+		if s.teachers[id] || s.students[id] {
+			resp.Profiles = append(resp.Profiles, &pb.AcademicProfile{Id: id, FullName: s.fullNames[id]})
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GroupStudents(_ context.Context, req *pb.GroupStudentsRequest) (*pb.GroupStudentsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	studentIDs, ok := s.groups[req.GetGroupId()]
+
+	return &pb.GroupStudentsResponse{Exists: ok, StudentIds: studentIDs}, nil
+}
+
+func (s *Server) StudentByEmail(_ context.Context, req *pb.StudentByEmailRequest) (*pb.StudentByEmailResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.failure != nil {
+		return nil, s.failure
+	}
+
+	studentID, ok := s.emails[req.GetEmail()]
+
+	return &pb.StudentByEmailResponse{Exists: ok, StudentId: studentID}, nil
+}
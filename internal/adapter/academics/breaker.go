@@ -0,0 +1,117 @@
+package academics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+type BreakerState uint8
+
+const (
+	BreakerClosed BreakerState = iota + 1
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+
+	return "%!BreakerState(" + strconv.Itoa(int(s)) + ")"
+}
+
+// MarshalText makes state readable in published metrics.
+func (s BreakerState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+type callOutcome uint8
+
+const (
+	callSucceeded callOutcome = iota + 1
+	callFailed
+	callAbandoned
+)
+
+// breaker opens after threshold failed calls in a row and rejects calls
+// until cooldown passes. Then single trial call is let through, breaker
+// is closed if it succeeds and opened again if it fails.
+type breaker struct {
+	mu        sync.Mutex
+	clock     Clock
+	threshold int
+	cooldown  time.Duration
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	openings  uint64
+}
+
+func newBreaker(clock Clock, threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		clock:     clock,
+		threshold: threshold,
+		cooldown:  cooldown,
+		state:     BreakerClosed,
+	}
+}
+
+// allow reports whether call can be made, every allowed call
+// should be finished with done.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerClosed:
+		return true
+	case BreakerOpen:
+		if b.clock.Now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+
+		b.state = BreakerHalfOpen
+
+		return true
+	}
+
+	return false
+}
+
+// done records outcome of allowed call. Abandoned call says nothing
+// about service, so trial call abandoned by caller is let through again.
+func (b *breaker) done(outcome callOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch outcome {
+	case callSucceeded:
+		b.state = BreakerClosed
+		b.failures = 0
+	case callFailed:
+		b.failures++
+		if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+			b.state = BreakerOpen
+			b.openedAt = b.clock.Now()
+			b.openings++
+		}
+	case callAbandoned:
+		if b.state == BreakerHalfOpen {
+			b.state = BreakerOpen
+		}
+	}
+}
+
+func (b *breaker) snapshot() (BreakerState, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state, b.openings
+}
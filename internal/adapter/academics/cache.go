@@ -0,0 +1,120 @@
+package academics
+
+import (
+	"sync"
+	"time"
+)
+
+// cache keeps answers of academics service by key. Entry of academic
+// that isn't found expires after negative TTL, expired entry is kept
+// for stale TTL to be used while service fails.
+type cache struct {
+	mu          sync.Mutex
+	clock       Clock
+	ttl         time.Duration
+	negativeTTL time.Duration
+	staleTTL    time.Duration
+	maxEntries  int
+	entries     map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value      interface{}
+	found      bool
+	expiresAt  time.Time
+	staleUntil time.Time
+}
+
+func newCache(clock Clock, ttl, negativeTTL, staleTTL time.Duration, maxEntries int) *cache {
+	return &cache{
+		clock:       clock,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		staleTTL:    staleTTL,
+		maxEntries:  maxEntries,
+		entries:     make(map[string]cacheEntry),
+	}
+}
+
+func (c *cache) fresh(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.clock.Now().Before(e.expiresAt) {
+		return cacheEntry{}, false
+	}
+
+	return e, true
+}
+
+// stale returns entry that is either fresh or expired not long ago.
+func (c *cache) stale(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.clock.Now().Before(e.staleUntil) {
+		return cacheEntry{}, false
+	}
+
+	return e, true
+}
+
+func (c *cache) put(key string, value interface{}, found bool) {
+	ttl := c.ttl
+	if !found {
+		ttl = c.negativeTTL
+	}
+
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+
+	c.entries[key] = cacheEntry{
+		value:      value,
+		found:      found,
+		expiresAt:  now.Add(ttl),
+		staleUntil: now.Add(ttl + c.staleTTL),
+	}
+}
+
+// removeIf removes fresh and stale entries that match.
+func (c *cache) removeIf(match func(key string, e cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if match(key, e) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// evict removes entries that can't be used anymore, when
+// there are no such entries one arbitrary entry is removed.
+func (c *cache) evict(now time.Time) {
+	for key, e := range c.entries {
+		if !now.Before(e.staleUntil) {
+			delete(c.entries, key)
+		}
+	}
+
+	if len(c.entries) < c.maxEntries {
+		return
+	}
+
+	for key := range c.entries {
+		delete(c.entries, key)
+
+		return
+	}
+}
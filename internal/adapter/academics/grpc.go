@@ -0,0 +1,151 @@
+package academics
+
+import (
+	"context"
+	"time"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/academics/pb"
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// GRPCService is client of academics service over gRPC, every call
+// is limited by request timeout. Failed calls are wrapped into
+// app.ErrAcademicsServiceProblems, so they aren't confused with
+// academics that don't exist.
+type GRPCService struct {
+	client  pb.AcademicsServiceClient
+	timeout time.Duration
+}
+
+func NewGRPCService(client pb.AcademicsServiceClient, timeout time.Duration) *GRPCService {
+	if client == nil {
+		panic("client is nil")
+	}
+
+	if timeout <= 0 {
+		panic("timeout isn't positive")
+	}
+
+	return &GRPCService{client: client, timeout: timeout}
+}
+
+func (s *GRPCService) TeacherExists(ctx context.Context, teacherID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.TeacherExists(ctx, &pb.TeacherExistsRequest{TeacherId: teacherID})
+	if err != nil {
+		return app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	if !resp.GetExists() {
+		return app.ErrTeacherDoesntExist
+	}
+
+	return nil
+}
+
+func (s *GRPCService) StudentExists(ctx context.Context, studentID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.StudentExists(ctx, &pb.StudentExistsRequest{StudentId: studentID})
+	if err != nil {
+		return app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	if !resp.GetExists() {
+		return app.ErrStudentDoesntExist
+	}
+
+	return nil
+}
+
+func (s *GRPCService) MissingTeachers(ctx context.Context, teacherIDs []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.MissingTeachers(ctx, &pb.MissingTeachersRequest{TeacherIds: teacherIDs})
+	if err != nil {
+		return nil, app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	return resp.GetMissingIds(), nil
+}
+
+func (s *GRPCService) MissingStudents(ctx context.Context, studentIDs []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.MissingStudents(ctx, &pb.MissingStudentsRequest{StudentIds: studentIDs})
+	if err != nil {
+		return nil, app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	return resp.GetMissingIds(), nil
+}
+
+func (s *GRPCService) StudentByEmail(ctx context.Context, email string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.StudentByEmail(ctx, &pb.StudentByEmailRequest{Email: email})
+	if err != nil {
+		return "", app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	if !resp.GetExists() {
+		return "", app.ErrStudentDoesntExist
+	}
+
+	return resp.GetStudentId(), nil
+}
+
+func (s *GRPCService) GroupExists(ctx context.Context, groupID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.GroupExists(ctx, &pb.GroupExistsRequest{GroupId: groupID})
+	if err != nil {
+		return app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	if !resp.GetExists() {
+		return app.ErrGroupDoesntExist
+	}
+
+	return nil
+}
+
+func (s *GRPCService) GroupStudents(ctx context.Context, groupID string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.GroupStudents(ctx, &pb.GroupStudentsRequest{GroupId: groupID})
+	if err != nil {
+		return nil, app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	if !resp.GetExists() {
+		return nil, app.ErrGroupDoesntExist
+	}
+
+	return resp.GetStudentIds(), nil
+}
+
+func (s *GRPCService) AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := s.client.AcademicProfiles(ctx, &pb.AcademicProfilesRequest{AcademicIds: academicIDs})
+	if err != nil {
+		return nil, app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	profiles := make([]app.AcademicProfile, 0, len(resp.GetProfiles()))
+	for _, p := range resp.GetProfiles() {
+		profiles = append(profiles, app.AcademicProfile{ID: p.GetId(), FullName: p.GetFullName()})
+	}
+
+	return profiles, nil
+}
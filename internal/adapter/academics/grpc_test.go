@@ -0,0 +1,145 @@
+package academics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/academics"
+	"github.com/authena-ru/courses-organization/internal/adapter/academics/academicstest"
+	"github.com/authena-ru/courses-organization/internal/adapter/academics/pb"
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+const (
+	teacherID = "d3e2490f-5944-4a87-b29a-94177d1caaed"
+	studentID = "798155cb-91b7-41d4-9f91-a1970339707e"
+	groupID   = "95dca190-f307-4954-8700-f992f8c12a86"
+	unknownID = "2f1cd4a8-7bb4-4b2d-92b3-0a4e9f0d6c11"
+)
+
+func newGRPCService(t *testing.T) (*academics.GRPCService, *academicstest.Server) {
+	t.Helper()
+
+	server := academicstest.NewServer().
+		WithTeachers(teacherID).
+		WithGroupStudents(groupID, studentID).
+		WithStudentEmail(studentID, "sergey@authena.ru").
+		WithFullNames(map[string]string{teacherID: "Ivan Petrov", studentID: "Sergey Ivanov"})
+	t.Cleanup(server.Close)
+
+	conn, err := server.Dial(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return academics.NewGRPCService(pb.NewAcademicsServiceClient(conn), time.Second), server
+}
+
+func TestGRPCService_TeacherExists(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	require.NoError(t, service.TeacherExists(context.Background(), teacherID))
+	require.ErrorIs(t, service.TeacherExists(context.Background(), studentID), app.ErrTeacherDoesntExist)
+}
+
+func TestGRPCService_StudentExists(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	require.NoError(t, service.StudentExists(context.Background(), studentID))
+	require.ErrorIs(t, service.StudentExists(context.Background(), teacherID), app.ErrStudentDoesntExist)
+}
+
+func TestGRPCService_MissingTeachers(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	missingIDs, err := service.MissingTeachers(context.Background(), []string{teacherID, unknownID, studentID})
+	require.NoError(t, err)
+	require.Equal(t, []string{unknownID, studentID}, missingIDs)
+}
+
+func TestGRPCService_MissingStudents(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	missingIDs, err := service.MissingStudents(context.Background(), []string{studentID})
+	require.NoError(t, err)
+	require.Empty(t, missingIDs)
+
+	missingIDs, err = service.MissingStudents(context.Background(), []string{teacherID, studentID})
+	require.NoError(t, err)
+	require.Equal(t, []string{teacherID}, missingIDs)
+}
+
+func TestGRPCService_StudentByEmail(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	id, err := service.StudentByEmail(context.Background(), "sergey@authena.ru")
+	require.NoError(t, err)
+	require.Equal(t, studentID, id)
+
+	_, err = service.StudentByEmail(context.Background(), "unknown@authena.ru")
+	require.ErrorIs(t, err, app.ErrStudentDoesntExist)
+}
+
+func TestGRPCService_GroupExists(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	require.NoError(t, service.GroupExists(context.Background(), groupID))
+	require.ErrorIs(t, service.GroupExists(context.Background(), unknownID), app.ErrGroupDoesntExist)
+}
+
+func TestGRPCService_GroupStudents(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	studentIDs, err := service.GroupStudents(context.Background(), groupID)
+	require.NoError(t, err)
+	require.Equal(t, []string{studentID}, studentIDs)
+
+	_, err = service.GroupStudents(context.Background(), unknownID)
+	require.ErrorIs(t, err, app.ErrGroupDoesntExist)
+}
+
+func TestGRPCService_AcademicProfiles(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newGRPCService(t)
+
+	profiles, err := service.AcademicProfiles(context.Background(), []string{teacherID, unknownID, studentID})
+	require.NoError(t, err)
+	require.Equal(t, []app.AcademicProfile{
+		{ID: teacherID, FullName: "Ivan Petrov"},
+		{ID: studentID, FullName: "Sergey Ivanov"},
+	}, profiles)
+}
+
+func TestGRPCService_service_problems(t *testing.T) {
+	t.Parallel()
+
+	service, server := newGRPCService(t)
+	server.FailWith(codes.Unavailable)
+
+	err := service.TeacherExists(context.Background(), teacherID)
+	require.Error(t, err)
+	require.Equal(t, app.ErrAcademicsServiceProblems, errors.Cause(err))
+	require.NotErrorIs(t, err, app.ErrTeacherDoesntExist)
+
+	_, err = service.AcademicProfiles(context.Background(), []string{teacherID})
+	require.Equal(t, app.ErrAcademicsServiceProblems, errors.Cause(err))
+}
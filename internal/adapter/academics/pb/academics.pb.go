@@ -0,0 +1,1063 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: academics.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeacherExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+}
+
+func (x *TeacherExistsRequest) Reset() {
+	*x = TeacherExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeacherExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherExistsRequest) ProtoMessage() {}
+
+func (x *TeacherExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherExistsRequest.ProtoReflect.Descriptor instead.
+func (*TeacherExistsRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeacherExistsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type StudentExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *StudentExistsRequest) Reset() {
+	*x = StudentExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentExistsRequest) ProtoMessage() {}
+
+func (x *StudentExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentExistsRequest.ProtoReflect.Descriptor instead.
+func (*StudentExistsRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StudentExistsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type MissingTeachersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeacherIds []string `protobuf:"bytes,1,rep,name=teacher_ids,json=teacherIds,proto3" json:"teacher_ids,omitempty"`
+}
+
+func (x *MissingTeachersRequest) Reset() {
+	*x = MissingTeachersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingTeachersRequest) ProtoMessage() {}
+
+func (x *MissingTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingTeachersRequest.ProtoReflect.Descriptor instead.
+func (*MissingTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MissingTeachersRequest) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+type MissingStudentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentIds []string `protobuf:"bytes,1,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+}
+
+func (x *MissingStudentsRequest) Reset() {
+	*x = MissingStudentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingStudentsRequest) ProtoMessage() {}
+
+func (x *MissingStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingStudentsRequest.ProtoReflect.Descriptor instead.
+func (*MissingStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MissingStudentsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type MissingAcademicsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissingIds []string `protobuf:"bytes,1,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+}
+
+func (x *MissingAcademicsResponse) Reset() {
+	*x = MissingAcademicsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingAcademicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingAcademicsResponse) ProtoMessage() {}
+
+func (x *MissingAcademicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingAcademicsResponse.ProtoReflect.Descriptor instead.
+func (*MissingAcademicsResponse) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MissingAcademicsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type GroupExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GroupExistsRequest) Reset() {
+	*x = GroupExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupExistsRequest) ProtoMessage() {}
+
+func (x *GroupExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupExistsRequest.ProtoReflect.Descriptor instead.
+func (*GroupExistsRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GroupExistsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ExistenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *ExistenceResponse) Reset() {
+	*x = ExistenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistenceResponse) ProtoMessage() {}
+
+func (x *ExistenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistenceResponse.ProtoReflect.Descriptor instead.
+func (*ExistenceResponse) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExistenceResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type AcademicProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcademicIds []string `protobuf:"bytes,1,rep,name=academic_ids,json=academicIds,proto3" json:"academic_ids,omitempty"`
+}
+
+func (x *AcademicProfilesRequest) Reset() {
+	*x = AcademicProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcademicProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicProfilesRequest) ProtoMessage() {}
+
+func (x *AcademicProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicProfilesRequest.ProtoReflect.Descriptor instead.
+func (*AcademicProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcademicProfilesRequest) GetAcademicIds() []string {
+	if x != nil {
+		return x.AcademicIds
+	}
+	return nil
+}
+
+type AcademicProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName string `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+}
+
+func (x *AcademicProfile) Reset() {
+	*x = AcademicProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcademicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicProfile) ProtoMessage() {}
+
+func (x *AcademicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicProfile.ProtoReflect.Descriptor instead.
+func (*AcademicProfile) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcademicProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcademicProfile) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+type AcademicProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*AcademicProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *AcademicProfilesResponse) Reset() {
+	*x = AcademicProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcademicProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicProfilesResponse) ProtoMessage() {}
+
+func (x *AcademicProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicProfilesResponse.ProtoReflect.Descriptor instead.
+func (*AcademicProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcademicProfilesResponse) GetProfiles() []*AcademicProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type GroupStudentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GroupStudentsRequest) Reset() {
+	*x = GroupStudentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStudentsRequest) ProtoMessage() {}
+
+func (x *GroupStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GroupStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GroupStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GroupStudentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists     bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	StudentIds []string `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+}
+
+func (x *GroupStudentsResponse) Reset() {
+	*x = GroupStudentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStudentsResponse) ProtoMessage() {}
+
+func (x *GroupStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupStudentsResponse.ProtoReflect.Descriptor instead.
+func (*GroupStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GroupStudentsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GroupStudentsResponse) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type StudentByEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *StudentByEmailRequest) Reset() {
+	*x = StudentByEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentByEmailRequest) ProtoMessage() {}
+
+func (x *StudentByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentByEmailRequest.ProtoReflect.Descriptor instead.
+func (*StudentByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StudentByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type StudentByEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists    bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	StudentId string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *StudentByEmailResponse) Reset() {
+	*x = StudentByEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_academics_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentByEmailResponse) ProtoMessage() {}
+
+func (x *StudentByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academics_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentByEmailResponse.ProtoReflect.Descriptor instead.
+func (*StudentByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_academics_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StudentByEmailResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *StudentByEmailResponse) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+var File_academics_proto protoreflect.FileDescriptor
+
+var file_academics_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x22, 0x35, 0x0a, 0x14,
+	0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x16, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73,
+	0x22, 0x3b, 0x0a, 0x18, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x2f, 0x0a,
+	0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x2b,
+	0x0a, 0x11, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x0f, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x41, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x31, 0x0a,
+	0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x22, 0x50, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x4f, 0x0a, 0x16, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x32, 0xcc, 0x05, 0x0a, 0x10, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x54, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65,
+	0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x63, 0x61, 0x64,
+	0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x61,
+	0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x79,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x61, 0x2d, 0x72, 0x75, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2f, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_academics_proto_rawDescOnce sync.Once
+	file_academics_proto_rawDescData = file_academics_proto_rawDesc
+)
+
+func file_academics_proto_rawDescGZIP() []byte {
+	file_academics_proto_rawDescOnce.Do(func() {
+		file_academics_proto_rawDescData = protoimpl.X.CompressGZIP(file_academics_proto_rawDescData)
+	})
+	return file_academics_proto_rawDescData
+}
+
+var file_academics_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_academics_proto_goTypes = []interface{}{
+	(*TeacherExistsRequest)(nil),     // 0: academics.TeacherExistsRequest
+	(*StudentExistsRequest)(nil),     // 1: academics.StudentExistsRequest
+	(*MissingTeachersRequest)(nil),   // 2: academics.MissingTeachersRequest
+	(*MissingStudentsRequest)(nil),   // 3: academics.MissingStudentsRequest
+	(*MissingAcademicsResponse)(nil), // 4: academics.MissingAcademicsResponse
+	(*GroupExistsRequest)(nil),       // 5: academics.GroupExistsRequest
+	(*ExistenceResponse)(nil),        // 6: academics.ExistenceResponse
+	(*AcademicProfilesRequest)(nil),  // 7: academics.AcademicProfilesRequest
+	(*AcademicProfile)(nil),          // 8: academics.AcademicProfile
+	(*AcademicProfilesResponse)(nil), // 9: academics.AcademicProfilesResponse
+	(*GroupStudentsRequest)(nil),     // 10: academics.GroupStudentsRequest
+	(*GroupStudentsResponse)(nil),    // 11: academics.GroupStudentsResponse
+	(*StudentByEmailRequest)(nil),    // 12: academics.StudentByEmailRequest
+	(*StudentByEmailResponse)(nil),   // 13: academics.StudentByEmailResponse
+}
+var file_academics_proto_depIdxs = []int32{
+	8,  // 0: academics.AcademicProfilesResponse.profiles:type_name -> academics.AcademicProfile
+	0,  // 1: academics.AcademicsService.TeacherExists:input_type -> academics.TeacherExistsRequest
+	1,  // 2: academics.AcademicsService.StudentExists:input_type -> academics.StudentExistsRequest
+	2,  // 3: academics.AcademicsService.MissingTeachers:input_type -> academics.MissingTeachersRequest
+	3,  // 4: academics.AcademicsService.MissingStudents:input_type -> academics.MissingStudentsRequest
+	5,  // 5: academics.AcademicsService.GroupExists:input_type -> academics.GroupExistsRequest
+	7,  // 6: academics.AcademicsService.AcademicProfiles:input_type -> academics.AcademicProfilesRequest
+	10, // 7: academics.AcademicsService.GroupStudents:input_type -> academics.GroupStudentsRequest
+	12, // 8: academics.AcademicsService.StudentByEmail:input_type -> academics.StudentByEmailRequest
+	6,  // 9: academics.AcademicsService.TeacherExists:output_type -> academics.ExistenceResponse
+	6,  // 10: academics.AcademicsService.StudentExists:output_type -> academics.ExistenceResponse
+	4,  // 11: academics.AcademicsService.MissingTeachers:output_type -> academics.MissingAcademicsResponse
+	4,  // 12: academics.AcademicsService.MissingStudents:output_type -> academics.MissingAcademicsResponse
+	6,  // 13: academics.AcademicsService.GroupExists:output_type -> academics.ExistenceResponse
+	9,  // 14: academics.AcademicsService.AcademicProfiles:output_type -> academics.AcademicProfilesResponse
+	11, // 15: academics.AcademicsService.GroupStudents:output_type -> academics.GroupStudentsResponse
+	13, // 16: academics.AcademicsService.StudentByEmail:output_type -> academics.StudentByEmailResponse
+	9,  // [9:17] is the sub-list for method output_type
+	1,  // [1:9] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_academics_proto_init() }
+func file_academics_proto_init() {
+	if File_academics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_academics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeacherExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingTeachersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingStudentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingAcademicsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcademicProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcademicProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcademicProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupStudentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupStudentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentByEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_academics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentByEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_academics_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_academics_proto_goTypes,
+		DependencyIndexes: file_academics_proto_depIdxs,
+		MessageInfos:      file_academics_proto_msgTypes,
+	}.Build()
+	File_academics_proto = out.File
+	file_academics_proto_rawDesc = nil
+	file_academics_proto_goTypes = nil
+	file_academics_proto_depIdxs = nil
+}
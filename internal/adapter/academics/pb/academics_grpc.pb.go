@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: academics.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AcademicsServiceClient is the client API for AcademicsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AcademicsServiceClient interface {
+	TeacherExists(ctx context.Context, in *TeacherExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error)
+	StudentExists(ctx context.Context, in *StudentExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error)
+	MissingTeachers(ctx context.Context, in *MissingTeachersRequest, opts ...grpc.CallOption) (*MissingAcademicsResponse, error)
+	MissingStudents(ctx context.Context, in *MissingStudentsRequest, opts ...grpc.CallOption) (*MissingAcademicsResponse, error)
+	GroupExists(ctx context.Context, in *GroupExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error)
+	AcademicProfiles(ctx context.Context, in *AcademicProfilesRequest, opts ...grpc.CallOption) (*AcademicProfilesResponse, error)
+	GroupStudents(ctx context.Context, in *GroupStudentsRequest, opts ...grpc.CallOption) (*GroupStudentsResponse, error)
+	StudentByEmail(ctx context.Context, in *StudentByEmailRequest, opts ...grpc.CallOption) (*StudentByEmailResponse, error)
+}
+
+type academicsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAcademicsServiceClient(cc grpc.ClientConnInterface) AcademicsServiceClient {
+	return &academicsServiceClient{cc}
+}
+
+func (c *academicsServiceClient) TeacherExists(ctx context.Context, in *TeacherExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error) {
+	out := new(ExistenceResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/TeacherExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) StudentExists(ctx context.Context, in *StudentExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error) {
+	out := new(ExistenceResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/StudentExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) MissingTeachers(ctx context.Context, in *MissingTeachersRequest, opts ...grpc.CallOption) (*MissingAcademicsResponse, error) {
+	out := new(MissingAcademicsResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/MissingTeachers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) MissingStudents(ctx context.Context, in *MissingStudentsRequest, opts ...grpc.CallOption) (*MissingAcademicsResponse, error) {
+	out := new(MissingAcademicsResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/MissingStudents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) GroupExists(ctx context.Context, in *GroupExistsRequest, opts ...grpc.CallOption) (*ExistenceResponse, error) {
+	out := new(ExistenceResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/GroupExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) AcademicProfiles(ctx context.Context, in *AcademicProfilesRequest, opts ...grpc.CallOption) (*AcademicProfilesResponse, error) {
+	out := new(AcademicProfilesResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/AcademicProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) GroupStudents(ctx context.Context, in *GroupStudentsRequest, opts ...grpc.CallOption) (*GroupStudentsResponse, error) {
+	out := new(GroupStudentsResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/GroupStudents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicsServiceClient) StudentByEmail(ctx context.Context, in *StudentByEmailRequest, opts ...grpc.CallOption) (*StudentByEmailResponse, error) {
+	out := new(StudentByEmailResponse)
+	err := c.cc.Invoke(ctx, "/academics.AcademicsService/StudentByEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AcademicsServiceServer is the server API for AcademicsService service.
+// All implementations must embed UnimplementedAcademicsServiceServer
+// for forward compatibility
+type AcademicsServiceServer interface {
+	TeacherExists(context.Context, *TeacherExistsRequest) (*ExistenceResponse, error)
+	StudentExists(context.Context, *StudentExistsRequest) (*ExistenceResponse, error)
+	MissingTeachers(context.Context, *MissingTeachersRequest) (*MissingAcademicsResponse, error)
+	MissingStudents(context.Context, *MissingStudentsRequest) (*MissingAcademicsResponse, error)
+	GroupExists(context.Context, *GroupExistsRequest) (*ExistenceResponse, error)
+	AcademicProfiles(context.Context, *AcademicProfilesRequest) (*AcademicProfilesResponse, error)
+	GroupStudents(context.Context, *GroupStudentsRequest) (*GroupStudentsResponse, error)
+	StudentByEmail(context.Context, *StudentByEmailRequest) (*StudentByEmailResponse, error)
+	mustEmbedUnimplementedAcademicsServiceServer()
+}
+
+// UnimplementedAcademicsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAcademicsServiceServer struct {
+}
+
+func (UnimplementedAcademicsServiceServer) TeacherExists(context.Context, *TeacherExistsRequest) (*ExistenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeacherExists not implemented")
+}
+func (UnimplementedAcademicsServiceServer) StudentExists(context.Context, *StudentExistsRequest) (*ExistenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StudentExists not implemented")
+}
+func (UnimplementedAcademicsServiceServer) MissingTeachers(context.Context, *MissingTeachersRequest) (*MissingAcademicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissingTeachers not implemented")
+}
+func (UnimplementedAcademicsServiceServer) MissingStudents(context.Context, *MissingStudentsRequest) (*MissingAcademicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissingStudents not implemented")
+}
+func (UnimplementedAcademicsServiceServer) GroupExists(context.Context, *GroupExistsRequest) (*ExistenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExists not implemented")
+}
+func (UnimplementedAcademicsServiceServer) AcademicProfiles(context.Context, *AcademicProfilesRequest) (*AcademicProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcademicProfiles not implemented")
+}
+func (UnimplementedAcademicsServiceServer) GroupStudents(context.Context, *GroupStudentsRequest) (*GroupStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupStudents not implemented")
+}
+func (UnimplementedAcademicsServiceServer) StudentByEmail(context.Context, *StudentByEmailRequest) (*StudentByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StudentByEmail not implemented")
+}
+func (UnimplementedAcademicsServiceServer) mustEmbedUnimplementedAcademicsServiceServer() {}
+
+// UnsafeAcademicsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AcademicsServiceServer will
+// result in compilation errors.
+type UnsafeAcademicsServiceServer interface {
+	mustEmbedUnimplementedAcademicsServiceServer()
+}
+
+func RegisterAcademicsServiceServer(s grpc.ServiceRegistrar, srv AcademicsServiceServer) {
+	s.RegisterService(&AcademicsService_ServiceDesc, srv)
+}
+
+func _AcademicsService_TeacherExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).TeacherExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/TeacherExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).TeacherExists(ctx, req.(*TeacherExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_StudentExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).StudentExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/StudentExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).StudentExists(ctx, req.(*StudentExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_MissingTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissingTeachersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).MissingTeachers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/MissingTeachers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).MissingTeachers(ctx, req.(*MissingTeachersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_MissingStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissingStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).MissingStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/MissingStudents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).MissingStudents(ctx, req.(*MissingStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_GroupExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).GroupExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/GroupExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).GroupExists(ctx, req.(*GroupExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_AcademicProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcademicProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).AcademicProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/AcademicProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).AcademicProfiles(ctx, req.(*AcademicProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_GroupStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).GroupStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/GroupStudents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).GroupStudents(ctx, req.(*GroupStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicsService_StudentByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicsServiceServer).StudentByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/academics.AcademicsService/StudentByEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicsServiceServer).StudentByEmail(ctx, req.(*StudentByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AcademicsService_ServiceDesc is the grpc.ServiceDesc for AcademicsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AcademicsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "academics.AcademicsService",
+	HandlerType: (*AcademicsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TeacherExists",
+			Handler:    _AcademicsService_TeacherExists_Handler,
+		},
+		{
+			MethodName: "StudentExists",
+			Handler:    _AcademicsService_StudentExists_Handler,
+		},
+		{
+			MethodName: "MissingTeachers",
+			Handler:    _AcademicsService_MissingTeachers_Handler,
+		},
+		{
+			MethodName: "MissingStudents",
+			Handler:    _AcademicsService_MissingStudents_Handler,
+		},
+		{
+			MethodName: "GroupExists",
+			Handler:    _AcademicsService_GroupExists_Handler,
+		},
+		{
+			MethodName: "AcademicProfiles",
+			Handler:    _AcademicsService_AcademicProfiles_Handler,
+		},
+		{
+			MethodName: "GroupStudents",
+			Handler:    _AcademicsService_GroupStudents_Handler,
+		},
+		{
+			MethodName: "StudentByEmail",
+			Handler:    _AcademicsService_StudentByEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academics.proto",
+}
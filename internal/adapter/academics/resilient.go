@@ -0,0 +1,506 @@
+package academics
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+// academicsService is service decorated by ResilientService.
+type academicsService interface {
+	TeacherExists(ctx context.Context, teacherID string) error
+	StudentExists(ctx context.Context, studentID string) error
+	MissingTeachers(ctx context.Context, teacherIDs []string) ([]string, error)
+	MissingStudents(ctx context.Context, studentIDs []string) ([]string, error)
+	StudentByEmail(ctx context.Context, email string) (string, error)
+	GroupExists(ctx context.Context, groupID string) error
+	GroupStudents(ctx context.Context, groupID string) ([]string, error)
+	AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error)
+}
+
+// Clock is source of time of ResilientService, tests use
+// fake clock to expire cache entries and skip backoff delays.
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var errBreakerOpen = errors.New("circuit breaker is open")
+
+// ResilientService decorates academics service with cache of found and
+// not found academics, retries of failed calls with exponential backoff
+// and circuit breaker. Only problems of service are retried, answer that
+// academic doesn't exist is cached like any other. While service fails,
+// recently expired entries are used.
+type ResilientService struct {
+	hits      uint64
+	misses    uint64
+	staleHits uint64
+
+	service academicsService
+	cfg     config.ResilienceConfig
+	clock   Clock
+	cache   *cache
+	breaker *breaker
+}
+
+// Stats is snapshot of metrics of ResilientService, stale
+// hits are misses answered from expired entries.
+type Stats struct {
+	Hits            uint64       `json:"hits"`
+	Misses          uint64       `json:"misses"`
+	StaleHits       uint64       `json:"staleHits"`
+	HitRate         float64      `json:"hitRate"`
+	BreakerState    BreakerState `json:"breakerState"`
+	BreakerOpenings uint64       `json:"breakerOpenings"`
+}
+
+func NewResilientService(service academicsService, cfg config.ResilienceConfig, clock Clock) *ResilientService {
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	if clock == nil {
+		panic("clock is nil")
+	}
+
+	if cfg.CacheMaxEntries <= 0 {
+		panic("cacheMaxEntries isn't positive")
+	}
+
+	if cfg.CallTimeout <= 0 {
+		panic("callTimeout isn't positive")
+	}
+
+	if cfg.MaxRetries < 0 {
+		panic("maxRetries is negative")
+	}
+
+	if cfg.RetryBackoff <= 0 || cfg.RetryMaxBackoff < cfg.RetryBackoff {
+		panic("retry backoff is out of range")
+	}
+
+	if cfg.BreakerThreshold <= 0 {
+		panic("breakerThreshold isn't positive")
+	}
+
+	return &ResilientService{
+		service: service,
+		cfg:     cfg,
+		clock:   clock,
+		cache:   newCache(clock, cfg.CacheTTL, cfg.NegativeCacheTTL, cfg.StaleTTL, cfg.CacheMaxEntries),
+		breaker: newBreaker(clock, cfg.BreakerThreshold, cfg.BreakerCooldown),
+	}
+}
+
+func (s *ResilientService) Stats() Stats {
+	stats := Stats{
+		Hits:      atomic.LoadUint64(&s.hits),
+		Misses:    atomic.LoadUint64(&s.misses),
+		StaleHits: atomic.LoadUint64(&s.staleHits),
+	}
+
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(lookups)
+	}
+
+	stats.BreakerState, stats.BreakerOpenings = s.breaker.snapshot()
+
+	return stats
+}
+
+func (s *ResilientService) TeacherExists(ctx context.Context, teacherID string) error {
+	_, err := s.lookup(ctx, teacherKey(teacherID), app.ErrTeacherDoesntExist,
+		func(ctx context.Context) (interface{}, error) {
+			return nil, s.service.TeacherExists(ctx, teacherID)
+		},
+	)
+
+	return err
+}
+
+func (s *ResilientService) StudentExists(ctx context.Context, studentID string) error {
+	_, err := s.lookup(ctx, studentKey(studentID), app.ErrStudentDoesntExist,
+		func(ctx context.Context) (interface{}, error) {
+			return nil, s.service.StudentExists(ctx, studentID)
+		},
+	)
+
+	return err
+}
+
+func (s *ResilientService) MissingTeachers(ctx context.Context, teacherIDs []string) ([]string, error) {
+	entries, err := s.lookupMany(ctx, teacherIDs, teacherKey, foundBy(s.service.MissingTeachers))
+	if err != nil {
+		return nil, err
+	}
+
+	return missingIDs(teacherIDs, entries), nil
+}
+
+func (s *ResilientService) MissingStudents(ctx context.Context, studentIDs []string) ([]string, error) {
+	entries, err := s.lookupMany(ctx, studentIDs, studentKey, foundBy(s.service.MissingStudents))
+	if err != nil {
+		return nil, err
+	}
+
+	return missingIDs(studentIDs, entries), nil
+}
+
+func (s *ResilientService) StudentByEmail(ctx context.Context, email string) (string, error) {
+	studentID, err := s.lookup(ctx, "email:"+email, app.ErrStudentDoesntExist,
+		func(ctx context.Context) (interface{}, error) {
+			return s.service.StudentByEmail(ctx, email)
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return studentID.(string), nil
+}
+
+func (s *ResilientService) GroupExists(ctx context.Context, groupID string) error {
+	_, err := s.lookup(ctx, "group:"+groupID, app.ErrGroupDoesntExist,
+		func(ctx context.Context) (interface{}, error) {
+			return nil, s.service.GroupExists(ctx, groupID)
+		},
+	)
+
+	return err
+}
+
+func (s *ResilientService) GroupStudents(ctx context.Context, groupID string) ([]string, error) {
+	studentIDs, err := s.lookup(ctx, "group-students:"+groupID, app.ErrGroupDoesntExist,
+		func(ctx context.Context) (interface{}, error) {
+			return s.service.GroupStudents(ctx, groupID)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string(nil), studentIDs.([]string)...), nil
+}
+
+func (s *ResilientService) AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error) {
+	entries, err := s.lookupMany(ctx, academicIDs, profileKey,
+		func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+			profiles, err := s.service.AcademicProfiles(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			found := make(map[string]interface{}, len(profiles))
+			for _, p := range profiles {
+				found[p.ID] = p
+			}
+
+			return found, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]app.AcademicProfile, 0, len(academicIDs))
+
+	for _, id := range academicIDs {
+		if e := entries[id]; e.found {
+			profiles = append(profiles, e.value.(app.AcademicProfile))
+		}
+	}
+
+	return profiles, nil
+}
+
+// InvalidateAcademic forgets everything cached about academic, including
+// answers by email and students of groups that mention academic, so
+// deactivated academic isn't found until service is asked again.
+func (s *ResilientService) InvalidateAcademic(academicID string) {
+	keys := map[string]bool{
+		teacherKey(academicID): true,
+		studentKey(academicID): true,
+		profileKey(academicID): true,
+	}
+
+	s.cache.removeIf(func(key string, e cacheEntry) bool {
+		switch {
+		case keys[key]:
+			return true
+		case strings.HasPrefix(key, "email:"):
+			return e.value == academicID
+		case strings.HasPrefix(key, "group-students:"):
+			studentIDs, _ := e.value.([]string)
+
+			return containsID(studentIDs, academicID)
+		}
+
+		return false
+	})
+}
+
+func containsID(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func teacherKey(id string) string {
+	return "teacher:" + id
+}
+
+func studentKey(id string) string {
+	return "student:" + id
+}
+
+func profileKey(id string) string {
+	return "profile:" + id
+}
+
+// lookup answers from cache or calls fetch, answer that academic
+// doesn't exist is recognized by errDoesntExist and cached too.
+func (s *ResilientService) lookup(
+	ctx context.Context,
+	key string, errDoesntExist error,
+	fetch func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+	if e, ok := s.cache.fresh(key); ok {
+		atomic.AddUint64(&s.hits, 1)
+
+		return e.answer(errDoesntExist)
+	}
+
+	atomic.AddUint64(&s.misses, 1)
+
+	var value interface{}
+
+	err := s.call(ctx, func(ctx context.Context) error {
+		var err error
+		value, err = fetch(ctx)
+
+		return err
+	})
+
+	switch {
+	case err == nil:
+		s.cache.put(key, value, true)
+	case errors.Is(err, errDoesntExist):
+		s.cache.put(key, nil, false)
+	case isServiceProblem(err):
+		if e, ok := s.cache.stale(key); ok {
+			atomic.AddUint64(&s.staleHits, 1)
+
+			return e.answer(errDoesntExist)
+		}
+	}
+
+	return value, err
+}
+
+func (e cacheEntry) answer(errDoesntExist error) (interface{}, error) {
+	if !e.found {
+		return nil, errDoesntExist
+	}
+
+	return e.value, nil
+}
+
+// fetchMany returns values of found IDs, IDs that aren't returned don't exist.
+type fetchMany func(ctx context.Context, ids []string) (map[string]interface{}, error)
+
+// lookupMany answers about every ID, IDs that aren't cached
+// are fetched by one call. Entries are returned by IDs.
+func (s *ResilientService) lookupMany(
+	ctx context.Context,
+	ids []string, key func(id string) string,
+	fetch fetchMany,
+) (map[string]cacheEntry, error) {
+	entries := make(map[string]cacheEntry, len(ids))
+
+	var unknownIDs []string
+
+	for _, id := range ids {
+		if e, ok := s.cache.fresh(key(id)); ok {
+			atomic.AddUint64(&s.hits, 1)
+
+			entries[id] = e
+
+			continue
+		}
+
+		atomic.AddUint64(&s.misses, 1)
+
+		unknownIDs = append(unknownIDs, id)
+	}
+
+	if len(unknownIDs) == 0 {
+		return entries, nil
+	}
+
+	var found map[string]interface{}
+
+	err := s.call(ctx, func(ctx context.Context) error {
+		var err error
+		found, err = fetch(ctx, unknownIDs)
+
+		return err
+	})
+	if err != nil {
+		return s.staleEntries(entries, unknownIDs, key, err)
+	}
+
+	for _, id := range unknownIDs {
+		value, ok := found[id]
+		s.cache.put(key(id), value, ok)
+		entries[id] = cacheEntry{value: value, found: ok}
+	}
+
+	return entries, nil
+}
+
+// staleEntries completes entries with stale ones when service fails,
+// error is returned if any of unknown IDs has no stale entry.
+func (s *ResilientService) staleEntries(
+	entries map[string]cacheEntry,
+	unknownIDs []string, key func(id string) string,
+	err error,
+) (map[string]cacheEntry, error) {
+	if !isServiceProblem(err) {
+		return nil, err
+	}
+
+	for _, id := range unknownIDs {
+		e, ok := s.cache.stale(key(id))
+		if !ok {
+			return nil, err
+		}
+
+		entries[id] = e
+	}
+
+	atomic.AddUint64(&s.staleHits, uint64(len(unknownIDs)))
+
+	return entries, nil
+}
+
+// foundBy adapts method that returns missing IDs to fetchMany.
+func foundBy(missing func(ctx context.Context, ids []string) ([]string, error)) fetchMany {
+	return func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		missingIDs, err := missing(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[string]interface{}, len(ids))
+		for _, id := range ids {
+			found[id] = nil
+		}
+
+		for _, id := range missingIDs {
+			delete(found, id)
+		}
+
+		return found, nil
+	}
+}
+
+func missingIDs(ids []string, entries map[string]cacheEntry) []string {
+	var missing []string
+
+	for _, id := range ids {
+		if !entries[id].found {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing
+}
+
+// call makes attempts until one of them isn't failed by problems of service or
+// retries run out. Backoff between attempts doubles up to max backoff.
+func (s *ResilientService) call(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+
+	backoff := s.cfg.RetryBackoff
+
+	for attempt := 0; attempt <= s.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if sleepErr := s.clock.Sleep(ctx, backoff); sleepErr != nil {
+				return err
+			}
+
+			backoff *= 2
+			if backoff > s.cfg.RetryMaxBackoff {
+				backoff = s.cfg.RetryMaxBackoff
+			}
+		}
+
+		if !s.breaker.allow() {
+			return app.Wrap(app.ErrAcademicsServiceProblems, errBreakerOpen)
+		}
+
+		err = s.attempt(ctx, fn)
+		if !isServiceProblem(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// attempt limits call by call timeout and reports its outcome to breaker,
+// call abandoned by caller says nothing about service.
+func (s *ResilientService) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	attemptCtx, cancel := context.WithTimeout(ctx, s.cfg.CallTimeout)
+	defer cancel()
+
+	err := fn(attemptCtx)
+
+	switch {
+	case ctx.Err() != nil:
+		s.breaker.done(callAbandoned)
+	case isServiceProblem(err):
+		s.breaker.done(callFailed)
+	default:
+		s.breaker.done(callSucceeded)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, app.ErrAcademicsServiceProblems) {
+		return app.Wrap(app.ErrAcademicsServiceProblems, err)
+	}
+
+	return err
+}
+
+func isServiceProblem(err error) bool {
+	return errors.Is(err, app.ErrAcademicsServiceProblems) || errors.Is(err, context.DeadlineExceeded)
+}
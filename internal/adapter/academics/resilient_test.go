@@ -0,0 +1,352 @@
+package academics_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/academics"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Sleep moves time forward at once, so tests don't wait for backoff.
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+
+	return nil
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// fakeService counts calls and fails given number of next calls.
+type fakeService struct {
+	*mock.AcademicsService
+
+	mu           sync.Mutex
+	calls        int
+	failures     int
+	requestedIDs [][]string
+}
+
+func newFakeService() *fakeService {
+	return &fakeService{
+		AcademicsService: mock.NewAcademicsService(
+			[]string{teacherID}, []string{studentID}, nil,
+		).WithFullNames(map[string]string{studentID: "Sergey Ivanov"}),
+	}
+}
+
+func (f *fakeService) FailNext(calls int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures = calls
+}
+
+func (f *fakeService) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls
+}
+
+func (f *fakeService) call(ids ...string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	f.requestedIDs = append(f.requestedIDs, ids)
+
+	if f.failures > 0 {
+		f.failures--
+
+		return app.Wrap(app.ErrAcademicsServiceProblems, errors.New("service unavailable"))
+	}
+
+	return nil
+}
+
+func (f *fakeService) TeacherExists(ctx context.Context, teacherID string) error {
+	if err := f.call(teacherID); err != nil {
+		return err
+	}
+
+	return f.AcademicsService.TeacherExists(ctx, teacherID)
+}
+
+func (f *fakeService) StudentExists(ctx context.Context, studentID string) error {
+	if err := f.call(studentID); err != nil {
+		return err
+	}
+
+	return f.AcademicsService.StudentExists(ctx, studentID)
+}
+
+func (f *fakeService) MissingStudents(ctx context.Context, studentIDs []string) ([]string, error) {
+	if err := f.call(studentIDs...); err != nil {
+		return nil, err
+	}
+
+	return f.AcademicsService.MissingStudents(ctx, studentIDs)
+}
+
+func (f *fakeService) AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error) {
+	if err := f.call(academicIDs...); err != nil {
+		return nil, err
+	}
+
+	return f.AcademicsService.AcademicProfiles(ctx, academicIDs)
+}
+
+func newResilienceConfig() config.ResilienceConfig {
+	return config.ResilienceConfig{
+		CacheTTL:         time.Minute,
+		NegativeCacheTTL: 10 * time.Second,
+		StaleTTL:         time.Hour,
+		CacheMaxEntries:  100,
+		CallTimeout:      time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     100 * time.Millisecond,
+		RetryMaxBackoff:  150 * time.Millisecond,
+		BreakerThreshold: 3,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+func TestResilientService_caches_existence(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, resilient.TeacherExists(context.Background(), teacherID))
+	}
+	require.Equal(t, 1, service.Calls())
+
+	clock.Advance(time.Minute)
+	require.NoError(t, resilient.TeacherExists(context.Background(), teacherID))
+	require.Equal(t, 2, service.Calls())
+
+	stats := resilient.Stats()
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(2), stats.Misses)
+	require.Equal(t, 0.5, stats.HitRate)
+}
+
+func TestResilientService_caches_absence(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	for i := 0; i < 2; i++ {
+		err := resilient.TeacherExists(context.Background(), unknownID)
+		require.ErrorIs(t, err, app.ErrTeacherDoesntExist)
+	}
+	require.Equal(t, 1, service.Calls())
+
+	clock.Advance(10 * time.Second)
+	err := resilient.TeacherExists(context.Background(), unknownID)
+	require.ErrorIs(t, err, app.ErrTeacherDoesntExist)
+	require.Equal(t, 2, service.Calls(), "absence should expire after negative TTL")
+}
+
+func TestResilientService_retries_service_problems(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	service.FailNext(2)
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	require.NoError(t, resilient.StudentExists(context.Background(), studentID))
+	require.Equal(t, 3, service.Calls())
+	require.Equal(t, []time.Duration{100 * time.Millisecond, 150 * time.Millisecond}, clock.sleeps)
+}
+
+func TestResilientService_fails_when_retries_run_out(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	service.FailNext(3)
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	err := resilient.StudentExists(context.Background(), studentID)
+	require.ErrorIs(t, err, app.ErrAcademicsServiceProblems)
+	require.Equal(t, 3, service.Calls())
+}
+
+func TestResilientService_breaker(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	cfg := newResilienceConfig()
+	cfg.MaxRetries = 0
+	resilient := academics.NewResilientService(service, cfg, clock)
+
+	service.FailNext(3)
+
+	for i := 0; i < 3; i++ {
+		err := resilient.StudentExists(context.Background(), studentID)
+		require.ErrorIs(t, err, app.ErrAcademicsServiceProblems)
+	}
+	require.Equal(t, academics.BreakerOpen, resilient.Stats().BreakerState)
+
+	err := resilient.StudentExists(context.Background(), studentID)
+	require.ErrorIs(t, err, app.ErrAcademicsServiceProblems)
+	require.Contains(t, err.Error(), "circuit breaker is open")
+	require.Equal(t, 3, service.Calls(), "open breaker shouldn't let calls through")
+
+	clock.Advance(30 * time.Second)
+	require.NoError(t, resilient.StudentExists(context.Background(), studentID))
+	require.Equal(t, 4, service.Calls())
+
+	stats := resilient.Stats()
+	require.Equal(t, academics.BreakerClosed, stats.BreakerState)
+	require.Equal(t, uint64(1), stats.BreakerOpenings)
+}
+
+func TestResilientService_breaker_opens_again_when_trial_fails(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	cfg := newResilienceConfig()
+	cfg.MaxRetries = 0
+	cfg.BreakerThreshold = 1
+	resilient := academics.NewResilientService(service, cfg, clock)
+
+	service.FailNext(2)
+	require.Error(t, resilient.StudentExists(context.Background(), studentID))
+
+	clock.Advance(30 * time.Second)
+	require.Error(t, resilient.StudentExists(context.Background(), studentID))
+
+	stats := resilient.Stats()
+	require.Equal(t, academics.BreakerOpen, stats.BreakerState)
+	require.Equal(t, uint64(2), stats.BreakerOpenings)
+}
+
+func TestResilientService_uses_stale_entries_while_service_fails(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	require.NoError(t, resilient.StudentExists(context.Background(), studentID))
+
+	clock.Advance(2 * time.Minute)
+	service.FailNext(3)
+	require.NoError(t, resilient.StudentExists(context.Background(), studentID))
+	require.Equal(t, uint64(1), resilient.Stats().StaleHits)
+
+	clock.Advance(time.Hour)
+	service.FailNext(3)
+	require.ErrorIs(t, resilient.StudentExists(context.Background(), studentID), app.ErrAcademicsServiceProblems)
+}
+
+func TestResilientService_MissingStudents_fetches_only_unknown(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	require.NoError(t, resilient.StudentExists(context.Background(), studentID))
+
+	missingIDs, err := resilient.MissingStudents(context.Background(), []string{studentID, unknownID})
+	require.NoError(t, err)
+	require.Equal(t, []string{unknownID}, missingIDs)
+	require.Equal(t, [][]string{{studentID}, {unknownID}}, service.requestedIDs)
+
+	err = resilient.StudentExists(context.Background(), unknownID)
+	require.ErrorIs(t, err, app.ErrStudentDoesntExist)
+	require.Equal(t, 2, service.Calls(), "batch answers should be cached by IDs")
+}
+
+func TestResilientService_AcademicProfiles(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	service := newFakeService()
+	resilient := academics.NewResilientService(service, newResilienceConfig(), clock)
+
+	for i := 0; i < 2; i++ {
+		profiles, err := resilient.AcademicProfiles(context.Background(), []string{unknownID, studentID})
+		require.NoError(t, err)
+		require.Equal(t, []app.AcademicProfile{{ID: studentID, FullName: "Sergey Ivanov"}}, profiles)
+	}
+	require.Equal(t, 1, service.Calls())
+}
+
+func TestResilientService_InvalidateAcademic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	service := newFakeService()
+	service.WithStudentEmail(studentID, "ivanov@example.com").WithGroupStudents("group-id", studentID)
+	resilient := academics.NewResilientService(service, newResilienceConfig(), newFakeClock())
+
+	require.NoError(t, resilient.TeacherExists(ctx, teacherID))
+	require.NoError(t, resilient.StudentExists(ctx, studentID))
+	_, err := resilient.AcademicProfiles(ctx, []string{studentID})
+	require.NoError(t, err)
+	_, err = resilient.StudentByEmail(ctx, "ivanov@example.com")
+	require.NoError(t, err)
+	_, err = resilient.GroupStudents(ctx, "group-id")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resilient.Stats().Misses)
+
+	resilient.InvalidateAcademic(studentID)
+
+	require.NoError(t, resilient.TeacherExists(ctx, teacherID))
+	require.Equal(t, uint64(5), resilient.Stats().Misses, "other academics should stay cached")
+
+	require.NoError(t, resilient.StudentExists(ctx, studentID))
+	_, err = resilient.AcademicProfiles(ctx, []string{studentID})
+	require.NoError(t, err)
+	_, err = resilient.StudentByEmail(ctx, "ivanov@example.com")
+	require.NoError(t, err)
+	_, err = resilient.GroupStudents(ctx, "group-id")
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), resilient.Stats().Misses)
+	require.Equal(t, 5, service.Calls())
+}
@@ -0,0 +1,305 @@
+package academics
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// RosterService is academics service backed by roster file of teachers,
+// students and groups for installations without academics service.
+// File is checked by interval and reloaded when it changes, invalid
+// file is logged and previous roster is kept.
+type RosterService struct {
+	path           string
+	reloadInterval time.Duration
+
+	mu      sync.RWMutex
+	roster  *roster
+	modTime time.Time
+	size    int64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewRosterService(path string, reloadInterval time.Duration) (*RosterService, error) {
+	if path == "" {
+		panic("roster path is empty")
+	}
+
+	if reloadInterval <= 0 {
+		panic("reloadInterval isn't positive")
+	}
+
+	s := &RosterService{
+		path:           path,
+		reloadInterval: reloadInterval,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+
+	go s.watch()
+
+	return s, nil
+}
+
+// Reload reads roster file again if its modification time or size
+// changed, returns whether roster was replaced.
+func (s *RosterService) Reload() (bool, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false, errors.Wrap(err, "reading roster")
+	}
+
+	s.mu.RLock()
+	unchanged := s.roster != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size
+	s.mu.RUnlock()
+
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return false, errors.Wrap(err, "reading roster")
+	}
+
+	r, err := parseRosterFile(s.path, data)
+	if err != nil {
+		return false, errors.Wrapf(err, "roster %s", s.path)
+	}
+
+	s.mu.Lock()
+	s.roster = r
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+	s.mu.Unlock()
+
+	return true, nil
+}
+
+// Close stops watching roster file.
+func (s *RosterService) Close() {
+	close(s.stop)
+	<-s.done
+}
+
+func (s *RosterService) watch() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			reloaded, err := s.Reload()
+			if err != nil {
+				logrus.WithError(err).Error("Failed to reload roster, previous roster is kept")
+
+				continue
+			}
+
+			if reloaded {
+				logrus.WithField("path", s.path).Info("Roster reloaded")
+			}
+		}
+	}
+}
+
+func (s *RosterService) current() *roster {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.roster
+}
+
+func (s *RosterService) TeacherExists(_ context.Context, teacherID string) error {
+	if s.current().teachers[teacherID] {
+		return nil
+	}
+
+	return app.ErrTeacherDoesntExist
+}
+
+func (s *RosterService) StudentExists(_ context.Context, studentID string) error {
+	if s.current().students[studentID] {
+		return nil
+	}
+
+	return app.ErrStudentDoesntExist
+}
+
+func (s *RosterService) MissingTeachers(_ context.Context, teacherIDs []string) ([]string, error) {
+	return missingFrom(s.current().teachers, teacherIDs), nil
+}
+
+func (s *RosterService) MissingStudents(_ context.Context, studentIDs []string) ([]string, error) {
+	return missingFrom(s.current().students, studentIDs), nil
+}
+
+func missingFrom(existing map[string]bool, ids []string) []string {
+	var missingIDs []string
+
+	for _, id := range ids {
+		if !existing[id] {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return missingIDs
+}
+
+// StudentByEmail finds student by email ignoring case.
+func (s *RosterService) StudentByEmail(_ context.Context, email string) (string, error) {
+	if studentID, ok := s.current().emails[normalizeEmail(email)]; ok {
+		return studentID, nil
+	}
+
+	return "", app.ErrStudentDoesntExist
+}
+
+func (s *RosterService) GroupExists(_ context.Context, groupID string) error {
+	if _, ok := s.current().groups[groupID]; ok {
+		return nil
+	}
+
+	return app.ErrGroupDoesntExist
+}
+
+func (s *RosterService) GroupStudents(_ context.Context, groupID string) ([]string, error) {
+	studentIDs, ok := s.current().groups[groupID]
+	if !ok {
+		return nil, app.ErrGroupDoesntExist
+	}
+
+	return append([]string(nil), studentIDs...), nil
+}
+
+// InvalidateAcademic does nothing, roster isn't cached and deactivated
+// academic is forgotten when academic is removed from roster file.
+func (s *RosterService) InvalidateAcademic(string) {}
+
+func (s *RosterService) AcademicProfiles(_ context.Context, academicIDs []string) ([]app.AcademicProfile, error) {
+	r := s.current()
+	profiles := make([]app.AcademicProfile, 0, len(academicIDs))
+
+	for _, id := range academicIDs {
+		if r.teachers[id] || r.students[id] {
+			profiles = append(profiles, app.AcademicProfile{ID: id, FullName: r.fullNames[id]})
+		}
+	}
+
+	return profiles, nil
+}
+
+// roster is validated roster file, it's never changed after parsing.
+type roster struct {
+	teachers  map[string]bool
+	students  map[string]bool
+	fullNames map[string]string
+	emails    map[string]string
+	groups    map[string][]string
+}
+
+func newRoster(document rosterDocument) (*roster, error) {
+	r := &roster{
+		teachers:  make(map[string]bool, len(document.Teachers)),
+		students:  make(map[string]bool, len(document.Students)),
+		fullNames: make(map[string]string, len(document.Teachers)+len(document.Students)),
+		emails:    make(map[string]string, len(document.Students)),
+		groups:    make(map[string][]string, len(document.Groups)),
+	}
+
+	for _, t := range document.Teachers {
+		if err := r.addAcademic(r.teachers, t); err != nil {
+			return nil, errors.Wrap(err, "teachers")
+		}
+	}
+
+	for _, s := range document.Students {
+		if err := r.addAcademic(r.students, s); err != nil {
+			return nil, errors.Wrap(err, "students")
+		}
+
+		if err := r.addEmail(s); err != nil {
+			return nil, errors.Wrap(err, "students")
+		}
+	}
+
+	for _, g := range document.Groups {
+		if err := r.addGroup(g); err != nil {
+			return nil, errors.Wrap(err, "groups")
+		}
+	}
+
+	return r, nil
+}
+
+func (r *roster) addAcademic(academics map[string]bool, a rosterAcademic) error {
+	if a.ID == "" {
+		return errors.New("academic without id")
+	}
+
+	if r.teachers[a.ID] || r.students[a.ID] {
+		return errors.Errorf("academic #%s is given twice", a.ID)
+	}
+
+	academics[a.ID] = true
+	r.fullNames[a.ID] = a.FullName
+
+	return nil
+}
+
+func (r *roster) addEmail(s rosterAcademic) error {
+	if s.Email == "" {
+		return nil
+	}
+
+	email := normalizeEmail(s.Email)
+	if _, ok := r.emails[email]; ok {
+		return errors.Errorf("email %s is given twice", s.Email)
+	}
+
+	r.emails[email] = s.ID
+
+	return nil
+}
+
+func (r *roster) addGroup(g rosterGroup) error {
+	if g.ID == "" {
+		return errors.New("group without id")
+	}
+
+	if _, ok := r.groups[g.ID]; ok {
+		return errors.Errorf("group #%s is given twice", g.ID)
+	}
+
+	for _, sid := range g.Students {
+		if !r.students[sid] {
+			return errors.Errorf("group #%s has unknown student #%s", g.ID, sid)
+		}
+	}
+
+	r.groups[g.ID] = append([]string(nil), g.Students...)
+
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
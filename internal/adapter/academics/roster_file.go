@@ -0,0 +1,154 @@
+package academics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// rosterDocument is content of roster file. YAML and JSON files keep it as is,
+// CSV file has header with type, id, fullName, email and members columns, where
+// type is teacher, student or group and members of group are separated by ';'.
+type rosterDocument struct {
+	Teachers []rosterAcademic `json:"teachers" yaml:"teachers"`
+	Students []rosterAcademic `json:"students" yaml:"students"`
+	Groups   []rosterGroup    `json:"groups" yaml:"groups"`
+}
+
+type rosterAcademic struct {
+	ID       string `json:"id" yaml:"id"`
+	FullName string `json:"fullName" yaml:"fullName"`
+	Email    string `json:"email" yaml:"email"`
+}
+
+type rosterGroup struct {
+	ID       string   `json:"id" yaml:"id"`
+	Students []string `json:"students" yaml:"students"`
+}
+
+var errUnknownRosterFormat = errors.New("unknown roster format, roster should be yaml, json or csv file")
+
+func parseRosterFile(path string, data []byte) (*roster, error) {
+	var (
+		document rosterDocument
+		err      error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, &document)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&document)
+	case ".csv":
+		document, err = parseRosterCSV(data)
+	default:
+		return nil, errUnknownRosterFormat
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing roster")
+	}
+
+	return newRoster(document)
+}
+
+const (
+	csvTypeColumn     = "type"
+	csvIDColumn       = "id"
+	csvFullNameColumn = "fullName"
+	csvEmailColumn    = "email"
+	csvMembersColumn  = "members"
+	csvMembersSep     = ";"
+)
+
+func parseRosterCSV(data []byte) (rosterDocument, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return rosterDocument{}, errors.Wrap(err, "reading csv header")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, required := range []string{csvTypeColumn, csvIDColumn} {
+		if _, ok := columns[required]; !ok {
+			return rosterDocument{}, errors.Errorf("csv has no %s column", required)
+		}
+	}
+
+	var document rosterDocument
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return document, nil
+		}
+
+		if err != nil {
+			return rosterDocument{}, err
+		}
+
+		if err := document.addCSVRecord(columns, record); err != nil {
+			line, _ := reader.FieldPos(0)
+
+			return rosterDocument{}, errors.Wrapf(err, "line %d", line)
+		}
+	}
+}
+
+func (d *rosterDocument) addCSVRecord(columns map[string]int, record []string) error {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	academic := rosterAcademic{
+		ID:       field(csvIDColumn),
+		FullName: field(csvFullNameColumn),
+		Email:    field(csvEmailColumn),
+	}
+
+	switch t := field(csvTypeColumn); t {
+	case "teacher":
+		d.Teachers = append(d.Teachers, academic)
+	case "student":
+		d.Students = append(d.Students, academic)
+	case "group":
+		d.Groups = append(d.Groups, rosterGroup{ID: academic.ID, Students: splitMembers(field(csvMembersColumn))})
+	default:
+		return errors.Errorf("unknown type %q", t)
+	}
+
+	return nil
+}
+
+func splitMembers(members string) []string {
+	if members == "" {
+		return nil
+	}
+
+	ids := strings.Split(members, csvMembersSep)
+	for i := range ids {
+		ids[i] = strings.TrimSpace(ids[i])
+	}
+
+	return ids
+}
@@ -0,0 +1,196 @@
+package academics_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/academics"
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+const yamlRoster = `
+teachers:
+  - id: d3e2490f-5944-4a87-b29a-94177d1caaed
+    fullName: Ivan Petrov
+students:
+  - id: 798155cb-91b7-41d4-9f91-a1970339707e
+    fullName: Sergey Ivanov
+    email: Sergey@Authena.ru
+groups:
+  - id: 95dca190-f307-4954-8700-f992f8c12a86
+    students: [798155cb-91b7-41d4-9f91-a1970339707e]
+`
+
+const jsonRoster = `{
+	"teachers": [{"id": "d3e2490f-5944-4a87-b29a-94177d1caaed", "fullName": "Ivan Petrov"}],
+	"students": [
+		{"id": "798155cb-91b7-41d4-9f91-a1970339707e", "fullName": "Sergey Ivanov", "email": "Sergey@Authena.ru"}
+	],
+	"groups": [
+		{"id": "95dca190-f307-4954-8700-f992f8c12a86", "students": ["798155cb-91b7-41d4-9f91-a1970339707e"]}
+	]
+}`
+
+const csvRoster = `type,id,fullName,email,members
+teacher,d3e2490f-5944-4a87-b29a-94177d1caaed,Ivan Petrov,,
+student,798155cb-91b7-41d4-9f91-a1970339707e,Sergey Ivanov,Sergey@Authena.ru,
+group,95dca190-f307-4954-8700-f992f8c12a86,,,798155cb-91b7-41d4-9f91-a1970339707e
+`
+
+func writeRoster(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func newRosterService(t *testing.T, name, content string) (*academics.RosterService, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	writeRoster(t, path, content)
+
+	service, err := academics.NewRosterService(path, 10*time.Millisecond)
+	require.NoError(t, err)
+	t.Cleanup(service.Close)
+
+	return service, path
+}
+
+func TestRosterService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		File    string
+		Content string
+	}{
+		{Name: "yaml", File: "roster.yaml", Content: yamlRoster},
+		{Name: "json", File: "roster.json", Content: jsonRoster},
+		{Name: "csv", File: "roster.csv", Content: csvRoster},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _ := newRosterService(t, c.File, c.Content)
+			ctx := context.Background()
+
+			require.NoError(t, service.TeacherExists(ctx, teacherID))
+			require.ErrorIs(t, service.TeacherExists(ctx, studentID), app.ErrTeacherDoesntExist)
+			require.NoError(t, service.StudentExists(ctx, studentID))
+			require.ErrorIs(t, service.StudentExists(ctx, teacherID), app.ErrStudentDoesntExist)
+
+			missingIDs, err := service.MissingStudents(ctx, []string{unknownID, studentID, teacherID})
+			require.NoError(t, err)
+			require.Equal(t, []string{unknownID, teacherID}, missingIDs)
+
+			id, err := service.StudentByEmail(ctx, "sergey@authena.ru")
+			require.NoError(t, err)
+			require.Equal(t, studentID, id)
+
+			require.NoError(t, service.GroupExists(ctx, groupID))
+			studentIDs, err := service.GroupStudents(ctx, groupID)
+			require.NoError(t, err)
+			require.Equal(t, []string{studentID}, studentIDs)
+
+			_, err = service.GroupStudents(ctx, unknownID)
+			require.ErrorIs(t, err, app.ErrGroupDoesntExist)
+
+			profiles, err := service.AcademicProfiles(ctx, []string{studentID, unknownID, teacherID})
+			require.NoError(t, err)
+			require.Equal(t, []app.AcademicProfile{
+				{ID: studentID, FullName: "Sergey Ivanov"},
+				{ID: teacherID, FullName: "Ivan Petrov"},
+			}, profiles)
+		})
+	}
+}
+
+func TestNewRosterService_with_invalid_roster(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		File    string
+		Content string
+	}{
+		{
+			Name:    "unknown_format",
+			File:    "roster.txt",
+			Content: yamlRoster,
+		},
+		{
+			Name:    "unknown_field",
+			File:    "roster.yaml",
+			Content: "teachers:\n  - uuid: d3e2490f-5944-4a87-b29a-94177d1caaed\n",
+		},
+		{
+			Name:    "academic_without_id",
+			File:    "roster.json",
+			Content: `{"students": [{"fullName": "Sergey Ivanov"}]}`,
+		},
+		{
+			Name:    "academic_given_twice",
+			File:    "roster.yaml",
+			Content: "teachers:\n  - id: a\nstudents:\n  - id: a\n",
+		},
+		{
+			Name:    "email_given_twice",
+			File:    "roster.yaml",
+			Content: "students:\n  - id: a\n    email: a@authena.ru\n  - id: b\n    email: A@authena.ru\n",
+		},
+		{
+			Name:    "group_with_unknown_student",
+			File:    "roster.yaml",
+			Content: "groups:\n  - id: g\n    students: [a]\n",
+		},
+		{
+			Name:    "csv_without_type_column",
+			File:    "roster.csv",
+			Content: "id,fullName\na,Ivan Petrov\n",
+		},
+		{
+			Name:    "csv_with_unknown_type",
+			File:    "roster.csv",
+			Content: "type,id\nadmin,a\n",
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), c.File)
+			writeRoster(t, path, c.Content)
+
+			_, err := academics.NewRosterService(path, time.Second)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRosterService_reloads_changed_roster(t *testing.T) {
+	t.Parallel()
+
+	service, path := newRosterService(t, "roster.yaml", yamlRoster)
+	ctx := context.Background()
+
+	writeRoster(t, path, yamlRoster+"  - id: "+unknownID+"\n    students: []\n")
+	require.Eventually(t, func() bool {
+		return service.GroupExists(ctx, unknownID) == nil
+	}, time.Second, 10*time.Millisecond)
+
+	writeRoster(t, path, "teachers: [")
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, service.GroupExists(ctx, unknownID), "invalid roster shouldn't replace previous one")
+	require.NoError(t, service.TeacherExists(ctx, teacherID))
+}
@@ -0,0 +1,109 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// mathExtension keeps formulas like $a^2$ and $$\sum_i x_i$$ away
+// from Markdown processing, so clients can render them with
+// KaTeX or MathJax using math class of span.
+type mathExtension struct{}
+
+func (mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(mathParser{}, 150),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(mathRenderer{}, 150),
+	))
+}
+
+var kindMath = ast.NewNodeKind("Math")
+
+type mathNode struct {
+	ast.BaseInline
+	display bool
+	formula []byte
+}
+
+func (n *mathNode) Kind() ast.NodeKind {
+	return kindMath
+}
+
+func (n *mathNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Formula": string(n.formula)}, nil)
+}
+
+type mathParser struct{}
+
+func (mathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse parses formula in single line. Inline formula can't start or end
+// with space, so amounts like $5 and $10 are left as text.
+func (mathParser) Parse(_ ast.Node, block text.Reader, _ parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	delimiter := []byte("$")
+	if bytes.HasPrefix(line, []byte("$$")) {
+		delimiter = []byte("$$")
+	}
+
+	rest := line[len(delimiter):]
+
+	end := bytes.Index(rest, delimiter)
+	if end <= 0 {
+		return nil
+	}
+
+	formula := rest[:end]
+	display := len(delimiter) == 2
+
+	if !display && (isSpace(formula[0]) || isSpace(formula[len(formula)-1])) {
+		return nil
+	}
+
+	block.Advance(len(delimiter)*2 + end)
+
+	return &mathNode{
+		display: display,
+		formula: append([]byte(nil), formula...),
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+type mathRenderer struct{}
+
+func (mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindMath, renderMath)
+}
+
+func renderMath(w util.BufWriter, _ []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	node, _ := n.(*mathNode)
+	if node.display {
+		_, _ = w.WriteString(`<span class="math display">\[`)
+		_, _ = w.Write(util.EscapeHTML(node.formula))
+		_, _ = w.WriteString(`\]</span>`)
+	} else {
+		_, _ = w.WriteString(`<span class="math inline">\(`)
+		_, _ = w.Write(util.EscapeHTML(node.formula))
+		_, _ = w.WriteString(`\)</span>`)
+	}
+
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,30 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Renderer converts Markdown task descriptions to HTML that is
+// safe to embed into pages: raw HTML of source is omitted
+// and links with dangerous schemes like javascript: are dropped.
+type Renderer struct {
+	md goldmark.Markdown
+}
+
+func NewRenderer() *Renderer {
+	return &Renderer{
+		md: goldmark.New(goldmark.WithExtensions(extension.GFM, mathExtension{})),
+	}
+}
+
+func (r *Renderer) RenderHTML(markdown string) (string, error) {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(markdown), &buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
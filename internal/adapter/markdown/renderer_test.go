@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/markdown"
+)
+
+func TestRenderer_RenderHTML(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		Markdown     string
+		ExpectedHTML string
+	}{
+		{
+			Name:         "plain_text",
+			Markdown:     "Print sum of two numbers",
+			ExpectedHTML: "<p>Print sum of two numbers</p>\n",
+		},
+		{
+			Name:         "code_block",
+			Markdown:     "```go\nfmt.Println(a + b)\n```",
+			ExpectedHTML: "<pre><code class=\"language-go\">fmt.Println(a + b)\n</code></pre>\n",
+		},
+		{
+			Name:     "table",
+			Markdown: "| a | b |\n|---|---|\n| 1 | 2 |",
+			ExpectedHTML: "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n" +
+				"<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			Name:         "inline_formula",
+			Markdown:     "Compute $a_1 * b_1 < c$",
+			ExpectedHTML: "<p>Compute <span class=\"math inline\">\\(a_1 * b_1 &lt; c\\)</span></p>\n",
+		},
+		{
+			Name:         "display_formula",
+			Markdown:     "$$\\sum_{i=1}^n x_i$$",
+			ExpectedHTML: "<p><span class=\"math display\">\\[\\sum_{i=1}^n x_i\\]</span></p>\n",
+		},
+		{
+			Name:         "dollar_amounts_arent_formula",
+			Markdown:     "Costs $5 or $ 10",
+			ExpectedHTML: "<p>Costs $5 or $ 10</p>\n",
+		},
+		{
+			Name:         "raw_html_omitted",
+			Markdown:     "Hello <script>alert(1)</script>",
+			ExpectedHTML: "<p>Hello <!-- raw HTML omitted -->alert(1)<!-- raw HTML omitted --></p>\n",
+		},
+		{
+			Name:         "dangerous_link_dropped",
+			Markdown:     "[click](javascript:alert(1))",
+			ExpectedHTML: "<p><a href=\"\">click</a></p>\n",
+		},
+	}
+
+	r := markdown.NewRenderer()
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			html, err := r.RenderHTML(c.Markdown)
+
+			require.NoError(t, err)
+			require.Equal(t, c.ExpectedHTML, html)
+		})
+	}
+}
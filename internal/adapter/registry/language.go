@@ -0,0 +1,32 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+// LanguageRegistry knows language toolchains defined in config.
+type LanguageRegistry struct {
+	languages map[string]config.LanguageConfig
+}
+
+func NewLanguageRegistry(languages []config.LanguageConfig) *LanguageRegistry {
+	r := &LanguageRegistry{
+		languages: make(map[string]config.LanguageConfig, len(languages)),
+	}
+	for _, l := range languages {
+		r.languages[l.ID] = l
+	}
+
+	return r
+}
+
+func (r *LanguageRegistry) LanguageSupported(_ context.Context, languageID string) error {
+	if _, ok := r.languages[languageID]; ok {
+		return nil
+	}
+
+	return app.ErrLanguageNotSupported
+}
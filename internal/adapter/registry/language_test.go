@@ -0,0 +1,28 @@
+package registry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/registry"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+func TestLanguageRegistry_LanguageSupported(t *testing.T) {
+	t.Parallel()
+
+	r := registry.NewLanguageRegistry([]config.LanguageConfig{
+		{ID: "go", Name: "Go", Version: "1.17", RunCommand: "./solution"},
+		{ID: "python3", Name: "Python", Version: "3.9", RunCommand: "python3 solution.py"},
+	})
+
+	require.NoError(t, r.LanguageSupported(context.Background(), "go"))
+	require.NoError(t, r.LanguageSupported(context.Background(), "python3"))
+
+	err := r.LanguageSupported(context.Background(), "brainfuck")
+	require.True(t, errors.Is(err, app.ErrLanguageNotSupported))
+}
@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+// copyCourse returns course that shares nothing with original, course keeps
+// tasks by pointers, so copy of struct would let failed update change kept course.
+func copyCourse(crs *course.Course) *course.Course {
+	return course.UnmarshalFromDatabase(course.UnmarshallingParams{
+		ID:                crs.ID(),
+		Title:             crs.Title(),
+		Period:            crs.Period(),
+		Started:           crs.Started(),
+		CreatorID:         crs.CreatorID(),
+		Collaborators:     crs.Collaborators(),
+		CollaboratorRoles: crs.CollaboratorRoles(),
+		Students:          crs.Students(),
+		Enrollments:       copyStudentEnrollments(crs.AllStudentEnrollments()),
+		Groups:            crs.Groups(),
+		StudentGroups:     crs.StudentGroups(),
+		Capacity:          crs.Capacity(),
+		Waitlist:          copyWaitlist(crs.Waitlist()),
+		Promotions:        copyWaitlistPromotions(crs.WaitlistPromotions()),
+		Invitations:       copyInvitations(crs.Invitations()),
+		Requests:          copyEnrollmentRequests(crs.EnrollmentRequests()),
+		Teams:             copyTeams(crs.Teams()),
+		TeamSelfFormation: crs.TeamSettings().SelfFormation(),
+		TeamMaxSize:       crs.TeamSettings().MaxSize(),
+		Tasks:             copyTasks(crs.Tasks()),
+		Materials:         crs.AuxiliaryMaterials(),
+	})
+}
+
+func copyStudentEnrollments(enrollments []course.StudentEnrollment) []course.UnmarshallingStudentEnrollmentParams {
+	params := make([]course.UnmarshallingStudentEnrollmentParams, 0, len(enrollments))
+	for _, e := range enrollments {
+		params = append(params, course.UnmarshallingStudentEnrollmentParams{
+			StudentID:   e.StudentID(),
+			Status:      e.Status(),
+			EnrolledAt:  e.EnrolledAt(),
+			WithdrawnAt: e.WithdrawnAt(),
+			Reason:      e.Reason(),
+		})
+	}
+
+	return params
+}
+
+func copyWaitlist(entries []course.WaitlistEntry) []course.UnmarshallingWaitlistEntryParams {
+	params := make([]course.UnmarshallingWaitlistEntryParams, 0, len(entries))
+	for _, e := range entries {
+		params = append(params, course.UnmarshallingWaitlistEntryParams{
+			StudentID:    e.StudentID(),
+			WaitlistedAt: e.WaitlistedAt(),
+		})
+	}
+
+	return params
+}
+
+func copyWaitlistPromotions(promotions []course.WaitlistPromotion) []course.UnmarshallingWaitlistPromotionParams {
+	params := make([]course.UnmarshallingWaitlistPromotionParams, 0, len(promotions))
+	for _, p := range promotions {
+		params = append(params, course.UnmarshallingWaitlistPromotionParams{
+			StudentID:    p.StudentID(),
+			WaitlistedAt: p.WaitlistedAt(),
+			PromotedAt:   p.PromotedAt(),
+		})
+	}
+
+	return params
+}
+
+func copyInvitations(invitations []course.Invitation) []course.UnmarshallingInvitationParams {
+	params := make([]course.UnmarshallingInvitationParams, 0, len(invitations))
+	for _, i := range invitations {
+		params = append(params, course.UnmarshallingInvitationParams{
+			Code:      i.Code(),
+			Role:      i.Role(),
+			ExpiresAt: i.ExpiresAt(),
+			MaxUses:   i.MaxUses(),
+			Uses:      i.Uses(),
+		})
+	}
+
+	return params
+}
+
+func copyEnrollmentRequests(requests []course.EnrollmentRequest) []course.UnmarshallingEnrollmentRequestParams {
+	params := make([]course.UnmarshallingEnrollmentRequestParams, 0, len(requests))
+	for _, r := range requests {
+		params = append(params, course.UnmarshallingEnrollmentRequestParams{
+			ID:         r.ID(),
+			StudentID:  r.StudentID(),
+			Message:    r.Message(),
+			Status:     r.Status(),
+			CreatedAt:  r.CreatedAt(),
+			ReviewerID: r.ReviewerID(),
+			ReviewedAt: r.ReviewedAt(),
+		})
+	}
+
+	return params
+}
+
+func copyTeams(teams []course.Team) []course.UnmarshallingTeamParams {
+	params := make([]course.UnmarshallingTeamParams, 0, len(teams))
+	for _, t := range teams {
+		params = append(params, course.UnmarshallingTeamParams{
+			ID:      t.ID(),
+			Name:    t.Name(),
+			Members: t.Members(),
+		})
+	}
+
+	return params
+}
+
+func copyTasks(tasks []course.Task) []course.UnmarshallingTaskParams {
+	params := make([]course.UnmarshallingTaskParams, 0, len(tasks))
+	for i := range tasks {
+		taskParams := copyTask(&tasks[i])
+		taskParams.Version = tasks[i].Version()
+
+		params = append(params, taskParams)
+	}
+
+	return params
+}
+
+func copyTask(t *course.Task) course.UnmarshallingTaskParams {
+	deadline, _ := t.Deadline()
+	testPoints, _ := t.TestPoints()
+	testData, _ := t.TestData()
+	checker, _ := t.Checker()
+	languages, _ := t.Languages()
+	limits, _ := t.ExecutionLimits()
+
+	return course.UnmarshallingTaskParams{
+		Number:      t.Number(),
+		Title:       t.Title(),
+		Description: t.Description(),
+		TaskType:    t.Type(),
+		TeamWork:    t.TeamWork(),
+		Deadline:    deadline,
+		TestPoints:  testPoints,
+		TestData:    testData,
+		Checker:     checker,
+		Languages:   languages,
+		Limits:      limits,
+	}
+}
@@ -0,0 +1,370 @@
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func newCommonCourse(crs *course.Course) app.CommonCourse {
+	return app.CommonCourse{
+		ID:    crs.ID(),
+		Title: crs.Title(),
+		Period: app.Period{
+			AcademicStartYear: crs.Period().AcademicStartYear(),
+			AcademicEndYear:   crs.Period().AcademicEndYear(),
+			Semester:          crs.Period().Semester(),
+		},
+		CreatorID:   crs.CreatorID(),
+		Started:     crs.Started(),
+		TasksNumber: crs.TasksNumber(),
+		Capacity:    crs.Capacity(),
+	}
+}
+
+// sortedTasks returns tasks by numbers, course keeps them unordered.
+func sortedTasks(crs *course.Course) []course.Task {
+	tasks := crs.Tasks()
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Number() < tasks[j].Number()
+	})
+
+	return tasks
+}
+
+func newGeneralTask(t *course.Task) app.GeneralTask {
+	return app.GeneralTask{
+		Number:      t.Number(),
+		Title:       t.Title(),
+		Description: t.Description(),
+		Type:        t.Type(),
+		TeamWork:    t.TeamWork(),
+	}
+}
+
+func newGeneralTasks(tasks []course.Task) []app.GeneralTask {
+	generalTasks := make([]app.GeneralTask, 0, len(tasks))
+	for i := range tasks {
+		generalTasks = append(generalTasks, newGeneralTask(&tasks[i]))
+	}
+
+	return generalTasks
+}
+
+func newSpecificTask(academic course.Academic, t *course.Task) app.SpecificTask {
+	forTeacher := academic.Type() == course.TeacherType
+
+	testData, _ := t.TestData()
+	queryTestData, hiddenTestDataNumber := newQueryTestData(forTeacher, testData)
+	testPoints, _ := t.TestPoints()
+
+	task := app.SpecificTask{
+		Number:               t.Number(),
+		Title:                t.Title(),
+		Description:          t.Description(),
+		Type:                 t.Type(),
+		TeamWork:             t.TeamWork(),
+		Deadline:             newQueryDeadline(t),
+		TestData:             queryTestData,
+		HiddenTestDataNumber: hiddenTestDataNumber,
+		Points:               newQueryTestPoints(forTeacher, testPoints),
+		Version:              t.Version(),
+	}
+
+	if checker, ok := t.Checker(); ok {
+		task.Checker = &app.Checker{Mode: checker.Mode(), Tolerance: checker.Tolerance()}
+	}
+
+	if languages, ok := t.Languages(); ok && len(languages) > 0 {
+		task.Languages = languages
+	}
+
+	if limits, ok := t.ExecutionLimits(); ok {
+		task.Limits = &app.ExecutionLimits{
+			TimeLimit:     limits.TimeLimit(),
+			MemoryLimit:   limits.MemoryLimit(),
+			MaxSourceSize: limits.MaxSourceSize(),
+		}
+	}
+
+	return task
+}
+
+func newQueryDeadline(t *course.Task) *app.Deadline {
+	deadline, ok := t.Deadline()
+	if !ok || deadline.IsZero() {
+		return nil
+	}
+
+	return &app.Deadline{
+		ExcellentGradeTime: deadline.ExcellentGradeTime(),
+		GoodGradeTime:      deadline.GoodGradeTime(),
+	}
+}
+
+// newQueryTestData returns all test data for teacher and
+// only sample test data for student. Hidden test data are counted.
+func newQueryTestData(forTeacher bool, testData []course.TestData) ([]app.TestData, int) {
+	queryTestData := make([]app.TestData, 0, len(testData))
+	hiddenNumber := 0
+
+	for _, td := range testData {
+		if td.Visibility() == course.HiddenTestData {
+			hiddenNumber++
+
+			if !forTeacher {
+				continue
+			}
+		}
+
+		queryTestData = append(queryTestData, app.TestData{
+			InputData:    td.InputData(),
+			OutputData:   td.OutputData(),
+			InputDataID:  td.InputDataID(),
+			OutputDataID: td.OutputDataID(),
+			Visibility:   td.Visibility(),
+			Explanation:  td.Explanation(),
+		})
+	}
+
+	return queryTestData, hiddenNumber
+}
+
+func sampleTestDataIDs(tasks []course.Task) []string {
+	var ids []string
+
+	for i := range tasks {
+		testData, _ := tasks[i].TestData()
+		for _, td := range testData {
+			if !td.IsSample() || !td.IsStored() {
+				continue
+			}
+
+			ids = append(ids, td.InputDataID(), td.OutputDataID())
+		}
+	}
+
+	return ids
+}
+
+func newQueryTestPoints(forTeacher bool, testPoints []course.TestPoint) []app.TestPoint {
+	queryTestPoints := make([]app.TestPoint, 0, len(testPoints))
+
+	for _, tp := range testPoints {
+		var correctVariantNumbers []int
+		if forTeacher {
+			correctVariantNumbers = tp.CorrectVariantNumbers()
+		}
+
+		queryTestPoints = append(queryTestPoints, app.TestPoint{
+			Description:           tp.Description(),
+			Variants:              tp.Variants(),
+			CorrectVariantNumbers: correctVariantNumbers,
+			SingleCorrectVariant:  len(tp.CorrectVariantNumbers()) > 1,
+		})
+	}
+
+	return queryTestPoints
+}
+
+func newGeneralTaskVersions(versions []course.TaskVersion) []app.GeneralTaskVersion {
+	generalVersions := make([]app.GeneralTaskVersion, 0, len(versions))
+	for _, v := range versions {
+		task := v.Task()
+
+		generalVersions = append(generalVersions, app.GeneralTaskVersion{
+			Version:   v.Number(),
+			AuthorID:  v.AuthorID(),
+			CreatedAt: v.CreatedAt(),
+			Title:     task.Title(),
+		})
+	}
+
+	return generalVersions
+}
+
+func newTaskVersion(academic course.Academic, version course.TaskVersion) app.TaskVersion {
+	task := version.Task()
+
+	specificTask := newSpecificTask(academic, &task)
+	specificTask.Version = version.Number()
+
+	return app.TaskVersion{
+		Version:   version.Number(),
+		AuthorID:  version.AuthorID(),
+		CreatedAt: version.CreatedAt(),
+		Task:      specificTask,
+	}
+}
+
+// newTaskAuxiliaryMaterials returns materials attached to the task.
+func newTaskAuxiliaryMaterials(
+	academic course.Academic,
+	materials []course.AuxiliaryMaterial, taskNumber int,
+) []app.AuxiliaryMaterial {
+	var taskMaterials []course.AuxiliaryMaterial
+	for _, m := range materials {
+		if m.TaskNumber() == taskNumber {
+			taskMaterials = append(taskMaterials, m)
+		}
+	}
+
+	if len(taskMaterials) == 0 {
+		return nil
+	}
+
+	return newQueryAuxiliaryMaterials(academic, taskMaterials)
+}
+
+func newQueryAuxiliaryMaterials(
+	academic course.Academic,
+	materials []course.AuxiliaryMaterial,
+) []app.AuxiliaryMaterial {
+	now := time.Now()
+
+	queryMaterials := make([]app.AuxiliaryMaterial, 0, len(materials))
+	for _, m := range materials {
+		if material, ok := newQueryAuxiliaryMaterial(academic, m, now); ok {
+			queryMaterials = append(queryMaterials, material)
+		}
+	}
+
+	return queryMaterials
+}
+
+// newQueryAuxiliaryMaterial returns false if academic
+// isn't teacher and material isn't released to students yet.
+func newQueryAuxiliaryMaterial(
+	academic course.Academic,
+	material course.AuxiliaryMaterial, now time.Time,
+) (app.AuxiliaryMaterial, bool) {
+	status := material.Status(now)
+	if academic.Type() != course.TeacherType && status != course.ReleasedMaterial {
+		return app.AuxiliaryMaterial{}, false
+	}
+
+	queryMaterial := app.AuxiliaryMaterial{
+		ID:           material.ID(),
+		Resource:     material.Resource(),
+		ResourceType: material.ResourceType(),
+		TaskNumber:   material.TaskNumber(),
+		ReleaseTime:  material.ReleaseTime(),
+		Hidden:       material.Hidden(),
+		Status:       status,
+	}
+
+	if file, ok := material.File(); ok {
+		queryMaterial.File = &app.MaterialFile{
+			Key:         file.Key(),
+			Name:        file.Name(),
+			ContentType: file.ContentType(),
+			Size:        file.Size(),
+		}
+	}
+
+	return queryMaterial, true
+}
+
+func newQueryStudentEnrollments(enrollments []course.StudentEnrollment) []app.StudentEnrollment {
+	queryEnrollments := make([]app.StudentEnrollment, 0, len(enrollments))
+	for _, e := range enrollments {
+		queryEnrollments = append(queryEnrollments, app.StudentEnrollment{
+			StudentID:   e.StudentID(),
+			Status:      e.Status(),
+			EnrolledAt:  e.EnrolledAt(),
+			WithdrawnAt: e.WithdrawnAt(),
+			Reason:      e.Reason(),
+		})
+	}
+
+	return queryEnrollments
+}
+
+func newQueryInvitations(invitations []course.Invitation) []app.Invitation {
+	queryInvitations := make([]app.Invitation, 0, len(invitations))
+	for _, i := range invitations {
+		queryInvitations = append(queryInvitations, app.Invitation{
+			Code:      i.Code(),
+			Role:      i.Role(),
+			ExpiresAt: i.ExpiresAt(),
+			MaxUses:   i.MaxUses(),
+			Uses:      i.Uses(),
+		})
+	}
+
+	return queryInvitations
+}
+
+// newQueryEnrollmentRequests keeps requests from the
+// oldest, zero status matches any request.
+func newQueryEnrollmentRequests(
+	requests []course.EnrollmentRequest,
+	status course.EnrollmentRequestStatus,
+) []app.EnrollmentRequest {
+	queryRequests := make([]app.EnrollmentRequest, 0, len(requests))
+	for _, r := range requests {
+		if status != 0 && r.Status() != status {
+			continue
+		}
+
+		queryRequests = append(queryRequests, app.EnrollmentRequest{
+			ID:         r.ID(),
+			StudentID:  r.StudentID(),
+			Message:    r.Message(),
+			Status:     r.Status(),
+			CreatedAt:  r.CreatedAt(),
+			ReviewerID: r.ReviewerID(),
+			ReviewedAt: r.ReviewedAt(),
+		})
+	}
+
+	return queryRequests
+}
+
+func newQueryTeams(crs *course.Course) app.Teams {
+	teams := make([]app.Team, 0)
+	for _, t := range crs.Teams() {
+		teams = append(teams, app.Team{
+			ID:        t.ID(),
+			Name:      t.Name(),
+			MemberIDs: t.Members(),
+		})
+	}
+
+	return app.Teams{
+		SelfFormation: crs.TeamSettings().SelfFormation(),
+		MaxSize:       crs.TeamSettings().MaxSize(),
+		Teams:         teams,
+	}
+}
+
+func newQueryWaitlist(crs *course.Course) app.Waitlist {
+	waitlist := crs.Waitlist()
+	entries := make([]app.WaitlistEntry, 0, len(waitlist))
+
+	for _, e := range waitlist {
+		entries = append(entries, app.WaitlistEntry{
+			StudentID:    e.StudentID(),
+			WaitlistedAt: e.WaitlistedAt(),
+		})
+	}
+
+	promotions := crs.WaitlistPromotions()
+	queryPromotions := make([]app.WaitlistPromotion, 0, len(promotions))
+
+	for _, p := range promotions {
+		queryPromotions = append(queryPromotions, app.WaitlistPromotion{
+			StudentID:    p.StudentID(),
+			WaitlistedAt: p.WaitlistedAt(),
+			PromotedAt:   p.PromotedAt(),
+		})
+	}
+
+	return app.Waitlist{
+		Capacity:   crs.Capacity(),
+		Entries:    entries,
+		Promotions: queryPromotions,
+	}
+}
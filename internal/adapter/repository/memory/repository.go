@@ -0,0 +1,587 @@
+package memory
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/query"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+// CoursesRepository keeps courses in memory for tests and runs without
+// MongoDB, it filters courses like MongoDB repository. Kept courses are
+// never given out, callers get copies, so course changes only by update.
+// Task versions are kept apart from courses like in MongoDB repository.
+type CoursesRepository struct {
+	mu           sync.RWMutex
+	courses      map[string]*courseRecord
+	taskVersions map[taskVersionsKey][]course.TaskVersion
+}
+
+// courseRecord is kept course with revision
+// that is increased by every saved update.
+type courseRecord struct {
+	course   *course.Course
+	revision int
+}
+
+// taskVersionsKey identifies version history of course task.
+type taskVersionsKey struct {
+	courseID   string
+	taskNumber int
+}
+
+func NewCoursesRepository() *CoursesRepository {
+	return &CoursesRepository{
+		courses:      make(map[string]*courseRecord),
+		taskVersions: make(map[taskVersionsKey][]course.TaskVersion),
+	}
+}
+
+func (r *CoursesRepository) AddCourse(_ context.Context, crs *course.Course) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.courses[crs.ID()]; ok {
+		return app.Wrap(app.ErrDatabaseProblems, errors.Errorf("course #%s already exists", crs.ID()))
+	}
+
+	r.courses[crs.ID()] = &courseRecord{course: copyCourse(crs)}
+	r.appendTaskVersions(crs)
+
+	return nil
+}
+
+func (r *CoursesRepository) GetCourse(_ context.Context, courseID string) (*course.Course, error) {
+	crs, _, err := r.getCourse(courseID)
+
+	return crs, err
+}
+
+func (r *CoursesRepository) getCourse(courseID string) (*course.Course, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	record, ok := r.courses[courseID]
+	if !ok {
+		return nil, 0, app.ErrCourseDoesntExist
+	}
+
+	return copyCourse(record.course), record.revision, nil
+}
+
+// UpdateCourse calls updateFn with copy of course and saves updated course only
+// if course wasn't changed meanwhile, otherwise update is tried again with fresh
+// copy like conflicting MongoDB transaction. Course isn't changed if updateFn fails.
+func (r *CoursesRepository) UpdateCourse(ctx context.Context, courseID string, updateFn command.UpdateFunction) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return app.Wrap(app.ErrDatabaseProblems, err)
+		}
+
+		crs, revision, err := r.getCourse(courseID)
+		if err != nil {
+			return err
+		}
+
+		updatedCourse, err := updateFn(ctx, crs)
+		if err != nil {
+			return err
+		}
+
+		if r.saveUpdatedCourse(courseID, revision, updatedCourse) {
+			return nil
+		}
+	}
+}
+
+func (r *CoursesRepository) saveUpdatedCourse(courseID string, revision int, updatedCourse *course.Course) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.courses[courseID]; !ok || record.revision != revision {
+		return false
+	}
+
+	r.courses[updatedCourse.ID()] = &courseRecord{course: copyCourse(updatedCourse), revision: revision + 1}
+	r.appendTaskVersions(updatedCourse)
+
+	return true
+}
+
+// appendTaskVersions appends new task versions of course
+// to their version history, it should be called under lock.
+func (r *CoursesRepository) appendTaskVersions(crs *course.Course) {
+	for _, v := range crs.NewTaskVersions() {
+		task := v.Task()
+		key := taskVersionsKey{courseID: crs.ID(), taskNumber: task.Number()}
+
+		r.taskVersions[key] = append(r.taskVersions[key], v)
+	}
+}
+
+func (r *CoursesRepository) GetTaskVersion(
+	_ context.Context,
+	courseID string, taskNumber, version int,
+) (course.TaskVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.taskVersion(courseID, taskNumber, version)
+}
+
+func (r *CoursesRepository) taskVersion(courseID string, taskNumber, version int) (course.TaskVersion, error) {
+	for _, v := range r.taskVersions[taskVersionsKey{courseID: courseID, taskNumber: taskNumber}] {
+		if v.Number() == version {
+			return v, nil
+		}
+	}
+
+	return course.TaskVersion{}, course.ErrTaskHasNoSuchVersion
+}
+
+func (r *CoursesRepository) FindInvitationCourseID(_ context.Context, code string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, id := range r.sortedCourseIDs() {
+		for _, invitation := range r.courses[id].course.Invitations() {
+			if invitation.Code() == code {
+				return id, nil
+			}
+		}
+	}
+
+	return "", app.ErrInvitationDoesntExist
+}
+
+func (r *CoursesRepository) FindAcademicCourseIDs(_ context.Context, academicID string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	courseIDs := make([]string, 0)
+
+	for _, id := range r.sortedCourseIDs() {
+		if hasAcademic(r.courses[id].course, academicID) {
+			courseIDs = append(courseIDs, id)
+		}
+	}
+
+	return courseIDs, nil
+}
+
+// hasAcademic reports whether academic is creator, collaborator,
+// student, waitlisted or has enrollment request.
+func hasAcademic(crs *course.Course, academicID string) bool {
+	if crs.CreatorID() == academicID ||
+		contains(crs.Collaborators(), academicID) ||
+		contains(crs.Students(), academicID) ||
+		crs.IsWaitlisted(academicID) {
+		return true
+	}
+
+	for _, request := range crs.EnrollmentRequests() {
+		if request.StudentID() == academicID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *CoursesRepository) FindCourse(
+	_ context.Context,
+	academic course.Academic,
+	courseID string,
+) (app.CommonCourse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.CommonCourse{}, err
+	}
+
+	return newCommonCourse(crs), nil
+}
+
+// FindAllCourses returns courses of academic sorted by IDs,
+// title is case-insensitive regular expression like in MongoDB.
+func (r *CoursesRepository) FindAllCourses(
+	_ context.Context,
+	academic course.Academic,
+	params query.CoursesFilterParams,
+) ([]app.CommonCourse, error) {
+	title, err := compileFilterRegexp(params.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	courses := make([]app.CommonCourse, 0)
+
+	for _, id := range r.sortedCourseIDs() {
+		crs := r.courses[id].course
+		if !isCourseForAcademic(crs, academic) || !matches(title, crs.Title()) {
+			continue
+		}
+
+		courses = append(courses, newCommonCourse(crs))
+	}
+
+	return courses, nil
+}
+
+func (r *CoursesRepository) FindTask(
+	_ context.Context,
+	academic course.Academic, courseID string, taskNumber int,
+) (app.SpecificTask, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, task, err := r.findTask(academic, courseID, taskNumber)
+	if err != nil {
+		return app.SpecificTask{}, err
+	}
+
+	specificTask := newSpecificTask(academic, &task)
+	specificTask.Materials = newTaskAuxiliaryMaterials(academic, crs.AuxiliaryMaterials(), taskNumber)
+
+	return specificTask, nil
+}
+
+func (r *CoursesRepository) FindTaskVersions(
+	_ context.Context,
+	academic course.Academic, courseID string, taskNumber int,
+) ([]app.GeneralTaskVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, _, err := r.findTask(academic, courseID, taskNumber); err != nil {
+		return nil, err
+	}
+
+	return newGeneralTaskVersions(r.taskVersions[taskVersionsKey{courseID: courseID, taskNumber: taskNumber}]), nil
+}
+
+func (r *CoursesRepository) FindTaskVersion(
+	_ context.Context,
+	academic course.Academic, courseID string, taskNumber, version int,
+) (app.TaskVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, _, err := r.findTask(academic, courseID, taskNumber); err != nil {
+		return app.TaskVersion{}, err
+	}
+
+	taskVersion, err := r.taskVersion(courseID, taskNumber, version)
+	if err != nil {
+		return app.TaskVersion{}, app.ErrTaskVersionDoesntExist
+	}
+
+	return newTaskVersion(academic, taskVersion), nil
+}
+
+func (r *CoursesRepository) findTask(
+	academic course.Academic, courseID string, taskNumber int,
+) (*course.Course, course.Task, error) {
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, course.Task{}, err
+	}
+
+	task, err := crs.Task(taskNumber)
+	if err != nil {
+		return nil, course.Task{}, app.ErrTaskDoesntExist
+	}
+
+	return crs, task, nil
+}
+
+func (r *CoursesRepository) FindSampleTestDataIDs(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sampleTestDataIDs(sortedTasks(crs)), nil
+}
+
+// FindAllTasks returns tasks sorted by numbers, text is case-insensitive regular
+// expression matching title or description like in MongoDB, invalid type is ignored.
+func (r *CoursesRepository) FindAllTasks(
+	_ context.Context,
+	academic course.Academic, courseID string,
+	filterParams query.TasksFilterParams,
+) ([]app.GeneralTask, error) {
+	text, err := compileFilterRegexp(filterParams.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []course.Task
+
+	for _, t := range sortedTasks(crs) {
+		if filterParams.Type.IsValid() && t.Type() != filterParams.Type {
+			continue
+		}
+
+		if !matches(text, t.Title()) && !matches(text, t.Description()) {
+			continue
+		}
+
+		tasks = append(tasks, t)
+	}
+
+	return newGeneralTasks(tasks), nil
+}
+
+func (r *CoursesRepository) FindAllAuxiliaryMaterials(
+	_ context.Context,
+	academic course.Academic, courseID string,
+	filterParams query.AuxiliaryMaterialsFilterParams,
+) ([]app.AuxiliaryMaterial, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	var materials []course.AuxiliaryMaterial
+
+	for _, m := range crs.AuxiliaryMaterials() {
+		if filterParams.ResourceType.IsValid() && m.ResourceType() != filterParams.ResourceType {
+			continue
+		}
+
+		if filterParams.TaskNumber != 0 && m.TaskNumber() != filterParams.TaskNumber {
+			continue
+		}
+
+		materials = append(materials, m)
+	}
+
+	return newQueryAuxiliaryMaterials(academic, materials), nil
+}
+
+func (r *CoursesRepository) FindAuxiliaryMaterial(
+	_ context.Context,
+	academic course.Academic, courseID, materialID string,
+) (app.AuxiliaryMaterial, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.AuxiliaryMaterial{}, err
+	}
+
+	material, err := crs.AuxiliaryMaterial(materialID)
+	if err != nil {
+		return app.AuxiliaryMaterial{}, app.ErrAuxiliaryMaterialDoesntExist
+	}
+
+	queryMaterial, ok := newQueryAuxiliaryMaterial(academic, material, time.Now())
+	if !ok {
+		return app.AuxiliaryMaterial{}, app.ErrAuxiliaryMaterialDoesntExist
+	}
+
+	return queryMaterial, nil
+}
+
+func (r *CoursesRepository) FindCourseAcademics(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) (app.CourseAcademics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.CourseAcademics{}, err
+	}
+
+	return app.CourseAcademics{
+		CreatorID:         crs.CreatorID(),
+		StudentIDs:        crs.Students(),
+		CollaboratorIDs:   crs.Collaborators(),
+		CollaboratorRoles: crs.CollaboratorRoles(),
+		Enrollments:       newQueryStudentEnrollments(crs.StudentEnrollments(true)),
+	}, nil
+}
+
+func (r *CoursesRepository) FindCourseContent(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) (app.CourseContent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.CourseContent{}, err
+	}
+
+	return app.CourseContent{
+		StudentIDs: crs.Students(),
+		Tasks:      newGeneralTasks(sortedTasks(crs)),
+		Materials:  newQueryAuxiliaryMaterials(academic, crs.AuxiliaryMaterials()),
+	}, nil
+}
+
+func (r *CoursesRepository) FindCourseInvitations(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) ([]app.Invitation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newQueryInvitations(crs.Invitations()), nil
+}
+
+func (r *CoursesRepository) FindEnrollmentRequests(
+	_ context.Context,
+	academic course.Academic, courseID string,
+	status course.EnrollmentRequestStatus,
+) ([]app.EnrollmentRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newQueryEnrollmentRequests(crs.EnrollmentRequests(), status), nil
+}
+
+func (r *CoursesRepository) FindCourseTeams(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) (app.Teams, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.Teams{}, err
+	}
+
+	return newQueryTeams(crs), nil
+}
+
+func (r *CoursesRepository) FindCourseWaitlist(
+	_ context.Context,
+	academic course.Academic, courseID string,
+) (app.Waitlist, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crs, err := r.findCourseForAcademic(academic, courseID)
+	if err != nil {
+		return app.Waitlist{}, err
+	}
+
+	return newQueryWaitlist(crs), nil
+}
+
+func (r *CoursesRepository) RemoveAllCourses(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.courses = make(map[string]*courseRecord)
+	r.taskVersions = make(map[taskVersionsKey][]course.TaskVersion)
+
+	return nil
+}
+
+// findCourseForAcademic returns kept course, it should be only read under lock.
+func (r *CoursesRepository) findCourseForAcademic(academic course.Academic, courseID string) (*course.Course, error) {
+	record, ok := r.courses[courseID]
+	if !ok || !isCourseForAcademic(record.course, academic) {
+		return nil, app.ErrCourseDoesntExist
+	}
+
+	return record.course, nil
+}
+
+// isCourseForAcademic reports whether student is in course
+// or teacher is creator or collaborator of course.
+func isCourseForAcademic(crs *course.Course, academic course.Academic) bool {
+	if academic.Type() == course.StudentType {
+		return contains(crs.Students(), academic.ID())
+	}
+
+	return crs.CreatorID() == academic.ID() || contains(crs.Collaborators(), academic.ID())
+}
+
+func (r *CoursesRepository) sortedCourseIDs() []string {
+	ids := make([]string, 0, len(r.courses))
+	for id := range r.courses {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// compileFilterRegexp returns nil for empty pattern, invalid
+// pattern is database problem like in MongoDB.
+func compileFilterRegexp(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return re, nil
+}
+
+func matches(re *regexp.Regexp, s string) bool {
+	return re == nil || re.MatchString(s)
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
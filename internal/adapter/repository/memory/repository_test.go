@@ -0,0 +1,459 @@
+package memory_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/repository/memory"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/query"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+const (
+	physicsID = "270d886e-097c-4334-8b0e-496464645dec"
+	mathID    = "47b36fbb-61e3-42fe-bb72-3908b909542f"
+)
+
+var (
+	creator  = course.MustNewAcademic("f7f02ce0-9d3f-4843-a379-a7773c29e7c2", course.TeacherType)
+	teacher  = course.MustNewAcademic("623bac67-d4b7-4474-8236-d4e5e3d2b374", course.TeacherType)
+	student  = course.MustNewAcademic("ad2ee15f-0805-42dc-8b7c-003c440e88c7", course.StudentType)
+	stranger = course.MustNewAcademic("1f5f202a-8935-44eb-9544-ae78c4e90d46", course.StudentType)
+)
+
+func newCourse(t *testing.T, id, title string) *course.Course {
+	t.Helper()
+
+	return course.MustNewCourse(course.CreationParams{
+		ID:            id,
+		Creator:       creator,
+		Title:         title,
+		Period:        course.MustNewPeriod(2021, 2022, course.FirstSemester),
+		Started:       true,
+		Collaborators: []string{teacher.ID()},
+		Students:      []string{student.ID()},
+	})
+}
+
+func newRepository(t *testing.T, courses ...*course.Course) *memory.CoursesRepository {
+	t.Helper()
+
+	repository := memory.NewCoursesRepository()
+	for _, crs := range courses {
+		require.NoError(t, repository.AddCourse(context.Background(), crs))
+	}
+
+	return repository
+}
+
+func TestCoursesRepository_FindCourse(t *testing.T) {
+	t.Parallel()
+
+	repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+
+	testCases := []struct {
+		Name        string
+		Academic    course.Academic
+		CourseID    string
+		ExpectedErr error
+	}{
+		{
+			Name:     "found_course_for_creator",
+			Academic: creator,
+			CourseID: physicsID,
+		},
+		{
+			Name:     "found_course_for_collaborator",
+			Academic: teacher,
+			CourseID: physicsID,
+		},
+		{
+			Name:     "found_course_for_student",
+			Academic: student,
+			CourseID: physicsID,
+		},
+		{
+			Name:        "not_found_course_for_another_academic",
+			Academic:    stranger,
+			CourseID:    physicsID,
+			ExpectedErr: app.ErrCourseDoesntExist,
+		},
+		{
+			Name:        "course_doesnt_exist",
+			Academic:    creator,
+			CourseID:    mathID,
+			ExpectedErr: app.ErrCourseDoesntExist,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			foundCourse, err := repository.FindCourse(context.Background(), c.Academic, c.CourseID)
+
+			if c.ExpectedErr != nil {
+				require.ErrorIs(t, err, c.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, physicsID, foundCourse.ID)
+			require.Equal(t, "Physics course", foundCourse.Title)
+		})
+	}
+}
+
+func TestCoursesRepository_FindAllCourses(t *testing.T) {
+	t.Parallel()
+
+	repository := newRepository(t,
+		newCourse(t, physicsID, "Physics course"),
+		newCourse(t, mathID, "Math course"),
+	)
+
+	testCases := []struct {
+		Name         string
+		Academic     course.Academic
+		FilterParams query.CoursesFilterParams
+		ExpectedIDs  []string
+		ExpectedErr  error
+	}{
+		{
+			Name:        "found_courses_for_teacher",
+			Academic:    teacher,
+			ExpectedIDs: []string{physicsID, mathID},
+		},
+		{
+			Name:        "not_found_courses_for_another_academic",
+			Academic:    stranger,
+			ExpectedIDs: []string{},
+		},
+		{
+			Name:         "found_courses_by_title_ignoring_case",
+			Academic:     student,
+			FilterParams: query.CoursesFilterParams{Title: "MATH"},
+			ExpectedIDs:  []string{mathID},
+		},
+		{
+			Name:         "found_courses_by_title_pattern",
+			Academic:     student,
+			FilterParams: query.CoursesFilterParams{Title: "^ph.*course$"},
+			ExpectedIDs:  []string{physicsID},
+		},
+		{
+			Name:         "invalid_title_pattern",
+			Academic:     student,
+			FilterParams: query.CoursesFilterParams{Title: "(math"},
+			ExpectedErr:  app.ErrDatabaseProblems,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			courses, err := repository.FindAllCourses(context.Background(), c.Academic, c.FilterParams)
+
+			if c.ExpectedErr != nil {
+				require.ErrorIs(t, err, c.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(courses))
+			for _, crs := range courses {
+				ids = append(ids, crs.ID)
+			}
+
+			require.ElementsMatch(t, c.ExpectedIDs, ids)
+		})
+	}
+}
+
+func TestCoursesRepository_FindAllTasks(t *testing.T) {
+	t.Parallel()
+
+	crs := newCourse(t, physicsID, "Physics course")
+	_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{
+		Title:       "Mechanics",
+		Description: "Newton laws",
+	}, time.Now())
+	require.NoError(t, err)
+	_, err = crs.AddTestingTask(creator, course.TestingTaskCreationParams{
+		Title:       "Optics quiz",
+		Description: "Lenses and mirrors",
+		TestPoints:  []course.TestPoint{course.MustNewTestPoint("Is light a wave?", []string{"Yes", "No"}, []int{1})},
+	}, time.Now())
+	require.NoError(t, err)
+
+	repository := newRepository(t, crs)
+
+	testCases := []struct {
+		Name            string
+		Academic        course.Academic
+		FilterParams    query.TasksFilterParams
+		ExpectedNumbers []int
+		ExpectedErr     error
+	}{
+		{
+			Name:            "found_all_tasks",
+			Academic:        student,
+			ExpectedNumbers: []int{1, 2},
+		},
+		{
+			Name:            "found_tasks_by_title",
+			Academic:        teacher,
+			FilterParams:    query.TasksFilterParams{Text: "mechanics"},
+			ExpectedNumbers: []int{1},
+		},
+		{
+			Name:            "found_tasks_by_description",
+			Academic:        teacher,
+			FilterParams:    query.TasksFilterParams{Text: "MIRROR"},
+			ExpectedNumbers: []int{2},
+		},
+		{
+			Name:            "found_tasks_by_type",
+			Academic:        teacher,
+			FilterParams:    query.TasksFilterParams{Type: course.TestingType},
+			ExpectedNumbers: []int{2},
+		},
+		{
+			Name:            "not_found_tasks_by_text_and_type",
+			Academic:        teacher,
+			FilterParams:    query.TasksFilterParams{Text: "newton", Type: course.TestingType},
+			ExpectedNumbers: []int{},
+		},
+		{
+			Name:        "course_for_another_academic",
+			Academic:    stranger,
+			ExpectedErr: app.ErrCourseDoesntExist,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tasks, err := repository.FindAllTasks(context.Background(), c.Academic, physicsID, c.FilterParams)
+
+			if c.ExpectedErr != nil {
+				require.ErrorIs(t, err, c.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			numbers := make([]int, 0, len(tasks))
+			for _, task := range tasks {
+				numbers = append(numbers, task.Number)
+			}
+
+			require.Equal(t, c.ExpectedNumbers, numbers)
+		})
+	}
+}
+
+func TestCoursesRepository_FindTask(t *testing.T) {
+	t.Parallel()
+
+	crs := newCourse(t, physicsID, "Physics course")
+	_, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{
+		Title:      "Optics quiz",
+		TestPoints: []course.TestPoint{course.MustNewTestPoint("Is light a wave?", []string{"Yes", "No"}, []int{1})},
+	}, time.Now())
+	require.NoError(t, err)
+
+	repository := newRepository(t, crs)
+	ctx := context.Background()
+
+	teacherTask, err := repository.FindTask(ctx, teacher, physicsID, 1)
+	require.NoError(t, err)
+	require.Equal(t, "Optics quiz", teacherTask.Title)
+	require.Equal(t, []int{1}, teacherTask.Points[0].CorrectVariantNumbers)
+
+	studentTask, err := repository.FindTask(ctx, student, physicsID, 1)
+	require.NoError(t, err)
+	require.Nil(t, studentTask.Points[0].CorrectVariantNumbers, "student shouldn't see correct variants")
+
+	_, err = repository.FindTask(ctx, student, physicsID, 2)
+	require.ErrorIs(t, err, app.ErrTaskDoesntExist)
+}
+
+func TestCoursesRepository_UpdateCourse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errUpdate := errors.New("update failed")
+
+	t.Run("updated_course", func(t *testing.T) {
+		t.Parallel()
+
+		repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+
+		err := repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+			return crs, crs.AddStudents(creator, time.Now(), stranger.ID())
+		})
+		require.NoError(t, err)
+
+		_, err = repository.FindCourse(ctx, stranger, physicsID)
+		require.NoError(t, err)
+	})
+
+	t.Run("failed_update_doesnt_change_course", func(t *testing.T) {
+		t.Parallel()
+
+		crs := newCourse(t, physicsID, "Physics course")
+		_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{Title: "Mechanics"}, time.Now())
+		require.NoError(t, err)
+
+		repository := newRepository(t, crs)
+
+		err = repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+			require.NoError(t, crs.RenameTask(creator, 1, "Kinematics", time.Now()))
+			require.NoError(t, crs.AddStudents(creator, time.Now(), stranger.ID()))
+
+			return nil, errUpdate
+		})
+		require.ErrorIs(t, err, errUpdate)
+
+		task, err := repository.FindTask(ctx, teacher, physicsID, 1)
+		require.NoError(t, err)
+		require.Equal(t, "Mechanics", task.Title)
+
+		_, err = repository.FindCourse(ctx, stranger, physicsID)
+		require.ErrorIs(t, err, app.ErrCourseDoesntExist)
+	})
+
+	t.Run("got_course_doesnt_change_kept_course", func(t *testing.T) {
+		t.Parallel()
+
+		repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+
+		crs, err := repository.GetCourse(ctx, physicsID)
+		require.NoError(t, err)
+		require.NoError(t, crs.AddStudents(creator, time.Now(), stranger.ID()))
+
+		_, err = repository.FindCourse(ctx, stranger, physicsID)
+		require.ErrorIs(t, err, app.ErrCourseDoesntExist)
+	})
+
+	t.Run("course_keeps_enrollment_history", func(t *testing.T) {
+		t.Parallel()
+
+		repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+		enrolledAt := time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC)
+
+		err := repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+			require.NoError(t, crs.AddStudents(creator, enrolledAt, stranger.ID()))
+			require.NoError(t, crs.RemoveStudent(creator, stranger.ID(), "moved", enrolledAt.AddDate(0, 1, 0)))
+
+			return crs, crs.AddStudents(creator, enrolledAt.AddDate(0, 2, 0), stranger.ID())
+		})
+		require.NoError(t, err)
+
+		crs, err := repository.GetCourse(ctx, physicsID)
+		require.NoError(t, err)
+
+		history := crs.StudentEnrollmentHistory(stranger.ID())
+		require.Len(t, history, 2)
+		require.Equal(t, course.WithdrawnStudent, history[0].Status())
+		require.Equal(t, course.ActiveStudent, history[1].Status())
+	})
+
+	t.Run("task_versions_are_appended_to_history", func(t *testing.T) {
+		t.Parallel()
+
+		repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+
+		for _, title := range []string{"Mechanics", "Optics"} {
+			title := title
+			err := repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+				if crs.TasksNumber() == 0 {
+					_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{Title: title}, time.Now())
+
+					return crs, err
+				}
+
+				return crs, crs.RenameTask(creator, 1, title, time.Now())
+			})
+			require.NoError(t, err)
+		}
+
+		versions, err := repository.FindTaskVersions(ctx, creator, physicsID, 1)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		require.Equal(t, "Mechanics", versions[0].Title)
+		require.Equal(t, "Optics", versions[1].Title)
+
+		version, err := repository.GetTaskVersion(ctx, physicsID, 1, 1)
+		require.NoError(t, err)
+		task := version.Task()
+		require.Equal(t, "Mechanics", task.Title())
+
+		_, err = repository.GetTaskVersion(ctx, physicsID, 1, 3)
+		require.ErrorIs(t, err, course.ErrTaskHasNoSuchVersion)
+	})
+
+	t.Run("course_doesnt_exist", func(t *testing.T) {
+		t.Parallel()
+
+		repository := newRepository(t)
+
+		err := repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+			return crs, nil
+		})
+		require.ErrorIs(t, err, app.ErrCourseDoesntExist)
+	})
+}
+
+func TestCoursesRepository_UpdateCourse_concurrently(t *testing.T) {
+	t.Parallel()
+
+	const updates = 50
+
+	repository := newRepository(t, newCourse(t, physicsID, "Physics course"))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, updates)
+
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = repository.UpdateCourse(ctx, physicsID, func(_ context.Context, crs *course.Course) (*course.Course, error) {
+				_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{Title: "Task"}, time.Now())
+
+				return crs, err
+			})
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	found, err := repository.FindCourse(ctx, teacher, physicsID)
+	require.NoError(t, err)
+	require.Equal(t, updates, found.TasksNumber, "no update should be lost")
+}
@@ -7,14 +7,91 @@ import (
 )
 
 type courseDocument struct {
-	ID            string         `bson:"_id,omitempty"`
-	Title         string         `bson:"title"`
-	Period        periodDocument `bson:"period"`
-	Started       bool           `bson:"started"`
-	CreatorID     string         `bson:"creatorId"`
-	Collaborators []string       `bson:"collaborators,omitempty"`
-	Students      []string       `bson:"students,omitempty"`
-	Tasks         []taskDocument `bson:"tasks,omitempty"`
+	ID            string                             `bson:"_id,omitempty"`
+	Title         string                             `bson:"title"`
+	Period        periodDocument                     `bson:"period"`
+	Started       bool                               `bson:"started"`
+	CreatorID     string                             `bson:"creatorId"`
+	Collaborators []string                           `bson:"collaborators,omitempty"`
+	Roles         map[string]course.CollaboratorRole `bson:"collaboratorRoles,omitempty"`
+	Students      []string                           `bson:"students,omitempty"`
+	Enrollments   []studentEnrollmentDocument        `bson:"studentEnrollments,omitempty"`
+	Groups        []string                           `bson:"groups,omitempty"`
+	StudentGroups map[string]string                  `bson:"studentGroups,omitempty"`
+	Invitations   []invitationDocument               `bson:"invitations,omitempty"`
+	Requests      []enrollmentRequestDocument        `bson:"enrollmentRequests,omitempty"`
+	Teams         []teamDocument                     `bson:"teams,omitempty"`
+	TeamSettings  teamSettingsDocument               `bson:"teamSettings"`
+	Capacity      int                                `bson:"capacity,omitempty"`
+	Waitlist      []waitlistEntryDocument            `bson:"waitlist,omitempty"`
+	Promotions    []waitlistPromotionDocument        `bson:"waitlistPromotions,omitempty"`
+	Tasks         []taskDocument                     `bson:"tasks,omitempty"`
+	Materials     []auxiliaryMaterialDocument        `bson:"auxiliaryMaterials,omitempty"`
+}
+
+type invitationDocument struct {
+	Code      string              `bson:"code"`
+	Role      course.AcademicType `bson:"role"`
+	ExpiresAt time.Time           `bson:"expiresAt"`
+	MaxUses   int                 `bson:"maxUses"`
+	Uses      int                 `bson:"uses"`
+}
+
+type enrollmentRequestDocument struct {
+	ID         string                         `bson:"id"`
+	StudentID  string                         `bson:"studentId"`
+	Message    string                         `bson:"message,omitempty"`
+	Status     course.EnrollmentRequestStatus `bson:"status"`
+	CreatedAt  time.Time                      `bson:"createdAt"`
+	ReviewerID string                         `bson:"reviewerId,omitempty"`
+	ReviewedAt *time.Time                     `bson:"reviewedAt,omitempty"`
+}
+
+type teamDocument struct {
+	ID      string   `bson:"id"`
+	Name    string   `bson:"name"`
+	Members []string `bson:"members,omitempty"`
+}
+
+type teamSettingsDocument struct {
+	SelfFormation bool `bson:"selfFormation"`
+	MaxSize       int  `bson:"maxSize"`
+}
+
+type studentEnrollmentDocument struct {
+	StudentID   string               `bson:"studentId"`
+	Status      course.StudentStatus `bson:"status"`
+	EnrolledAt  *time.Time           `bson:"enrolledAt,omitempty"`
+	WithdrawnAt *time.Time           `bson:"withdrawnAt,omitempty"`
+	Reason      string               `bson:"reason,omitempty"`
+}
+
+type waitlistEntryDocument struct {
+	StudentID    string    `bson:"studentId"`
+	WaitlistedAt time.Time `bson:"waitlistedAt"`
+}
+
+type waitlistPromotionDocument struct {
+	StudentID    string    `bson:"studentId"`
+	WaitlistedAt time.Time `bson:"waitlistedAt"`
+	PromotedAt   time.Time `bson:"promotedAt"`
+}
+
+type auxiliaryMaterialDocument struct {
+	ID           string                `bson:"id"`
+	Resource     string                `bson:"resource"`
+	ResourceType course.ResourceType   `bson:"resourceType"`
+	File         *materialFileDocument `bson:"file,omitempty"`
+	TaskNumber   int                   `bson:"taskNumber,omitempty"`
+	ReleaseTime  *time.Time            `bson:"releaseTime,omitempty"`
+	Hidden       bool                  `bson:"hidden,omitempty"`
+}
+
+type materialFileDocument struct {
+	Key         string `bson:"key"`
+	Name        string `bson:"name"`
+	ContentType string `bson:"contentType"`
+	Size        int64  `bson:"size"`
 }
 
 type periodDocument struct {
@@ -28,9 +105,36 @@ type taskDocument struct {
 	Title       string              `bson:"title"`
 	Description string              `bson:"description"`
 	Type        course.TaskType     `bson:"type"`
+	TeamWork    bool                `bson:"teamWork,omitempty"`
 	Deadline    *deadlineDocument   `bson:"deadline,omitempty"`
 	TestPoints  []testPointDocument `bson:"testPoints,omitempty"`
 	TestData    []testDataDocument  `bson:"testData,omitempty"`
+	Checker     *checkerDocument    `bson:"checker,omitempty"`
+	Languages   []string            `bson:"languages,omitempty"`
+	Limits      *limitsDocument     `bson:"limits,omitempty"`
+	Version     int                 `bson:"version,omitempty"`
+}
+
+// taskVersionDocument is kept in own collection, so
+// version history doesn't grow course document.
+type taskVersionDocument struct {
+	CourseID   string       `bson:"courseId"`
+	TaskNumber int          `bson:"taskNumber"`
+	Version    int          `bson:"version"`
+	AuthorID   string       `bson:"authorId"`
+	CreatedAt  time.Time    `bson:"createdAt"`
+	Task       taskDocument `bson:"task"`
+}
+
+type limitsDocument struct {
+	TimeLimit     time.Duration `bson:"timeLimit"`
+	MemoryLimit   int64         `bson:"memoryLimit"`
+	MaxSourceSize int           `bson:"maxSourceSize"`
+}
+
+type checkerDocument struct {
+	Mode      course.CheckerMode `bson:"mode"`
+	Tolerance float64            `bson:"tolerance,omitempty"`
 }
 
 type deadlineDocument struct {
@@ -45,8 +149,12 @@ type testPointDocument struct {
 }
 
 type testDataDocument struct {
-	InputData  string `bson:"inputData"`
-	OutputData string `bson:"outputData"`
+	InputData    string                    `bson:"inputData"`
+	OutputData   string                    `bson:"outputData"`
+	InputDataID  string                    `bson:"inputDataId,omitempty"`
+	OutputDataID string                    `bson:"outputDataId,omitempty"`
+	Visibility   course.TestDataVisibility `bson:"visibility"`
+	Explanation  string                    `bson:"explanation,omitempty"`
 }
 
 func marshalCourseDocument(crs *course.Course) courseDocument {
@@ -61,8 +169,160 @@ func marshalCourseDocument(crs *course.Course) courseDocument {
 		Started:       crs.Started(),
 		CreatorID:     crs.CreatorID(),
 		Collaborators: crs.Collaborators(),
+		Roles:         crs.CollaboratorRoles(),
 		Students:      crs.Students(),
+		Enrollments:   marshalStudentEnrollmentDocuments(crs.AllStudentEnrollments()),
+		Groups:        crs.Groups(),
+		StudentGroups: crs.StudentGroups(),
+		Invitations:   marshalInvitationDocuments(crs.Invitations()),
+		Requests:      marshalEnrollmentRequestDocuments(crs.EnrollmentRequests()),
+		Teams:         marshalTeamDocuments(crs.Teams()),
+		TeamSettings:  marshalTeamSettingsDocument(crs.TeamSettings()),
+		Capacity:      crs.Capacity(),
+		Waitlist:      marshalWaitlistEntryDocuments(crs.Waitlist()),
+		Promotions:    marshalWaitlistPromotionDocuments(crs.WaitlistPromotions()),
 		Tasks:         marshalTaskDocuments(crs.Tasks()),
+		Materials:     marshalAuxiliaryMaterialDocuments(crs.AuxiliaryMaterials()),
+	}
+}
+
+func marshalInvitationDocuments(invitations []course.Invitation) []invitationDocument {
+	invitationDocuments := make([]invitationDocument, 0, len(invitations))
+	for _, i := range invitations {
+		invitationDocuments = append(invitationDocuments, invitationDocument{
+			Code:      i.Code(),
+			Role:      i.Role(),
+			ExpiresAt: i.ExpiresAt(),
+			MaxUses:   i.MaxUses(),
+			Uses:      i.Uses(),
+		})
+	}
+
+	return invitationDocuments
+}
+
+func marshalEnrollmentRequestDocuments(requests []course.EnrollmentRequest) []enrollmentRequestDocument {
+	requestDocuments := make([]enrollmentRequestDocument, 0, len(requests))
+	for _, r := range requests {
+		requestDocuments = append(requestDocuments, enrollmentRequestDocument{
+			ID:         r.ID(),
+			StudentID:  r.StudentID(),
+			Message:    r.Message(),
+			Status:     r.Status(),
+			CreatedAt:  r.CreatedAt(),
+			ReviewerID: r.ReviewerID(),
+			ReviewedAt: marshalOptionalTime(r.ReviewedAt()),
+		})
+	}
+
+	return requestDocuments
+}
+
+func marshalTeamDocuments(teams []course.Team) []teamDocument {
+	teamDocuments := make([]teamDocument, 0, len(teams))
+	for _, t := range teams {
+		teamDocuments = append(teamDocuments, teamDocument{
+			ID:      t.ID(),
+			Name:    t.Name(),
+			Members: t.Members(),
+		})
+	}
+
+	return teamDocuments
+}
+
+func marshalTeamSettingsDocument(settings course.TeamSettings) teamSettingsDocument {
+	return teamSettingsDocument{
+		SelfFormation: settings.SelfFormation(),
+		MaxSize:       settings.MaxSize(),
+	}
+}
+
+func marshalStudentEnrollmentDocuments(enrollments []course.StudentEnrollment) []studentEnrollmentDocument {
+	enrollmentDocuments := make([]studentEnrollmentDocument, 0, len(enrollments))
+	for _, e := range enrollments {
+		enrollmentDocuments = append(enrollmentDocuments, studentEnrollmentDocument{
+			StudentID:   e.StudentID(),
+			Status:      e.Status(),
+			EnrolledAt:  marshalOptionalTime(e.EnrolledAt()),
+			WithdrawnAt: marshalOptionalTime(e.WithdrawnAt()),
+			Reason:      e.Reason(),
+		})
+	}
+
+	return enrollmentDocuments
+}
+
+func marshalWaitlistEntryDocuments(entries []course.WaitlistEntry) []waitlistEntryDocument {
+	entryDocuments := make([]waitlistEntryDocument, 0, len(entries))
+	for _, e := range entries {
+		entryDocuments = append(entryDocuments, waitlistEntryDocument{
+			StudentID:    e.StudentID(),
+			WaitlistedAt: e.WaitlistedAt(),
+		})
+	}
+
+	return entryDocuments
+}
+
+func marshalWaitlistPromotionDocuments(promotions []course.WaitlistPromotion) []waitlistPromotionDocument {
+	promotionDocuments := make([]waitlistPromotionDocument, 0, len(promotions))
+	for _, p := range promotions {
+		promotionDocuments = append(promotionDocuments, waitlistPromotionDocument{
+			StudentID:    p.StudentID(),
+			WaitlistedAt: p.WaitlistedAt(),
+			PromotedAt:   p.PromotedAt(),
+		})
+	}
+
+	return promotionDocuments
+}
+
+func marshalAuxiliaryMaterialDocuments(materials []course.AuxiliaryMaterial) []auxiliaryMaterialDocument {
+	materialDocuments := make([]auxiliaryMaterialDocument, 0, len(materials))
+	for _, m := range materials {
+		materialDocuments = append(materialDocuments, auxiliaryMaterialDocument{
+			ID:           m.ID(),
+			Resource:     m.Resource(),
+			ResourceType: m.ResourceType(),
+			File:         marshalMaterialFileDocument(m),
+			TaskNumber:   m.TaskNumber(),
+			ReleaseTime:  marshalReleaseTime(m.ReleaseTime()),
+			Hidden:       m.Hidden(),
+		})
+	}
+
+	return materialDocuments
+}
+
+func marshalReleaseTime(releaseTime time.Time) *time.Time {
+	if releaseTime.IsZero() {
+		return nil
+	}
+
+	return &releaseTime
+}
+
+// marshalOptionalTime omits zero time of not happened event.
+func marshalOptionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func marshalMaterialFileDocument(material course.AuxiliaryMaterial) *materialFileDocument {
+	file, ok := material.File()
+	if !ok {
+		return nil
+	}
+
+	return &materialFileDocument{
+		Key:         file.Key(),
+		Name:        file.Name(),
+		ContentType: file.ContentType(),
+		Size:        file.Size(),
 	}
 }
 
@@ -70,39 +330,91 @@ func marshalTaskDocuments(tasks []course.Task) []taskDocument {
 	taskDocuments := make([]taskDocument, 0, len(tasks))
 
 	for _, t := range tasks {
-		deadline, _ := t.Deadline()
-
-		var deadlineDoc *deadlineDocument
-		if !deadline.IsZero() {
-			deadlineDoc = &deadlineDocument{
-				GoodGradeTime:      deadline.GoodGradeTime(),
-				ExcellentGradeTime: deadline.ExcellentGradeTime(),
-			}
-		}
+		document := marshalTaskDocument(t)
+		document.Version = t.Version()
 
-		testData, _ := t.TestData()
-		testPoints, _ := t.TestPoints()
-
-		taskDocuments = append(taskDocuments, taskDocument{
-			Number:      t.Number(),
-			Title:       t.Title(),
-			Description: t.Description(),
-			Type:        t.Type(),
-			Deadline:    deadlineDoc,
-			TestData:    marshalTestDataDocuments(testData),
-			TestPoints:  marshalTestPointDocuments(testPoints),
-		})
+		taskDocuments = append(taskDocuments, document)
 	}
 
 	return taskDocuments
 }
 
+func marshalTaskVersionDocuments(courseID string, versions []course.TaskVersion) []interface{} {
+	versionDocuments := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		task := v.Task()
+
+		versionDocuments = append(versionDocuments, taskVersionDocument{
+			CourseID:   courseID,
+			TaskNumber: task.Number(),
+			Version:    v.Number(),
+			AuthorID:   v.AuthorID(),
+			CreatedAt:  v.CreatedAt(),
+			Task:       marshalTaskDocument(task),
+		})
+	}
+
+	return versionDocuments
+}
+
+func marshalTaskDocument(t course.Task) taskDocument {
+	deadline, _ := t.Deadline()
+
+	var deadlineDoc *deadlineDocument
+	if !deadline.IsZero() {
+		deadlineDoc = &deadlineDocument{
+			GoodGradeTime:      deadline.GoodGradeTime(),
+			ExcellentGradeTime: deadline.ExcellentGradeTime(),
+		}
+	}
+
+	testData, _ := t.TestData()
+	testPoints, _ := t.TestPoints()
+
+	var checkerDoc *checkerDocument
+	if checker, ok := t.Checker(); ok {
+		checkerDoc = &checkerDocument{
+			Mode:      checker.Mode(),
+			Tolerance: checker.Tolerance(),
+		}
+	}
+
+	languages, _ := t.Languages()
+
+	var limitsDoc *limitsDocument
+	if limits, ok := t.ExecutionLimits(); ok {
+		limitsDoc = &limitsDocument{
+			TimeLimit:     limits.TimeLimit(),
+			MemoryLimit:   limits.MemoryLimit(),
+			MaxSourceSize: limits.MaxSourceSize(),
+		}
+	}
+
+	return taskDocument{
+		Number:      t.Number(),
+		Title:       t.Title(),
+		Description: t.Description(),
+		Type:        t.Type(),
+		TeamWork:    t.TeamWork(),
+		Deadline:    deadlineDoc,
+		TestData:    marshalTestDataDocuments(testData),
+		Checker:     checkerDoc,
+		Languages:   languages,
+		Limits:      limitsDoc,
+		TestPoints:  marshalTestPointDocuments(testPoints),
+	}
+}
+
 func marshalTestDataDocuments(testData []course.TestData) []testDataDocument {
 	testDataDocuments := make([]testDataDocument, 0, len(testData))
 	for _, td := range testData {
 		testDataDocuments = append(testDataDocuments, testDataDocument{
-			InputData:  td.InputData(),
-			OutputData: td.OutputData(),
+			InputData:    td.InputData(),
+			OutputData:   td.OutputData(),
+			InputDataID:  td.InputDataID(),
+			OutputDataID: td.OutputDataID(),
+			Visibility:   td.Visibility(),
+			Explanation:  td.Explanation(),
 		})
 	}
 
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,13 +17,20 @@ import (
 )
 
 type CoursesRepository struct {
-	courses *mongo.Collection
+	courses      *mongo.Collection
+	taskVersions *mongo.Collection
 }
 
-const coursesCollection = "courses"
+const (
+	coursesCollection      = "courses"
+	taskVersionsCollection = "taskVersions"
+)
 
 func NewCoursesRepository(db *mongo.Database) *CoursesRepository {
-	return &CoursesRepository{courses: db.Collection(coursesCollection)}
+	return &CoursesRepository{
+		courses:      db.Collection(coursesCollection),
+		taskVersions: db.Collection(taskVersionsCollection),
+	}
 }
 
 func (r *CoursesRepository) FindCourse(
@@ -80,9 +88,22 @@ func makeFindCoursesTitleFilter(filterParams query.CoursesFilterParams) bson.E {
 }
 
 func (r *CoursesRepository) AddCourse(ctx context.Context, crs *course.Course) error {
-	_, err := r.courses.InsertOne(ctx, marshalCourseDocument(crs))
+	session, err := r.courses.Database().Client().StartSession()
+	if err != nil {
+		return app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if _, err := r.courses.InsertOne(sessCtx, marshalCourseDocument(crs)); err != nil {
+			return nil, app.Wrap(app.ErrDatabaseProblems, err)
+		}
 
-	return app.Wrap(app.ErrDatabaseProblems, err)
+		return nil, r.appendTaskVersions(sessCtx, crs)
+	})
+
+	return err
 }
 
 func (r *CoursesRepository) GetCourse(ctx context.Context, courseID string) (*course.Course, error) {
@@ -108,7 +129,7 @@ func (r *CoursesRepository) UpdateCourse(ctx context.Context, courseID string, u
 
 	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
 		var document courseDocument
-		if err := r.courses.FindOne(ctx, bson.M{"_id": courseID}).Decode(&document); err != nil {
+		if err := r.courses.FindOne(sessCtx, bson.M{"_id": courseID}).Decode(&document); err != nil {
 			if errors.Is(err, mongo.ErrNoDocuments) {
 				return nil, app.Wrap(app.ErrCourseDoesntExist, err)
 			}
@@ -117,7 +138,7 @@ func (r *CoursesRepository) UpdateCourse(ctx context.Context, courseID string, u
 		}
 
 		crs := unmarshalCourse(document)
-		updatedCourse, err := updateFn(ctx, crs)
+		updatedCourse, err := updateFn(sessCtx, crs)
 		if err != nil {
 			return nil, err
 		}
@@ -125,38 +146,162 @@ func (r *CoursesRepository) UpdateCourse(ctx context.Context, courseID string, u
 
 		replaceOpt := options.Replace().SetUpsert(true)
 		filter := bson.M{"_id": updatedCourseDocument.ID}
-		if _, err := r.courses.ReplaceOne(ctx, filter, updatedCourseDocument, replaceOpt); err != nil {
+		if _, err := r.courses.ReplaceOne(sessCtx, filter, updatedCourseDocument, replaceOpt); err != nil {
 			return nil, app.Wrap(app.ErrDatabaseProblems, err)
 		}
 
-		return nil, nil
+		return nil, r.appendTaskVersions(sessCtx, updatedCourse)
 	})
 
 	return err
 }
 
+// appendTaskVersions inserts new task versions of course, version
+// documents are never changed, so version history is append-only.
+func (r *CoursesRepository) appendTaskVersions(ctx context.Context, crs *course.Course) error {
+	versions := crs.NewTaskVersions()
+	if len(versions) == 0 {
+		return nil
+	}
+
+	if _, err := r.taskVersions.InsertMany(ctx, marshalTaskVersionDocuments(crs.ID(), versions)); err != nil {
+		return app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return nil
+}
+
+func (r *CoursesRepository) GetTaskVersion(
+	ctx context.Context,
+	courseID string, taskNumber, version int,
+) (course.TaskVersion, error) {
+	document, err := r.findTaskVersionDocument(ctx, courseID, taskNumber, version)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return course.TaskVersion{}, course.ErrTaskHasNoSuchVersion
+		}
+
+		return course.TaskVersion{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalDomainTaskVersion(document), nil
+}
+
+func (r *CoursesRepository) findTaskVersionDocument(
+	ctx context.Context,
+	courseID string, taskNumber, version int,
+) (taskVersionDocument, error) {
+	filter := bson.D{
+		{Key: "courseId", Value: courseID},
+		{Key: "taskNumber", Value: taskNumber},
+		{Key: "version", Value: version},
+	}
+
+	var document taskVersionDocument
+	err := r.taskVersions.FindOne(ctx, filter).Decode(&document)
+
+	return document, err
+}
+
 func (r *CoursesRepository) FindTask(
 	ctx context.Context,
 	academic course.Academic, courseID string, taskNumber int,
 ) (app.SpecificTask, error) {
+	projection := append(makeFindTaskProjection(taskNumber), bson.E{Key: "auxiliaryMaterials", Value: 1})
+
+	document, err := r.findTaskCourseDocument(ctx, academic, courseID, projection)
+	if err != nil {
+		return app.SpecificTask{}, err
+	}
+
+	task := unmarshalSpecificTask(academic, document.Tasks[0])
+	task.Materials = unmarshalTaskAuxiliaryMaterials(academic, document.Materials, taskNumber)
+
+	return task, nil
+}
+
+func (r *CoursesRepository) FindTaskVersions(
+	ctx context.Context,
+	academic course.Academic, courseID string, taskNumber int,
+) ([]app.GeneralTaskVersion, error) {
+	if _, err := r.findTaskDocument(ctx, academic, courseID, taskNumber); err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "courseId", Value: courseID}, {Key: "taskNumber", Value: taskNumber}}
+	findOpt := options.Find().
+		SetProjection(bson.D{{Key: "task.description", Value: 0}}).
+		SetSort(bson.D{{Key: "version", Value: 1}})
+
+	cursor, err := r.taskVersions.Find(ctx, filter, findOpt)
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	var documents []taskVersionDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalGeneralTaskVersions(documents), nil
+}
+
+func (r *CoursesRepository) FindTaskVersion(
+	ctx context.Context,
+	academic course.Academic, courseID string, taskNumber, version int,
+) (app.TaskVersion, error) {
+	if _, err := r.findTaskDocument(ctx, academic, courseID, taskNumber); err != nil {
+		return app.TaskVersion{}, err
+	}
+
+	document, err := r.findTaskVersionDocument(ctx, courseID, taskNumber, version)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.TaskVersion{}, app.Wrap(app.ErrTaskVersionDoesntExist, err)
+		}
+
+		return app.TaskVersion{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalTaskVersion(academic, document), nil
+}
+
+func (r *CoursesRepository) findTaskDocument(
+	ctx context.Context,
+	academic course.Academic, courseID string, taskNumber int,
+) (taskDocument, error) {
+	document, err := r.findTaskCourseDocument(ctx, academic, courseID, makeFindTaskProjection(taskNumber))
+	if err != nil {
+		return taskDocument{}, err
+	}
+
+	return document.Tasks[0], nil
+}
+
+// findTaskCourseDocument returns course document with
+// projection that should keep only one task of course.
+func (r *CoursesRepository) findTaskCourseDocument(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+	projection bson.D,
+) (courseDocument, error) {
 	filter := makeCourseForAcademicFilter(academic, courseID)
-	projection := makeFindTaskProjection(taskNumber)
 	findOpt := options.FindOne().SetProjection(projection)
 
 	var document courseDocument
 	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return app.SpecificTask{}, app.Wrap(app.ErrCourseDoesntExist, err)
+			return courseDocument{}, app.Wrap(app.ErrCourseDoesntExist, err)
 		}
 
-		return app.SpecificTask{}, app.Wrap(app.ErrDatabaseProblems, err)
+		return courseDocument{}, app.Wrap(app.ErrDatabaseProblems, err)
 	}
 
 	if len(document.Tasks) == 0 {
-		return app.SpecificTask{}, app.ErrTaskDoesntExist
+		return courseDocument{}, app.ErrTaskDoesntExist
 	}
 
-	return unmarshalSpecificTask(academic, document.Tasks[0]), nil
+	return document, nil
 }
 
 func makeFindTaskProjection(taskNumber int) bson.D {
@@ -171,6 +316,25 @@ func makeFindTaskProjection(taskNumber int) bson.D {
 	}}
 }
 
+func (r *CoursesRepository) FindSampleTestDataIDs(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) ([]string, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	findOpt := options.FindOne().SetProjection(bson.D{{Key: "tasks.testData", Value: 1}})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalSampleTestDataIDs(document.Tasks), nil
+}
+
 func (r *CoursesRepository) FindAllTasks(
 	ctx context.Context,
 	academic course.Academic, courseID string,
@@ -259,6 +423,307 @@ func makeFindAllTasksTypeFilter(filterParams query.TasksFilterParams) bson.D {
 	}}
 }
 
+func (r *CoursesRepository) FindAllAuxiliaryMaterials(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+	filterParams query.AuxiliaryMaterialsFilterParams,
+) ([]app.AuxiliaryMaterial, error) {
+	pipeline := makeFindAllAuxiliaryMaterialsPipeline(academic, courseID, filterParams)
+
+	cursor, err := r.courses.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	if !cursor.Next(ctx) {
+		return nil, app.ErrCourseDoesntExist
+	}
+
+	var document courseDocument
+	if err := cursor.Decode(&document); err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalQueryAuxiliaryMaterials(academic, document.Materials), nil
+}
+
+func makeFindAllAuxiliaryMaterialsPipeline(
+	academic course.Academic,
+	courseID string,
+	filterParams query.AuxiliaryMaterialsFilterParams,
+) mongo.Pipeline {
+	matchStage := bson.D{{Key: "$match", Value: makeCourseForAcademicFilter(academic, courseID)}}
+	projectStage := bson.D{{
+		Key: "$project", Value: bson.D{{
+			Key: "auxiliaryMaterials", Value: bson.D{{
+				Key: "$filter", Value: bson.D{
+					{Key: "input", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$auxiliaryMaterials", bson.A{}}}}},
+					{
+						Key: "cond",
+						Value: bson.D{{
+							Key: "$and", Value: bson.A{
+								makeFindAllAuxiliaryMaterialsTypeFilter(filterParams),
+								makeFindAllAuxiliaryMaterialsTaskFilter(filterParams),
+							},
+						}},
+					},
+				},
+			}},
+		}},
+	}}
+
+	return mongo.Pipeline{matchStage, projectStage}
+}
+
+func makeFindAllAuxiliaryMaterialsTypeFilter(filterParams query.AuxiliaryMaterialsFilterParams) bson.D {
+	if !filterParams.ResourceType.IsValid() {
+		return bson.D{{Key: "$literal", Value: true}}
+	}
+
+	return bson.D{{
+		Key: "$eq", Value: bson.A{"$$this.resourceType", filterParams.ResourceType},
+	}}
+}
+
+func makeFindAllAuxiliaryMaterialsTaskFilter(filterParams query.AuxiliaryMaterialsFilterParams) bson.D {
+	if filterParams.TaskNumber == 0 {
+		return bson.D{{Key: "$literal", Value: true}}
+	}
+
+	return bson.D{{
+		Key: "$eq", Value: bson.A{"$$this.taskNumber", filterParams.TaskNumber},
+	}}
+}
+
+func (r *CoursesRepository) FindAuxiliaryMaterial(
+	ctx context.Context,
+	academic course.Academic, courseID, materialID string,
+) (app.AuxiliaryMaterial, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	projection := bson.D{{
+		Key: "auxiliaryMaterials", Value: bson.D{{
+			Key: "$elemMatch", Value: bson.D{{Key: "id", Value: materialID}},
+		}},
+	}}
+	findOpt := options.FindOne().SetProjection(projection)
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.AuxiliaryMaterial{}, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return app.AuxiliaryMaterial{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	if len(document.Materials) == 0 {
+		return app.AuxiliaryMaterial{}, app.ErrAuxiliaryMaterialDoesntExist
+	}
+
+	material, ok := unmarshalQueryAuxiliaryMaterial(academic, document.Materials[0], time.Now())
+	if !ok {
+		return app.AuxiliaryMaterial{}, app.ErrAuxiliaryMaterialDoesntExist
+	}
+
+	return material, nil
+}
+
+func (r *CoursesRepository) FindCourseAcademics(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) (app.CourseAcademics, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	projection := bson.D{
+		{Key: "creatorId", Value: 1},
+		{Key: "collaborators", Value: 1},
+		{Key: "collaboratorRoles", Value: 1},
+		{Key: "students", Value: 1},
+		{Key: "studentEnrollments", Value: 1},
+	}
+	findOpt := options.FindOne().SetProjection(projection)
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.CourseAcademics{}, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return app.CourseAcademics{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	crs := unmarshalCourse(document)
+
+	return app.CourseAcademics{
+		CreatorID:         crs.CreatorID(),
+		StudentIDs:        crs.Students(),
+		CollaboratorIDs:   crs.Collaborators(),
+		CollaboratorRoles: crs.CollaboratorRoles(),
+		Enrollments:       unmarshalQueryStudentEnrollments(crs.StudentEnrollments(true)),
+	}, nil
+}
+
+func (r *CoursesRepository) FindCourseContent(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) (app.CourseContent, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	projection := bson.D{
+		{Key: "students", Value: 1},
+		{Key: "tasks.number", Value: 1},
+		{Key: "tasks.title", Value: 1},
+		{Key: "tasks.type", Value: 1},
+		{Key: "auxiliaryMaterials", Value: 1},
+	}
+	findOpt := options.FindOne().SetProjection(projection)
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.CourseContent{}, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return app.CourseContent{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return app.CourseContent{
+		StudentIDs: document.Students,
+		Tasks:      unmarshalGeneralTasks(document.Tasks),
+		Materials:  unmarshalQueryAuxiliaryMaterials(academic, document.Materials),
+	}, nil
+}
+
+func (r *CoursesRepository) FindInvitationCourseID(ctx context.Context, code string) (string, error) {
+	filter := bson.D{{Key: "invitations.code", Value: code}}
+	findOpt := options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", app.Wrap(app.ErrInvitationDoesntExist, err)
+		}
+
+		return "", app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return document.ID, nil
+}
+
+func (r *CoursesRepository) FindAcademicCourseIDs(ctx context.Context, academicID string) ([]string, error) {
+	filter := bson.D{{
+		Key: "$or", Value: bson.A{
+			bson.D{{Key: "creatorId", Value: academicID}},
+			bson.D{{Key: "collaborators", Value: academicID}},
+			bson.D{{Key: "students", Value: academicID}},
+			bson.D{{Key: "waitlist.studentId", Value: academicID}},
+			bson.D{{Key: "enrollmentRequests.studentId", Value: academicID}},
+		},
+	}}
+	findOpt := options.Find().
+		SetProjection(bson.D{{Key: "_id", Value: 1}}).
+		SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cursor, err := r.courses.Find(ctx, filter, findOpt)
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	var documents []courseDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	courseIDs := make([]string, 0, len(documents))
+	for _, d := range documents {
+		courseIDs = append(courseIDs, d.ID)
+	}
+
+	return courseIDs, nil
+}
+
+func (r *CoursesRepository) FindCourseInvitations(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) ([]app.Invitation, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	findOpt := options.FindOne().SetProjection(bson.D{{Key: "invitations", Value: 1}})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalQueryInvitations(document.Invitations), nil
+}
+
+func (r *CoursesRepository) FindEnrollmentRequests(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+	status course.EnrollmentRequestStatus,
+) ([]app.EnrollmentRequest, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	findOpt := options.FindOne().SetProjection(bson.D{{Key: "enrollmentRequests", Value: 1}})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalQueryEnrollmentRequests(document.Requests, status), nil
+}
+
+func (r *CoursesRepository) FindCourseTeams(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) (app.Teams, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	findOpt := options.FindOne().SetProjection(bson.D{
+		{Key: "teams", Value: 1},
+		{Key: "teamSettings", Value: 1},
+	})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.Teams{}, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return app.Teams{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalQueryTeams(document.Teams, document.TeamSettings), nil
+}
+
+func (r *CoursesRepository) FindCourseWaitlist(
+	ctx context.Context,
+	academic course.Academic, courseID string,
+) (app.Waitlist, error) {
+	filter := makeCourseForAcademicFilter(academic, courseID)
+	findOpt := options.FindOne().SetProjection(bson.D{
+		{Key: "capacity", Value: 1},
+		{Key: "waitlist", Value: 1},
+		{Key: "waitlistPromotions", Value: 1},
+	})
+
+	var document courseDocument
+	if err := r.courses.FindOne(ctx, filter, findOpt).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return app.Waitlist{}, app.Wrap(app.ErrCourseDoesntExist, err)
+		}
+
+		return app.Waitlist{}, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return unmarshalQueryWaitlist(document), nil
+}
+
 func makeCourseForAcademicFilter(academic course.Academic, courseID string) bson.D {
 	return bson.D{{Key: "_id", Value: courseID}, makeCoursesForAcademicFilter(academic)}
 }
@@ -291,6 +756,10 @@ func makeCoursesForAcademicFilter(academic course.Academic) bson.E {
 }
 
 func (r *CoursesRepository) RemoveAllCourses(ctx context.Context) error {
+	if _, err := r.taskVersions.DeleteMany(ctx, bson.D{}); err != nil {
+		return errors.Wrap(err, "unable to remove all task versions")
+	}
+
 	_, err := r.courses.DeleteMany(ctx, bson.D{})
 
 	return errors.Wrap(err, "unable to remove all courses")
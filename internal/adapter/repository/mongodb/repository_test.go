@@ -3,6 +3,7 @@ package mongodb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/suite"
@@ -345,6 +346,64 @@ func (s *CoursesRepositoryTestSuite) TestCoursesRepository_UpdateCourse() {
 	}
 }
 
+func (s *CoursesRepositoryTestSuite) TestCoursesRepository_FindInvitationCourseID() {
+	creator := course.MustNewAcademic("0e4c1a8f-6f2b-4d5e-9a3c-7b8d9e0f1a2b", course.TeacherType)
+	now := time.Now()
+
+	courseWithInvitation := course.MustNewCourse(course.CreationParams{
+		ID:      "c3b6a9e2-4d1f-4f7a-8b5c-2e9d0a1b3c4d",
+		Creator: creator,
+		Title:   "Course with invitation",
+		Period:  course.MustNewPeriod(2030, 2031, course.FirstSemester),
+	})
+	err := courseWithInvitation.AddInvitation(
+		creator,
+		course.MustNewInvitation("ZK3QW7RT2M5XN4PA", course.StudentType, now.Add(time.Hour), 30),
+		now,
+	)
+	s.Require().NoError(err)
+
+	s.addCourses(courseWithInvitation)
+
+	courseID, err := s.repository.FindInvitationCourseID(context.Background(), "ZK3QW7RT2M5XN4PA")
+	s.Require().NoError(err)
+	s.Require().Equal(courseWithInvitation.ID(), courseID)
+
+	_, err = s.repository.FindInvitationCourseID(context.Background(), "UNKNOWNCODE")
+	s.Require().True(errors.Is(err, app.ErrInvitationDoesntExist))
+}
+
+func (s *CoursesRepositoryTestSuite) TestCoursesRepository_FindAcademicCourseIDs() {
+	creator := course.MustNewAcademic("5b0e7c2d-3a4f-4e6b-8c9d-1f2a3b4c5d6e", course.TeacherType)
+	academicID := "9d8c7b6a-5f4e-4d3c-b2a1-0f9e8d7c6b5a"
+
+	creatorCourse := course.MustNewCourse(course.CreationParams{
+		ID:      "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		Creator: course.MustNewAcademic(academicID, course.TeacherType),
+		Title:   "Course of academic",
+		Period:  course.MustNewPeriod(2030, 2031, course.FirstSemester),
+	})
+	studentCourse := course.MustNewCourse(course.CreationParams{
+		ID:       "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+		Creator:  creator,
+		Title:    "Course with student",
+		Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+		Students: []string{academicID},
+	})
+	otherCourse := course.MustNewCourse(course.CreationParams{
+		ID:      "3c4d5e6f-7a8b-4c9d-0e1f-2a3b4c5d6e7f",
+		Creator: creator,
+		Title:   "Other course",
+		Period:  course.MustNewPeriod(2030, 2031, course.FirstSemester),
+	})
+
+	s.addCourses(otherCourse, studentCourse, creatorCourse)
+
+	courseIDs, err := s.repository.FindAcademicCourseIDs(context.Background(), academicID)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{creatorCourse.ID(), studentCourse.ID()}, courseIDs)
+}
+
 func (s *CoursesRepositoryTestSuite) newCoursesRepository() *mongodb.CoursesRepository {
 	return mongodb.NewCoursesRepository(s.db)
 }
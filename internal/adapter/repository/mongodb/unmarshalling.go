@@ -1,23 +1,267 @@
 package mongodb
 
 import (
+	"time"
+
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
 )
 
 func unmarshalCourse(document courseDocument) *course.Course {
 	return course.UnmarshalFromDatabase(course.UnmarshallingParams{
-		ID:            document.ID,
-		Title:         document.Title,
-		Period:        unmarshalPeriod(document.Period),
-		Started:       document.Started,
-		CreatorID:     document.CreatorID,
-		Collaborators: document.Collaborators,
-		Students:      document.Students,
-		Tasks:         unmarshalTasks(document.Tasks),
+		ID:                document.ID,
+		Title:             document.Title,
+		Period:            unmarshalPeriod(document.Period),
+		Started:           document.Started,
+		CreatorID:         document.CreatorID,
+		Collaborators:     document.Collaborators,
+		CollaboratorRoles: document.Roles,
+		Students:          document.Students,
+		Enrollments:       unmarshalStudentEnrollments(document.Enrollments),
+		Groups:            document.Groups,
+		StudentGroups:     document.StudentGroups,
+		Invitations:       unmarshalInvitations(document.Invitations),
+		Requests:          unmarshalEnrollmentRequests(document.Requests),
+		Teams:             unmarshalTeams(document.Teams),
+		TeamSelfFormation: document.TeamSettings.SelfFormation,
+		TeamMaxSize:       document.TeamSettings.MaxSize,
+		Capacity:          document.Capacity,
+		Waitlist:          unmarshalWaitlist(document.Waitlist),
+		Promotions:        unmarshalWaitlistPromotions(document.Promotions),
+		Tasks:             unmarshalTasks(document.Tasks),
+		Materials:         unmarshalAuxiliaryMaterials(document.Materials),
 	})
 }
 
+func unmarshalInvitations(documents []invitationDocument) []course.UnmarshallingInvitationParams {
+	invitations := make([]course.UnmarshallingInvitationParams, 0, len(documents))
+	for _, d := range documents {
+		invitations = append(invitations, course.UnmarshallingInvitationParams{
+			Code:      d.Code,
+			Role:      d.Role,
+			ExpiresAt: d.ExpiresAt.UTC(),
+			MaxUses:   d.MaxUses,
+			Uses:      d.Uses,
+		})
+	}
+
+	return invitations
+}
+
+func unmarshalEnrollmentRequests(documents []enrollmentRequestDocument) []course.UnmarshallingEnrollmentRequestParams {
+	requests := make([]course.UnmarshallingEnrollmentRequestParams, 0, len(documents))
+	for _, d := range documents {
+		requests = append(requests, course.UnmarshallingEnrollmentRequestParams{
+			ID:         d.ID,
+			StudentID:  d.StudentID,
+			Message:    d.Message,
+			Status:     d.Status,
+			CreatedAt:  d.CreatedAt.UTC(),
+			ReviewerID: d.ReviewerID,
+			ReviewedAt: unmarshalOptionalTime(d.ReviewedAt),
+		})
+	}
+
+	return requests
+}
+
+func unmarshalTeams(documents []teamDocument) []course.UnmarshallingTeamParams {
+	teams := make([]course.UnmarshallingTeamParams, 0, len(documents))
+	for _, d := range documents {
+		teams = append(teams, course.UnmarshallingTeamParams{
+			ID:      d.ID,
+			Name:    d.Name,
+			Members: d.Members,
+		})
+	}
+
+	return teams
+}
+
+// unmarshalQueryTeams keeps order of documents, teams are marshalled sorted by name.
+func unmarshalQueryTeams(documents []teamDocument, settings teamSettingsDocument) app.Teams {
+	teams := make([]app.Team, 0, len(documents))
+	for _, d := range documents {
+		teams = append(teams, app.Team{
+			ID:        d.ID,
+			Name:      d.Name,
+			MemberIDs: d.Members,
+		})
+	}
+
+	return app.Teams{
+		SelfFormation: settings.SelfFormation,
+		MaxSize:       settings.MaxSize,
+		Teams:         teams,
+	}
+}
+
+func unmarshalStudentEnrollments(documents []studentEnrollmentDocument) []course.UnmarshallingStudentEnrollmentParams {
+	enrollments := make([]course.UnmarshallingStudentEnrollmentParams, 0, len(documents))
+	for _, d := range documents {
+		enrollments = append(enrollments, course.UnmarshallingStudentEnrollmentParams{
+			StudentID:   d.StudentID,
+			Status:      d.Status,
+			EnrolledAt:  unmarshalOptionalTime(d.EnrolledAt),
+			WithdrawnAt: unmarshalOptionalTime(d.WithdrawnAt),
+			Reason:      d.Reason,
+		})
+	}
+
+	return enrollments
+}
+
+func unmarshalQueryStudentEnrollments(enrollments []course.StudentEnrollment) []app.StudentEnrollment {
+	queryEnrollments := make([]app.StudentEnrollment, 0, len(enrollments))
+	for _, e := range enrollments {
+		queryEnrollments = append(queryEnrollments, app.StudentEnrollment{
+			StudentID:   e.StudentID(),
+			Status:      e.Status(),
+			EnrolledAt:  e.EnrolledAt(),
+			WithdrawnAt: e.WithdrawnAt(),
+			Reason:      e.Reason(),
+		})
+	}
+
+	return queryEnrollments
+}
+
+func unmarshalWaitlist(documents []waitlistEntryDocument) []course.UnmarshallingWaitlistEntryParams {
+	waitlist := make([]course.UnmarshallingWaitlistEntryParams, 0, len(documents))
+	for _, d := range documents {
+		waitlist = append(waitlist, course.UnmarshallingWaitlistEntryParams{
+			StudentID:    d.StudentID,
+			WaitlistedAt: d.WaitlistedAt.UTC(),
+		})
+	}
+
+	return waitlist
+}
+
+func unmarshalWaitlistPromotions(documents []waitlistPromotionDocument) []course.UnmarshallingWaitlistPromotionParams {
+	promotions := make([]course.UnmarshallingWaitlistPromotionParams, 0, len(documents))
+	for _, d := range documents {
+		promotions = append(promotions, course.UnmarshallingWaitlistPromotionParams{
+			StudentID:    d.StudentID,
+			WaitlistedAt: d.WaitlistedAt.UTC(),
+			PromotedAt:   d.PromotedAt.UTC(),
+		})
+	}
+
+	return promotions
+}
+
+// unmarshalQueryWaitlist keeps order of documents, waitlist is marshalled
+// in order of waiting and promotions from the oldest.
+func unmarshalQueryWaitlist(document courseDocument) app.Waitlist {
+	entries := make([]app.WaitlistEntry, 0, len(document.Waitlist))
+	for _, d := range document.Waitlist {
+		entries = append(entries, app.WaitlistEntry{
+			StudentID:    d.StudentID,
+			WaitlistedAt: d.WaitlistedAt.UTC(),
+		})
+	}
+
+	promotions := make([]app.WaitlistPromotion, 0, len(document.Promotions))
+	for _, d := range document.Promotions {
+		promotions = append(promotions, app.WaitlistPromotion{
+			StudentID:    d.StudentID,
+			WaitlistedAt: d.WaitlistedAt.UTC(),
+			PromotedAt:   d.PromotedAt.UTC(),
+		})
+	}
+
+	return app.Waitlist{
+		Capacity:   document.Capacity,
+		Entries:    entries,
+		Promotions: promotions,
+	}
+}
+
+// unmarshalQueryEnrollmentRequests keeps order of documents, requests are
+// marshalled from the oldest, zero status matches any request.
+func unmarshalQueryEnrollmentRequests(
+	documents []enrollmentRequestDocument,
+	status course.EnrollmentRequestStatus,
+) []app.EnrollmentRequest {
+	requests := make([]app.EnrollmentRequest, 0, len(documents))
+	for _, d := range documents {
+		if status != 0 && d.Status != status {
+			continue
+		}
+
+		requests = append(requests, app.EnrollmentRequest{
+			ID:         d.ID,
+			StudentID:  d.StudentID,
+			Message:    d.Message,
+			Status:     d.Status,
+			CreatedAt:  d.CreatedAt.UTC(),
+			ReviewerID: d.ReviewerID,
+			ReviewedAt: unmarshalOptionalTime(d.ReviewedAt),
+		})
+	}
+
+	return requests
+}
+
+func unmarshalOptionalTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.UTC()
+}
+
+// unmarshalQueryInvitations keeps order of documents, invitations
+// are marshalled sorted by expiry.
+func unmarshalQueryInvitations(documents []invitationDocument) []app.Invitation {
+	invitations := make([]app.Invitation, 0, len(documents))
+	for _, d := range documents {
+		invitations = append(invitations, app.Invitation{
+			Code:      d.Code,
+			Role:      d.Role,
+			ExpiresAt: d.ExpiresAt.UTC(),
+			MaxUses:   d.MaxUses,
+			Uses:      d.Uses,
+		})
+	}
+
+	return invitations
+}
+
+func unmarshalAuxiliaryMaterials(documents []auxiliaryMaterialDocument) []course.AuxiliaryMaterial {
+	var materials []course.AuxiliaryMaterial
+	for _, d := range documents {
+		materials = append(materials, unmarshalAuxiliaryMaterial(d))
+	}
+
+	return materials
+}
+
+func unmarshalAuxiliaryMaterial(document auxiliaryMaterialDocument) course.AuxiliaryMaterial {
+	var material course.AuxiliaryMaterial
+
+	if document.File == nil {
+		material = course.MustNewAuxiliaryMaterial(document.ID, document.Resource, document.ResourceType)
+	} else {
+		file := course.MustNewMaterialFile(
+			document.File.Key, document.File.Name,
+			document.File.ContentType, document.File.Size,
+		)
+		material = course.MustNewFileAuxiliaryMaterial(document.ID, file, document.ResourceType)
+	}
+
+	return material.ForTask(document.TaskNumber).WithRelease(unmarshalReleaseTime(document.ReleaseTime), document.Hidden)
+}
+
+func unmarshalReleaseTime(releaseTime *time.Time) time.Time {
+	if releaseTime == nil {
+		return time.Time{}
+	}
+
+	return *releaseTime
+}
+
 func unmarshalPeriod(document periodDocument) course.Period {
 	return course.MustNewPeriod(document.AcademicStartYear, document.AcademicEndYear, document.Semester)
 }
@@ -25,20 +269,40 @@ func unmarshalPeriod(document periodDocument) course.Period {
 func unmarshalTasks(taskDocuments []taskDocument) []course.UnmarshallingTaskParams {
 	taskParams := make([]course.UnmarshallingTaskParams, 0, len(taskDocuments))
 	for _, td := range taskDocuments {
-		taskParams = append(taskParams, course.UnmarshallingTaskParams{
-			Number:      td.Number,
-			Title:       td.Title,
-			Description: td.Description,
-			TaskType:    td.Type,
-			Deadline:    unmarshalDeadline(td.Deadline),
-			TestData:    unmarshalTestData(td.TestData),
-			TestPoints:  unmarshalTestPoints(td.TestPoints),
-		})
+		params := unmarshalTask(td)
+		params.Version = td.Version
+
+		taskParams = append(taskParams, params)
 	}
 
 	return taskParams
 }
 
+func unmarshalDomainTaskVersion(document taskVersionDocument) course.TaskVersion {
+	return course.UnmarshalTaskVersionFromDatabase(course.UnmarshallingTaskVersionParams{
+		Number:    document.Version,
+		AuthorID:  document.AuthorID,
+		CreatedAt: document.CreatedAt,
+		Task:      unmarshalTask(document.Task),
+	})
+}
+
+func unmarshalTask(document taskDocument) course.UnmarshallingTaskParams {
+	return course.UnmarshallingTaskParams{
+		Number:      document.Number,
+		Title:       document.Title,
+		Description: document.Description,
+		TaskType:    document.Type,
+		TeamWork:    document.TeamWork,
+		Deadline:    unmarshalDeadline(document.Deadline),
+		TestData:    unmarshalTestData(document.TestData),
+		Checker:     unmarshalChecker(document.Checker),
+		Languages:   document.Languages,
+		Limits:      unmarshalExecutionLimits(document.Limits),
+		TestPoints:  unmarshalTestPoints(document.TestPoints),
+	}
+}
+
 func unmarshalDeadline(document *deadlineDocument) course.Deadline {
 	if document == nil {
 		return course.Deadline{}
@@ -50,12 +314,73 @@ func unmarshalDeadline(document *deadlineDocument) course.Deadline {
 func unmarshalTestData(documents []testDataDocument) []course.TestData {
 	testData := make([]course.TestData, 0, len(documents))
 	for _, d := range documents {
-		testData = append(testData, course.MustNewTestData(d.OutputData, d.OutputData))
+		visibility := unmarshalTestDataVisibility(d.Visibility)
+
+		if d.InputDataID != "" || d.OutputDataID != "" {
+			testData = append(testData, course.MustNewStoredTestData(d.InputDataID, d.OutputDataID, visibility, d.Explanation))
+
+			continue
+		}
+
+		testData = append(testData, course.MustNewTestData(d.InputData, d.OutputData, visibility, d.Explanation))
 	}
 
 	return testData
 }
 
+// unmarshalChecker returns zero checker for tasks persisted
+// before checkers were introduced, task treats it as default.
+func unmarshalChecker(document *checkerDocument) course.Checker {
+	if document == nil {
+		return course.Checker{}
+	}
+
+	return course.MustNewChecker(document.Mode, document.Tolerance)
+}
+
+// unmarshalExecutionLimits returns zero limits for tasks persisted
+// before limits were introduced, task treats them as default.
+func unmarshalExecutionLimits(document *limitsDocument) course.ExecutionLimits {
+	if document == nil {
+		return course.ExecutionLimits{}
+	}
+
+	return course.MustNewExecutionLimits(document.TimeLimit, document.MemoryLimit, document.MaxSourceSize)
+}
+
+func unmarshalQueryExecutionLimits(document *limitsDocument) *app.ExecutionLimits {
+	if document == nil {
+		return nil
+	}
+
+	return &app.ExecutionLimits{
+		TimeLimit:     document.TimeLimit,
+		MemoryLimit:   document.MemoryLimit,
+		MaxSourceSize: document.MaxSourceSize,
+	}
+}
+
+func unmarshalQueryChecker(document *checkerDocument) *app.Checker {
+	if document == nil {
+		return nil
+	}
+
+	return &app.Checker{
+		Mode:      document.Mode,
+		Tolerance: document.Tolerance,
+	}
+}
+
+// unmarshalTestDataVisibility treats test data persisted
+// before visibility was introduced as hidden.
+func unmarshalTestDataVisibility(visibility course.TestDataVisibility) course.TestDataVisibility {
+	if !visibility.IsValid() {
+		return course.HiddenTestData
+	}
+
+	return visibility
+}
+
 func unmarshalTestPoints(documents []testPointDocument) []course.TestPoint {
 	testPoints := make([]course.TestPoint, 0, len(documents))
 	for _, d := range documents {
@@ -82,20 +407,65 @@ func unmarshalCommonCourse(document courseDocument) app.CommonCourse {
 		CreatorID:   document.CreatorID,
 		Started:     document.Started,
 		TasksNumber: len(document.Tasks),
+		Capacity:    document.Capacity,
 	}
 }
 
 func unmarshalSpecificTask(academic course.Academic, document taskDocument) app.SpecificTask {
 	forTeacher := academic.Type() == course.TeacherType
 
+	testData, hiddenTestDataNumber := unmarshalQueryTestData(forTeacher, document.TestData)
+
 	return app.SpecificTask{
-		Number:      document.Number,
-		Title:       document.Title,
-		Description: document.Description,
-		Type:        document.Type,
-		Deadline:    unmarshalQueryDeadline(document.Deadline),
-		TestData:    unmarshalQueryTestData(forTeacher, document.TestData),
-		Points:      unmarshalQueryTestPoints(forTeacher, document.TestPoints),
+		Number:               document.Number,
+		Title:                document.Title,
+		Description:          document.Description,
+		Type:                 document.Type,
+		TeamWork:             document.TeamWork,
+		Deadline:             unmarshalQueryDeadline(document.Deadline),
+		TestData:             testData,
+		HiddenTestDataNumber: hiddenTestDataNumber,
+		Checker:              unmarshalQueryChecker(document.Checker),
+		Languages:            document.Languages,
+		Limits:               unmarshalQueryExecutionLimits(document.Limits),
+		Points:               unmarshalQueryTestPoints(forTeacher, document.TestPoints),
+		Version:              unmarshalTaskVersionNumber(document.Version),
+	}
+}
+
+// unmarshalTaskVersionNumber treats tasks created before
+// version history was kept as tasks of the first version.
+func unmarshalTaskVersionNumber(version int) int {
+	if version == 0 {
+		return 1
+	}
+
+	return version
+}
+
+func unmarshalGeneralTaskVersions(documents []taskVersionDocument) []app.GeneralTaskVersion {
+	versions := make([]app.GeneralTaskVersion, 0, len(documents))
+	for _, d := range documents {
+		versions = append(versions, app.GeneralTaskVersion{
+			Version:   d.Version,
+			AuthorID:  d.AuthorID,
+			CreatedAt: d.CreatedAt,
+			Title:     d.Task.Title,
+		})
+	}
+
+	return versions
+}
+
+func unmarshalTaskVersion(academic course.Academic, document taskVersionDocument) app.TaskVersion {
+	task := unmarshalSpecificTask(academic, document.Task)
+	task.Version = document.Version
+
+	return app.TaskVersion{
+		Version:   document.Version,
+		AuthorID:  document.AuthorID,
+		CreatedAt: document.CreatedAt,
+		Task:      task,
 	}
 }
 
@@ -118,21 +488,55 @@ func unmarshalQueryDeadline(document *deadlineDocument) *app.Deadline {
 	}
 }
 
-func unmarshalQueryTestData(forTeacher bool, documents []testDataDocument) []app.TestData {
-	if !forTeacher {
-		return nil
-	}
-
+// unmarshalQueryTestData returns all test data for teacher and
+// only sample test data for student. Hidden test data are counted.
+func unmarshalQueryTestData(forTeacher bool, documents []testDataDocument) ([]app.TestData, int) {
 	queryTestData := make([]app.TestData, 0, len(documents))
+	hiddenNumber := 0
 
 	for _, d := range documents {
+		visibility := unmarshalTestDataVisibility(d.Visibility)
+		if visibility == course.HiddenTestData {
+			hiddenNumber++
+
+			if !forTeacher {
+				continue
+			}
+		}
+
 		queryTestData = append(queryTestData, app.TestData{
-			InputData:  d.InputData,
-			OutputData: d.OutputData,
+			InputData:    d.InputData,
+			OutputData:   d.OutputData,
+			InputDataID:  d.InputDataID,
+			OutputDataID: d.OutputDataID,
+			Visibility:   visibility,
+			Explanation:  d.Explanation,
 		})
 	}
 
-	return queryTestData
+	return queryTestData, hiddenNumber
+}
+
+func unmarshalSampleTestDataIDs(documents []taskDocument) []string {
+	var ids []string
+
+	for _, task := range documents {
+		for _, d := range task.TestData {
+			if unmarshalTestDataVisibility(d.Visibility) != course.SampleTestData {
+				continue
+			}
+
+			if d.InputDataID != "" {
+				ids = append(ids, d.InputDataID)
+			}
+
+			if d.OutputDataID != "" {
+				ids = append(ids, d.OutputDataID)
+			}
+		}
+	}
+
+	return ids
 }
 
 func unmarshalQueryTestPoints(forTeacher bool, documents []testPointDocument) []app.TestPoint {
@@ -163,8 +567,77 @@ func unmarshalGeneralTasks(documents []taskDocument) []app.GeneralTask {
 			Title:       d.Title,
 			Description: d.Description,
 			Type:        d.Type,
+			TeamWork:    d.TeamWork,
 		})
 	}
 
 	return tasks
 }
+
+// unmarshalTaskAuxiliaryMaterials returns materials attached to the task.
+func unmarshalTaskAuxiliaryMaterials(
+	academic course.Academic,
+	documents []auxiliaryMaterialDocument, taskNumber int,
+) []app.AuxiliaryMaterial {
+	var taskDocuments []auxiliaryMaterialDocument
+	for _, d := range documents {
+		if d.TaskNumber == taskNumber {
+			taskDocuments = append(taskDocuments, d)
+		}
+	}
+
+	if len(taskDocuments) == 0 {
+		return nil
+	}
+
+	return unmarshalQueryAuxiliaryMaterials(academic, taskDocuments)
+}
+
+func unmarshalQueryAuxiliaryMaterials(
+	academic course.Academic,
+	documents []auxiliaryMaterialDocument,
+) []app.AuxiliaryMaterial {
+	now := time.Now()
+
+	materials := make([]app.AuxiliaryMaterial, 0, len(documents))
+	for _, d := range documents {
+		if material, ok := unmarshalQueryAuxiliaryMaterial(academic, d, now); ok {
+			materials = append(materials, material)
+		}
+	}
+
+	return materials
+}
+
+// unmarshalQueryAuxiliaryMaterial returns false if academic
+// isn't teacher and material isn't released to students yet.
+func unmarshalQueryAuxiliaryMaterial(
+	academic course.Academic,
+	document auxiliaryMaterialDocument, now time.Time,
+) (app.AuxiliaryMaterial, bool) {
+	status := unmarshalAuxiliaryMaterial(document).Status(now)
+	if academic.Type() != course.TeacherType && status != course.ReleasedMaterial {
+		return app.AuxiliaryMaterial{}, false
+	}
+
+	material := app.AuxiliaryMaterial{
+		ID:           document.ID,
+		Resource:     document.Resource,
+		ResourceType: document.ResourceType,
+		TaskNumber:   document.TaskNumber,
+		ReleaseTime:  unmarshalReleaseTime(document.ReleaseTime),
+		Hidden:       document.Hidden,
+		Status:       status,
+	}
+
+	if document.File != nil {
+		material.File = &app.MaterialFile{
+			Key:         document.File.Key,
+			Name:        document.File.Name,
+			ContentType: document.File.ContentType,
+			Size:        document.File.Size,
+		}
+	}
+
+	return material, true
+}
@@ -0,0 +1,105 @@
+package local
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// BlobStorage keeps blobs in local directory. Blobs are addressed
+// by SHA-256 of their content, so same content is stored once.
+type BlobStorage struct {
+	dir string
+}
+
+const blobKeyLen = sha256.Size * 2
+
+func NewBlobStorage(dir string) *BlobStorage {
+	if dir == "" {
+		panic("dir is empty")
+	}
+
+	return &BlobStorage{dir: dir}
+}
+
+func (s *BlobStorage) SaveBlob(_ context.Context, data io.Reader) (app.Blob, error) {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(tmp, hash), data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	blob := app.Blob{Key: hex.EncodeToString(hash.Sum(nil)), Size: size}
+
+	path := s.blobPath(blob.Key)
+	if _, err := os.Stat(path); err == nil {
+		return blob, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	return blob, nil
+}
+
+func (s *BlobStorage) OpenBlob(_ context.Context, key string) (io.ReadCloser, error) {
+	if !isBlobKey(key) {
+		return nil, app.ErrBlobDoesntExist
+	}
+
+	file, err := os.Open(s.blobPath(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, app.Wrap(app.ErrBlobDoesntExist, err)
+		}
+
+		return nil, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	return file, nil
+}
+
+// blobPath spreads blobs over subdirectories by first
+// key bytes to keep directories reasonably small.
+func (s *BlobStorage) blobPath(key string) string {
+	return filepath.Join(s.dir, key[:2], key)
+}
+
+// isBlobKey reports whether key is hex encoded SHA-256,
+// so it can't point outside storage directory.
+func isBlobKey(key string) bool {
+	if len(key) != blobKeyLen {
+		return false
+	}
+
+	_, err := hex.DecodeString(key)
+
+	return err == nil
+}
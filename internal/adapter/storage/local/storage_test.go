@@ -0,0 +1,110 @@
+package local_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/storage/local"
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+func TestBlobStorage_SaveBlob(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	storage := local.NewBlobStorage(dir)
+
+	blob, err := storage.SaveBlob(context.Background(), strings.NewReader("test"))
+	require.NoError(t, err)
+	require.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", blob.Key)
+	require.Equal(t, int64(4), blob.Size)
+
+	data, err := storage.OpenBlob(context.Background(), blob.Key)
+	require.NoError(t, err)
+
+	defer data.Close()
+
+	content, err := io.ReadAll(data)
+	require.NoError(t, err)
+	require.Equal(t, "test", string(content))
+}
+
+func TestBlobStorage_SaveBlob_deduplicatesContent(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	storage := local.NewBlobStorage(dir)
+
+	first, err := storage.SaveBlob(context.Background(), strings.NewReader("same slides"))
+	require.NoError(t, err)
+	second, err := storage.SaveBlob(context.Background(), strings.NewReader("same slides"))
+	require.NoError(t, err)
+	other, err := storage.SaveBlob(context.Background(), strings.NewReader("other slides"))
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+	require.NotEqual(t, first.Key, other.Key)
+	require.Equal(t, 2, countFiles(t, dir))
+}
+
+func TestBlobStorage_OpenBlob(t *testing.T) {
+	t.Parallel()
+
+	storage := local.NewBlobStorage(t.TempDir())
+
+	testCases := []struct {
+		Name string
+		Key  string
+	}{
+		{
+			Name: "blob_doesnt_exist",
+			Key:  "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		},
+		{
+			Name: "key_with_path_traversal",
+			Key:  "../../../../../../../../../../../../../../../../../../etc/passwd",
+		},
+		{
+			Name: "not_hex_key",
+			Key:  strings.Repeat("z", 64),
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := storage.OpenBlob(context.Background(), c.Key)
+			require.True(t, errors.Is(err, app.ErrBlobDoesntExist))
+		})
+	}
+}
+
+func countFiles(t *testing.T, dir string) int {
+	t.Helper()
+
+	var count int
+
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			count++
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return count
+}
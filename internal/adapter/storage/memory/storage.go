@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// TestDataStorage keeps large test data of auto code checking
+// tasks in memory, test data is lost when service stops.
+type TestDataStorage struct {
+	mu       sync.RWMutex
+	testData map[string]storedTestData
+}
+
+type storedTestData struct {
+	courseID        string
+	sharedCourseIDs map[string]bool
+	content         []byte
+}
+
+func (td storedTestData) belongsTo(courseID string) bool {
+	return td.courseID == courseID || td.sharedCourseIDs[courseID]
+}
+
+func NewTestDataStorage() *TestDataStorage {
+	return &TestDataStorage{testData: make(map[string]storedTestData)}
+}
+
+func (s *TestDataStorage) SaveTestData(_ context.Context, courseID string, data io.Reader) (string, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return "", app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	id := uuid.NewString()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.testData[id] = storedTestData{courseID: courseID, content: content}
+
+	return id, nil
+}
+
+func (s *TestDataStorage) TestDataExists(_ context.Context, courseID, testDataID string) error {
+	_, err := s.find(courseID, testDataID)
+
+	return err
+}
+
+func (s *TestDataStorage) OpenTestData(_ context.Context, courseID, testDataID string) (io.ReadCloser, error) {
+	td, err := s.find(courseID, testDataID)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(td.content)), nil
+}
+
+func (s *TestDataStorage) ShareTestData(_ context.Context, fromCourseID, toCourseID string, testDataIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range testDataIDs {
+		td, ok := s.testData[id]
+		if !ok || !td.belongsTo(fromCourseID) {
+			continue
+		}
+
+		sharedCourseIDs := make(map[string]bool, len(td.sharedCourseIDs)+1)
+		for courseID := range td.sharedCourseIDs {
+			sharedCourseIDs[courseID] = true
+		}
+
+		sharedCourseIDs[toCourseID] = true
+		td.sharedCourseIDs = sharedCourseIDs
+		s.testData[id] = td
+	}
+
+	return nil
+}
+
+func (s *TestDataStorage) find(courseID, testDataID string) (storedTestData, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	td, ok := s.testData[testDataID]
+	if !ok || !td.belongsTo(courseID) {
+		return storedTestData{}, app.ErrTestDataDoesntExist
+	}
+
+	return td, nil
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// ViewsStorage keeps view events of course tasks and materials in
+// memory, only distinct viewers of every item are kept.
+type ViewsStorage struct {
+	mu      sync.RWMutex
+	viewers map[string]map[app.ViewedItem]map[string]bool
+}
+
+func NewViewsStorage() *ViewsStorage {
+	return &ViewsStorage{viewers: make(map[string]map[app.ViewedItem]map[string]bool)}
+}
+
+func (s *ViewsStorage) AppendViews(_ context.Context, events []app.ViewEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		items, ok := s.viewers[e.CourseID]
+		if !ok {
+			items = make(map[app.ViewedItem]map[string]bool)
+			s.viewers[e.CourseID] = items
+		}
+
+		students, ok := items[e.Item]
+		if !ok {
+			students = make(map[string]bool)
+			items[e.Item] = students
+		}
+
+		students[e.StudentID] = true
+	}
+
+	return nil
+}
+
+func (s *ViewsStorage) FindCourseViewers(_ context.Context, courseID string) ([]app.ItemViewers, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := s.viewers[courseID]
+	viewers := make([]app.ItemViewers, 0, len(items))
+
+	for item, students := range items {
+		studentIDs := make([]string, 0, len(students))
+		for id := range students {
+			studentIDs = append(studentIDs, id)
+		}
+
+		sort.Strings(studentIDs)
+
+		viewers = append(viewers, app.ItemViewers{Item: item, StudentIDs: studentIDs})
+	}
+
+	return viewers, nil
+}
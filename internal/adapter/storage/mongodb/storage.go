@@ -0,0 +1,148 @@
+package mongodb
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// TestDataStorage keeps large test data of auto code
+// checking tasks in GridFS bucket outside course documents.
+// Test data is shared with extended courses, not copied.
+type TestDataStorage struct {
+	db *mongo.Database
+}
+
+const testDataBucket = "testData"
+
+func NewTestDataStorage(db *mongo.Database) *TestDataStorage {
+	return &TestDataStorage{db: db}
+}
+
+// newBucket creates bucket for single operation,
+// because bucket deadlines can't be shared between requests.
+func (s *TestDataStorage) newBucket() (*gridfs.Bucket, error) {
+	bucket, err := gridfs.NewBucket(s.db, options.GridFSBucket().SetName(testDataBucket))
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return bucket, nil
+}
+
+func (s *TestDataStorage) SaveTestData(ctx context.Context, courseID string, data io.Reader) (string, error) {
+	bucket, err := s.newBucket()
+	if err != nil {
+		return "", err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := bucket.SetWriteDeadline(deadline); err != nil {
+			return "", app.Wrap(app.ErrDatabaseProblems, err)
+		}
+	}
+
+	uploadOpts := options.GridFSUpload().SetMetadata(bson.M{"courseId": courseID})
+
+	id, err := bucket.UploadFromStream(courseID, data, uploadOpts)
+	if err != nil {
+		return "", app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *TestDataStorage) TestDataExists(ctx context.Context, courseID, testDataID string) error {
+	id, err := primitive.ObjectIDFromHex(testDataID)
+	if err != nil {
+		return app.Wrap(app.ErrTestDataDoesntExist, err)
+	}
+
+	filesCollection := s.db.Collection(testDataBucket + ".files")
+
+	count, err := filesCollection.CountDocuments(ctx, makeTestDataFilter(courseID, id))
+	if err != nil {
+		return app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	if count == 0 {
+		return app.ErrTestDataDoesntExist
+	}
+
+	return nil
+}
+
+func (s *TestDataStorage) OpenTestData(ctx context.Context, courseID, testDataID string) (io.ReadCloser, error) {
+	if err := s.TestDataExists(ctx, courseID, testDataID); err != nil {
+		return nil, err
+	}
+
+	bucket, err := s.newBucket()
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := bucket.SetReadDeadline(deadline); err != nil {
+			return nil, app.Wrap(app.ErrDatabaseProblems, err)
+		}
+	}
+
+	id, _ := primitive.ObjectIDFromHex(testDataID)
+
+	stream, err := bucket.OpenDownloadStream(id)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return nil, app.Wrap(app.ErrTestDataDoesntExist, err)
+		}
+
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return stream, nil
+}
+
+func (s *TestDataStorage) ShareTestData(ctx context.Context, fromCourseID, toCourseID string, testDataIDs []string) error {
+	ids := make([]primitive.ObjectID, 0, len(testDataIDs))
+
+	for _, testDataID := range testDataIDs {
+		if id, err := primitive.ObjectIDFromHex(testDataID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}, "$or": makeCourseTestDataFilter(fromCourseID)}
+	update := bson.M{"$addToSet": bson.M{"metadata.sharedCourseIds": toCourseID}}
+
+	filesCollection := s.db.Collection(testDataBucket + ".files")
+	if _, err := filesCollection.UpdateMany(ctx, filter, update); err != nil {
+		return app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return nil
+}
+
+func makeTestDataFilter(courseID string, id primitive.ObjectID) bson.M {
+	return bson.M{"_id": id, "$or": makeCourseTestDataFilter(courseID)}
+}
+
+// makeCourseTestDataFilter matches test data uploaded
+// to course or shared with it on course extension.
+func makeCourseTestDataFilter(courseID string) bson.A {
+	return bson.A{
+		bson.M{"metadata.courseId": courseID},
+		bson.M{"metadata.sharedCourseIds": courseID},
+	}
+}
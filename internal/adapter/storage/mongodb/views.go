@@ -0,0 +1,107 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+// ViewsStorage keeps view events of course tasks and materials
+// in separate collection, events are only inserted and never updated.
+type ViewsStorage struct {
+	views *mongo.Collection
+}
+
+const viewsCollection = "views"
+
+func NewViewsStorage(db *mongo.Database) *ViewsStorage {
+	return &ViewsStorage{views: db.Collection(viewsCollection)}
+}
+
+type viewEventDocument struct {
+	CourseID   string             `bson:"courseId"`
+	StudentID  string             `bson:"studentId"`
+	ItemType   app.ViewedItemType `bson:"itemType"`
+	TaskNumber int                `bson:"taskNumber,omitempty"`
+	MaterialID string             `bson:"materialId,omitempty"`
+	ViewedAt   time.Time          `bson:"viewedAt"`
+}
+
+type itemViewersDocument struct {
+	Item struct {
+		ItemType   app.ViewedItemType `bson:"itemType"`
+		TaskNumber int                `bson:"taskNumber,omitempty"`
+		MaterialID string             `bson:"materialId,omitempty"`
+	} `bson:"_id"`
+	StudentIDs []string `bson:"studentIds"`
+}
+
+func (s *ViewsStorage) AppendViews(ctx context.Context, events []app.ViewEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(events))
+	for _, e := range events {
+		documents = append(documents, viewEventDocument{
+			CourseID:   e.CourseID,
+			StudentID:  e.StudentID,
+			ItemType:   e.Item.Type,
+			TaskNumber: e.Item.TaskNumber,
+			MaterialID: e.Item.MaterialID,
+			ViewedAt:   e.ViewedAt,
+		})
+	}
+
+	if _, err := s.views.InsertMany(ctx, documents); err != nil {
+		return app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	return nil
+}
+
+func (s *ViewsStorage) FindCourseViewers(ctx context.Context, courseID string) ([]app.ItemViewers, error) {
+	cursor, err := s.views.Aggregate(ctx, makeFindCourseViewersPipeline(courseID))
+	if err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	var documents []itemViewersDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, app.Wrap(app.ErrDatabaseProblems, err)
+	}
+
+	viewers := make([]app.ItemViewers, 0, len(documents))
+	for _, d := range documents {
+		viewers = append(viewers, app.ItemViewers{
+			Item: app.ViewedItem{
+				Type:       d.Item.ItemType,
+				TaskNumber: d.Item.TaskNumber,
+				MaterialID: d.Item.MaterialID,
+			},
+			StudentIDs: d.StudentIDs,
+		})
+	}
+
+	return viewers, nil
+}
+
+func makeFindCourseViewersPipeline(courseID string) mongo.Pipeline {
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "courseId", Value: courseID}}}}
+	groupStage := bson.D{{
+		Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "itemType", Value: "$itemType"},
+				{Key: "taskNumber", Value: "$taskNumber"},
+				{Key: "materialId", Value: "$materialId"},
+			}},
+			{Key: "studentIds", Value: bson.D{{Key: "$addToSet", Value: "$studentId"}}},
+		},
+	}}
+
+	return mongo.Pipeline{matchStage, groupStage}
+}
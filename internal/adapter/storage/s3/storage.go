@@ -0,0 +1,208 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+// BlobStorage keeps blobs in bucket of S3 compatible storage. Blobs are
+// addressed by SHA-256 of their content, so same content is stored once.
+// Path-style requests are used, so self-hosted storages work without DNS.
+type BlobStorage struct {
+	endpoint *url.URL
+	bucket   string
+	signer   signer
+	client   *http.Client
+}
+
+const blobKeyLen = sha256.Size * 2
+
+func NewBlobStorage(cfg config.S3Config, client *http.Client) *BlobStorage {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		panic("endpoint isn't valid url")
+	}
+
+	if cfg.Bucket == "" {
+		panic("bucket is empty")
+	}
+
+	if client == nil {
+		panic("client is nil")
+	}
+
+	return &BlobStorage{
+		endpoint: endpoint,
+		bucket:   cfg.Bucket,
+		signer: signer{
+			region:          cfg.Region,
+			accessKeyID:     cfg.AccessKeyID,
+			secretAccessKey: cfg.SecretAccessKey,
+		},
+		client: client,
+	}
+}
+
+// SaveBlob spools data to temporary file first, because key
+// and signed payload hash must be known before uploading.
+func (s *BlobStorage) SaveBlob(ctx context.Context, data io.Reader) (app.Blob, error) {
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(tmp, hash), data)
+	if err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	blob := app.Blob{Key: hex.EncodeToString(hash.Sum(nil)), Size: size}
+
+	exists, err := s.objectExists(ctx, blob.Key)
+	if err != nil {
+		return app.Blob{}, err
+	}
+
+	if exists {
+		return blob, nil
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return app.Blob{}, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	if err := s.putObject(ctx, blob, tmp); err != nil {
+		return app.Blob{}, err
+	}
+
+	return blob, nil
+}
+
+func (s *BlobStorage) OpenBlob(ctx context.Context, key string) (io.ReadCloser, error) {
+	if !isBlobKey(key) {
+		return nil, app.ErrBlobDoesntExist
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, emptyPayloadSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+
+		return nil, app.ErrBlobDoesntExist
+	}
+
+	return nil, unexpectedResponse(resp)
+}
+
+func (s *BlobStorage) objectExists(ctx context.Context, key string) (bool, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, 0, emptyPayloadSHA)
+	if err != nil {
+		return false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		resp.Body.Close()
+
+		return true, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+
+		return false, nil
+	}
+
+	return false, unexpectedResponse(resp)
+}
+
+func (s *BlobStorage) putObject(ctx context.Context, blob app.Blob, body io.Reader) error {
+	resp, err := s.do(ctx, http.MethodPut, blob.Key, body, blob.Size, blob.Key)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedResponse(resp)
+	}
+
+	resp.Body.Close()
+
+	return nil
+}
+
+func (s *BlobStorage) do(
+	ctx context.Context,
+	method, key string,
+	body io.Reader, size int64, payloadHash string,
+) (*http.Response, error) {
+	objectURL := *s.endpoint
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + s.bucket + "/" + key
+
+	r, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	if body != nil {
+		r.ContentLength = size
+		r.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	s.signer.sign(r, payloadHash, time.Now())
+
+	resp, err := s.client.Do(r)
+	if err != nil {
+		return nil, app.Wrap(app.ErrBlobStorageProblems, err)
+	}
+
+	return resp, nil
+}
+
+// unexpectedResponse closes response and returns error
+// with its status and beginning of S3 error document.
+func unexpectedResponse(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	details, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+	return app.Wrap(
+		app.ErrBlobStorageProblems,
+		errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(details))),
+	)
+}
+
+// isBlobKey reports whether key is hex encoded SHA-256,
+// so it can't point to another object of bucket.
+func isBlobKey(key string) bool {
+	if len(key) != blobKeyLen {
+		return false
+	}
+
+	_, err := hex.DecodeString(key)
+
+	return err == nil
+}
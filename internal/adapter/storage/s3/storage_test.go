@@ -0,0 +1,159 @@
+package s3_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/storage/s3"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/config"
+)
+
+const bucket = "materials"
+
+func TestBlobStorage_SaveBlob(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeS3()
+	storage := newBlobStorage(t, fake)
+
+	first, err := storage.SaveBlob(context.Background(), strings.NewReader("lecture slides"))
+	require.NoError(t, err)
+	second, err := storage.SaveBlob(context.Background(), strings.NewReader("lecture slides"))
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+	require.Equal(t, int64(len("lecture slides")), first.Size)
+	require.Equal(t, 1, fake.putsNumber(), "same content should be uploaded once")
+
+	data, err := storage.OpenBlob(context.Background(), first.Key)
+	require.NoError(t, err)
+
+	defer data.Close()
+
+	content, err := io.ReadAll(data)
+	require.NoError(t, err)
+	require.Equal(t, "lecture slides", string(content))
+}
+
+func TestBlobStorage_OpenBlob(t *testing.T) {
+	t.Parallel()
+
+	storage := newBlobStorage(t, newFakeS3())
+
+	_, err := storage.OpenBlob(context.Background(), strings.Repeat("a", 64))
+	require.True(t, errors.Is(err, app.ErrBlobDoesntExist))
+
+	_, err = storage.OpenBlob(context.Background(), "../other-bucket/object")
+	require.True(t, errors.Is(err, app.ErrBlobDoesntExist))
+}
+
+func TestBlobStorage_SaveBlob_storageProblems(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	storage := s3.NewBlobStorage(config.S3Config{
+		Endpoint: server.URL,
+		Region:   "us-east-1",
+		Bucket:   bucket,
+	}, server.Client())
+
+	_, err := storage.SaveBlob(context.Background(), strings.NewReader("lecture slides"))
+	require.True(t, errors.Is(err, app.ErrBlobStorageProblems))
+	require.Contains(t, err.Error(), "403 Forbidden")
+}
+
+func newBlobStorage(t *testing.T, fake *fakeS3) *s3.BlobStorage {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return s3.NewBlobStorage(config.S3Config{
+		Endpoint:        server.URL,
+		Region:          "eu-central-1",
+		Bucket:          bucket,
+		AccessKeyID:     "access-key-id",
+		SecretAccessKey: "secret-access-key",
+	}, server.Client())
+}
+
+// fakeS3 is local stand-in of S3 compatible storage,
+// it keeps objects of single bucket in memory.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string][]byte)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key-id/") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/"+bucket+"/")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodHead, http.MethodGet:
+		content, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(content)
+		}
+	case http.MethodPut:
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		sum := sha256.Sum256(content)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		f.objects[key] = content
+		f.puts++
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) putsNumber() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.puts
+}
@@ -0,0 +1,113 @@
+package tracking
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type viewsAppender interface {
+	AppendViews(ctx context.Context, events []app.ViewEvent) error
+}
+
+// ViewsRecorder buffers view events and appends them to storage
+// in background by batches, so requests aren't slowed down by tracking.
+// Events are dropped when buffer is full.
+type ViewsRecorder struct {
+	appender      viewsAppender
+	events        chan app.ViewEvent
+	batchSize     int
+	flushInterval time.Duration
+	done          chan struct{}
+}
+
+const appendTimeout = 5 * time.Second
+
+func NewViewsRecorder(appender viewsAppender, bufferSize int, flushInterval time.Duration) *ViewsRecorder {
+	if appender == nil {
+		panic("viewsAppender is nil")
+	}
+
+	if bufferSize <= 0 {
+		panic("bufferSize isn't positive")
+	}
+
+	if flushInterval <= 0 {
+		panic("flushInterval isn't positive")
+	}
+
+	r := &ViewsRecorder{
+		appender:      appender,
+		events:        make(chan app.ViewEvent, bufferSize),
+		batchSize:     bufferSize,
+		flushInterval: flushInterval,
+		done:          make(chan struct{}),
+	}
+
+	go r.run()
+
+	return r
+}
+
+func (r *ViewsRecorder) RecordView(event app.ViewEvent) {
+	select {
+	case r.events <- event:
+	default:
+		logrus.WithFields(logrus.Fields{
+			"course_id":  event.CourseID,
+			"student_id": event.StudentID,
+		}).Warn("View event dropped, recorder buffer is full")
+	}
+}
+
+// Close appends buffered events and stops recorder,
+// RecordView mustn't be called after Close.
+func (r *ViewsRecorder) Close() {
+	close(r.events)
+	<-r.done
+}
+
+func (r *ViewsRecorder) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]app.ViewEvent, 0, r.batchSize)
+
+	for {
+		select {
+		case event, ok := <-r.events:
+			if !ok {
+				r.flush(batch)
+
+				return
+			}
+
+			batch = append(batch, event)
+			if len(batch) >= r.batchSize {
+				r.flush(batch)
+				batch = make([]app.ViewEvent, 0, r.batchSize)
+			}
+		case <-ticker.C:
+			r.flush(batch)
+			batch = make([]app.ViewEvent, 0, r.batchSize)
+		}
+	}
+}
+
+func (r *ViewsRecorder) flush(batch []app.ViewEvent) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), appendTimeout)
+	defer cancel()
+
+	if err := r.appender.AppendViews(ctx, batch); err != nil {
+		logrus.WithError(err).WithField("events", len(batch)).Error("Failed to append view events")
+	}
+}
@@ -0,0 +1,88 @@
+package tracking_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/tracking"
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type viewsAppender struct {
+	mu      sync.Mutex
+	batches [][]app.ViewEvent
+}
+
+func (a *viewsAppender) AppendViews(_ context.Context, events []app.ViewEvent) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.batches = append(a.batches, events)
+
+	return nil
+}
+
+func (a *viewsAppender) appendedBatches() [][]app.ViewEvent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.batches
+}
+
+func newViewEvent(studentID string) app.ViewEvent {
+	return app.ViewEvent{
+		CourseID:  "7d5b1f58-c1bd-4a4c-bd2e-d6ec1bd62ff6",
+		StudentID: studentID,
+		Item:      app.TaskItem(1),
+		ViewedAt:  time.Date(2042, time.March, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestViewsRecorder_Close_appendsBufferedEvents(t *testing.T) {
+	t.Parallel()
+
+	appender := &viewsAppender{}
+	recorder := tracking.NewViewsRecorder(appender, 10, time.Hour)
+
+	recorder.RecordView(newViewEvent("a"))
+	recorder.RecordView(newViewEvent("b"))
+	recorder.Close()
+
+	require.Equal(t, [][]app.ViewEvent{{newViewEvent("a"), newViewEvent("b")}}, appender.appendedBatches())
+}
+
+func TestViewsRecorder_RecordView_appendsFullBatch(t *testing.T) {
+	t.Parallel()
+
+	appender := &viewsAppender{}
+	recorder := tracking.NewViewsRecorder(appender, 2, time.Hour)
+
+	recorder.RecordView(newViewEvent("a"))
+	recorder.RecordView(newViewEvent("b"))
+
+	require.Eventually(t, func() bool {
+		return len(appender.appendedBatches()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	recorder.Close()
+	require.Len(t, appender.appendedBatches(), 1)
+}
+
+func TestViewsRecorder_RecordView_appendsByInterval(t *testing.T) {
+	t.Parallel()
+
+	appender := &viewsAppender{}
+	recorder := tracking.NewViewsRecorder(appender, 10, 10*time.Millisecond)
+
+	defer recorder.Close()
+
+	recorder.RecordView(newViewEvent("a"))
+
+	require.Eventually(t, func() bool {
+		return len(appender.appendedBatches()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
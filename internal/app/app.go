@@ -1,6 +1,9 @@
 package app
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type Application struct {
 	Commands Commands
@@ -13,9 +16,42 @@ type (
 		ExtendCourse       extendCourseHandler
 		AddCollaborator    addCollaboratorHandler
 		RemoveCollaborator removeCollaboratorHandler
+		ChangeRole         changeCollaboratorRoleHandler
 		AddStudent         addStudentHandler
 		RemoveStudent      removeStudentHandler
+		CompleteStudent    completeStudentHandler
+		AddGroup           addGroupHandler
+		RemoveGroup        removeGroupHandler
+		SyncGroup          syncGroupHandler
+		EnrollStudents     enrollStudentsHandler
+		CreateInvitation   createInvitationHandler
+		RevokeInvitation   revokeInvitationHandler
+		RedeemInvitation   redeemInvitationHandler
+		RequestEnrollment  requestEnrollmentHandler
+		ApproveEnrollment  approveEnrollmentRequestsHandler
+		RejectEnrollment   rejectEnrollmentRequestsHandler
 		AddTask            addTaskHandler
+		EditTask           editTaskHandler
+		RestoreTaskVersion restoreTaskVersionHandler
+		UploadTestData     uploadTestDataHandler
+
+		AttachAuxiliaryMaterial   attachAuxiliaryMaterialHandler
+		EditAuxiliaryMaterial     editAuxiliaryMaterialHandler
+		RemoveAuxiliaryMaterial   removeAuxiliaryMaterialHandler
+		ReorderAuxiliaryMaterials reorderAuxiliaryMaterialsHandler
+		UploadAuxiliaryMaterial   uploadAuxiliaryMaterialHandler
+
+		ChangeTeamSettings changeTeamSettingsHandler
+		CreateTeam         createTeamHandler
+		RenameTeam         renameTeamHandler
+		RemoveTeam         removeTeamHandler
+		AddTeamMembers     addTeamMembersHandler
+		RemoveTeamMember   removeTeamMemberHandler
+
+		ChangeCapacity     changeCourseCapacityHandler
+		RemoveFromWaitlist removeFromWaitlistHandler
+
+		DeactivateAcademic deactivateAcademicHandler
 	}
 
 	createCourseHandler interface {
@@ -52,39 +88,322 @@ type (
 		Handle(ctx context.Context, cmd RemoveCollaboratorCommand) error
 	}
 
+	changeCollaboratorRoleHandler interface {
+		// Handle is ChangeCollaboratorRoleCommand handler.
+		// Changes role of one collaborator, only creator of course can do it, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchCollaborator,
+		// course.ErrInvalidCollaboratorRole, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd ChangeCollaboratorRoleCommand) error
+	}
+
 	addStudentHandler interface {
 		// Handle is AddStudentCommand handler.
 		// Adds one student to course, returns one of possible errors:
 		// app.ErrStudentDoesntExist, app.ErrCourseDoesntExist, app.ErrDatabaseProblems,
-		// error that can be detected using method course.IsAcademicCantEditCourseError and
-		// others without definition.
+		// errors that can be detected using methods course.IsAcademicCantEditCourseError,
+		// course.IsCourseIsFullError and others without definition.
 		Handle(ctx context.Context, cmd AddStudentCommand) error
 	}
 
 	removeStudentHandler interface {
 		// Handle is RemoveStudentCommand handler.
-		// Removes one student from course, returns one of possible errors:
-		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchStudent
-		// error that can be detected using method course.IsAcademicCantEditCourseError and others without definition.
+		// Withdraws one student from course keeping enrollment record, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchStudent,
+		// course.ErrWithdrawalReasonTooLong, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
 		Handle(ctx context.Context, cmd RemoveStudentCommand) error
 	}
 
+	completeStudentHandler interface {
+		// Handle is CompleteStudentCommand handler.
+		// Marks that student completed course, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchStudent,
+		// course.ErrStudentAlreadyCompleted, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd CompleteStudentCommand) error
+	}
+
+	addGroupHandler interface {
+		// Handle is AddGroupCommand handler.
+		// Adds students of academic group to course and remembers group they came from,
+		// returns one of possible errors: app.ErrGroupDoesntExist, app.ErrCourseDoesntExist,
+		// app.ErrDatabaseProblems, errors that can be detected using methods
+		// course.IsAcademicCantEditCourseError, course.IsCourseIsFullError and others without definition.
+		Handle(ctx context.Context, cmd AddGroupCommand) error
+	}
+
+	removeGroupHandler interface {
+		// Handle is RemoveGroupCommand handler.
+		// Removes group and students who came from it, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchGroup,
+		// error that can be detected using method course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd RemoveGroupCommand) error
+	}
+
+	syncGroupHandler interface {
+		// Handle is SyncGroupCommand handler.
+		// Adds students who joined group later and removes students who came from group but left it,
+		// returns the same errors as RemoveGroupCommand handler, app.ErrGroupDoesntExist and error
+		// that can be detected using method course.IsCourseIsFullError.
+		Handle(ctx context.Context, cmd SyncGroupCommand) error
+	}
+
+	enrollStudentsHandler interface {
+		// Handle is EnrollStudentsCommand handler.
+		// Checks every row is ID or email of existing student and adds all found students at once,
+		// returns report with status of each row and one of possible errors:
+		// app.ErrEnrollmentTooLarge, app.ErrCourseDoesntExist, app.ErrDatabaseProblems, errors that can be
+		// detected using methods course.IsAcademicCantEditCourseError, course.IsCourseIsFullError and others
+		// without definition.
+		Handle(ctx context.Context, cmd EnrollStudentsCommand) ([]EnrollmentRow, error)
+	}
+
+	createInvitationHandler interface {
+		// Handle is CreateInvitationCommand handler.
+		// Adds invitation with generated code to course, returns code and one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, errors that can be detected using methods
+		// course.IsInvalidInvitationError, course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd CreateInvitationCommand) (string, error)
+	}
+
+	revokeInvitationHandler interface {
+		// Handle is RevokeInvitationCommand handler.
+		// Removes invitation from course, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchInvitation,
+		// error that can be detected using method course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd RevokeInvitationCommand) error
+	}
+
+	redeemInvitationHandler interface {
+		// Handle is RedeemInvitationCommand handler.
+		// Adds academic to course of invitation as student or collaborator and spends one use of
+		// invitation, concurrent redemptions never exceed max uses. Student is waitlisted when course
+		// is full. Returns ID of course with waitlisting flag and one of possible errors:
+		// app.ErrInvitationDoesntExist, app.ErrDatabaseProblems, course.ErrStudentAlreadyWaitlisted,
+		// error that can be detected using method course.IsInvitationNotRedeemableError and others without definition.
+		Handle(ctx context.Context, cmd RedeemInvitationCommand) (RedeemedInvitation, error)
+	}
+
+	requestEnrollmentHandler interface {
+		// Handle is RequestEnrollmentCommand handler.
+		// Adds pending enrollment request of student, returns ID of request and one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrNotStudentCantRequestEnrollment,
+		// course.ErrAcademicAlreadyInCourse, course.ErrEnrollmentAlreadyRequested, error that can be
+		// detected using method course.IsInvalidEnrollmentRequestError and others without definition.
+		Handle(ctx context.Context, cmd RequestEnrollmentCommand) (string, error)
+	}
+
+	approveEnrollmentRequestsHandler interface {
+		// Handle is ApproveEnrollmentRequestsCommand handler.
+		// Approves all requests or none and adds their students to course, students who don't fit
+		// into full course are put to waitlist. Returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchEnrollmentRequest,
+		// course.ErrEnrollmentRequestAlreadyReviewed, course.ErrStudentAlreadyWaitlisted, errors that
+		// can be detected using method course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd ApproveEnrollmentRequestsCommand) error
+	}
+
+	rejectEnrollmentRequestsHandler interface {
+		// Handle is RejectEnrollmentRequestsCommand handler.
+		// Rejects all requests or none, returns the same errors as ApproveEnrollmentRequestsCommand handler.
+		Handle(ctx context.Context, cmd RejectEnrollmentRequestsCommand) error
+	}
+
 	addTaskHandler interface {
 		// Handle is AddTaskCommand handler.
 		// Adds task with manual checking, auto code checking or testing type,
-		// returns one of possible errors. app.ErrCourseDoesntExist, errors that can
-		// be detected using methods course.IsInvalidTaskParametersError,
+		// returns one of possible errors. app.ErrCourseDoesntExist, app.ErrTestDataDoesntExist,
+		// app.ErrLanguageNotSupported, errors that can be detected using methods
+		// course.IsInvalidTaskParametersError, course.IsInvalidLanguagesError,
 		// course.IsAcademicCantEditCourseError and others without definition.
 		Handle(ctx context.Context, cmd AddTaskCommand) (int, error)
 	}
+
+	editTaskHandler interface {
+		// Handle is EditTaskCommand handler.
+		// Edits task and commits changes as new task version, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrTestDataDoesntExist, app.ErrLanguageNotSupported,
+		// app.ErrDatabaseProblems, course.ErrCourseHasNoSuchTask, errors that can be detected
+		// using methods course.IsInvalidTaskParametersError, course.IsInvalidLanguagesError,
+		// course.IsInvalidExecutionLimitsError, course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd EditTaskCommand) error
+	}
+
+	restoreTaskVersionHandler interface {
+		// Handle is RestoreTaskVersionCommand handler.
+		// Restores content of task version as new task version, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchTask,
+		// course.ErrTaskHasNoSuchVersion, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd RestoreTaskVersionCommand) error
+	}
+
+	uploadTestDataHandler interface {
+		// Handle is UploadTestDataCommand handler.
+		// Stores large test input or output outside the course, returns ID of stored
+		// test data and one of possible errors: app.ErrCourseDoesntExist, app.ErrTestDataTooLarge,
+		// app.ErrDatabaseProblems, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd UploadTestDataCommand) (string, error)
+	}
+
+	attachAuxiliaryMaterialHandler interface {
+		// Handle is AttachAuxiliaryMaterialCommand handler.
+		// Attaches auxiliary material to course or its task, returns ID of attached material and
+		// one of possible errors: app.ErrCourseDoesntExist, app.ErrDatabaseProblems,
+		// course.ErrCourseHasNoSuchTask, errors that can be detected using methods course.IsInvalidAuxiliaryMaterialError,
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd AttachAuxiliaryMaterialCommand) (string, error)
+	}
+
+	editAuxiliaryMaterialHandler interface {
+		// Handle is EditAuxiliaryMaterialCommand handler.
+		// Replaces auxiliary material keeping its uploaded file, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchMaterial,
+		// course.ErrCourseHasNoSuchTask, errors that can be detected using methods course.IsInvalidAuxiliaryMaterialError,
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd EditAuxiliaryMaterialCommand) error
+	}
+
+	removeAuxiliaryMaterialHandler interface {
+		// Handle is RemoveAuxiliaryMaterialCommand handler.
+		// Removes auxiliary material from course, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchMaterial,
+		// error that can be detected using method course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd RemoveAuxiliaryMaterialCommand) error
+	}
+
+	reorderAuxiliaryMaterialsHandler interface {
+		// Handle is ReorderAuxiliaryMaterialsCommand handler.
+		// Sorts all auxiliary materials of course at once, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrInvalidMaterialsOrder,
+		// error that can be detected using method course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd ReorderAuxiliaryMaterialsCommand) error
+	}
+
+	uploadAuxiliaryMaterialHandler interface {
+		// Handle is UploadAuxiliaryMaterialCommand handler.
+		// Saves uploaded file to blob storage and attaches it to course or its task as auxiliary
+		// material, returns ID of attached material and one of possible errors: app.ErrCourseDoesntExist,
+		// course.ErrCourseHasNoSuchTask, app.ErrMaterialFileTooLarge, app.ErrMaterialFileTypeNotAllowed,
+		// app.ErrBlobStorageProblems, app.ErrDatabaseProblems, errors that can be detected using methods
+		// course.IsInvalidAuxiliaryMaterialError, course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd UploadAuxiliaryMaterialCommand) (string, error)
+	}
+
+	changeTeamSettingsHandler interface {
+		// Handle is ChangeTeamSettingsCommand handler.
+		// Changes whether students form teams by themselves and maximum team size, returns
+		// one of possible errors: app.ErrCourseDoesntExist, app.ErrDatabaseProblems, errors that
+		// can be detected using methods course.IsInvalidTeamParametersError,
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd ChangeTeamSettingsCommand) error
+	}
+
+	createTeamHandler interface {
+		// Handle is CreateTeamCommand handler.
+		// Creates team of students, student creates team only of himself with self formation,
+		// returns ID of created team and one of possible errors: app.ErrCourseDoesntExist,
+		// app.ErrDatabaseProblems, course.ErrTeamSelfFormationDisabled, errors that can be
+		// detected using methods course.IsInvalidTeamParametersError, course.IsTeamMembershipError,
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd CreateTeamCommand) (string, error)
+	}
+
+	renameTeamHandler interface {
+		// Handle is RenameTeamCommand handler.
+		// Renames team, student renames only own team with self formation, returns one of
+		// possible errors: app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchTeam,
+		// course.ErrTeamSelfFormationDisabled, errors that can be detected using methods
+		// course.IsInvalidTeamParametersError, course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd RenameTeamCommand) error
+	}
+
+	removeTeamHandler interface {
+		// Handle is RemoveTeamCommand handler.
+		// Removes team keeping its students in course, returns one of possible errors:
+		// app.ErrCourseDoesntExist, app.ErrDatabaseProblems, course.ErrCourseHasNoSuchTeam,
+		// error that can be detected using method course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd RemoveTeamCommand) error
+	}
+
+	addTeamMembersHandler interface {
+		// Handle is AddTeamMembersCommand handler.
+		// Adds all students to team or none, student joins team only by himself with self formation,
+		// returns one of possible errors: app.ErrCourseDoesntExist, app.ErrDatabaseProblems,
+		// course.ErrCourseHasNoSuchTeam, course.ErrTeamSelfFormationDisabled, errors that can be
+		// detected using methods course.IsTeamMembershipError, course.IsAcademicCantEditCourseError
+		// and others without definition.
+		Handle(ctx context.Context, cmd AddTeamMembersCommand) error
+	}
+
+	removeTeamMemberHandler interface {
+		// Handle is RemoveTeamMemberCommand handler.
+		// Removes student from team, student leaves team only by himself with self formation,
+		// returns the same errors as AddTeamMembersCommand handler.
+		Handle(ctx context.Context, cmd RemoveTeamMemberCommand) error
+	}
+
+	changeCourseCapacityHandler interface {
+		// Handle is ChangeCourseCapacityCommand handler.
+		// Changes maximum number of course students, zero removes limit, and fills free seats
+		// from waitlist. Returns one of possible errors: app.ErrCourseDoesntExist,
+		// app.ErrDatabaseProblems, course.ErrNegativeCourseCapacity, error that can be detected
+		// using method course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd ChangeCourseCapacityCommand) error
+	}
+
+	removeFromWaitlistHandler interface {
+		// Handle is RemoveFromWaitlistCommand handler.
+		// Removes student from waitlist of course, student can leave waitlist by himself.
+		// Returns one of possible errors: app.ErrCourseDoesntExist, app.ErrDatabaseProblems,
+		// course.ErrCourseHasNoSuchWaitlisted, error that can be detected using method
+		// course.IsAcademicCantEditCourseError and others without definition.
+		Handle(ctx context.Context, cmd RemoveFromWaitlistCommand) error
+	}
+
+	deactivateAcademicHandler interface {
+		// Handle is DeactivateAcademicCommand handler.
+		// Removes deactivated academic from all courses, replaces deactivated creator by co-teacher,
+		// returns report of changed courses and one of possible errors: app.ErrDatabaseProblems,
+		// course.ErrEmptyAcademicID and others without definition. Report lists courses changed
+		// before error, handling the same command again is safe.
+		Handle(ctx context.Context, cmd DeactivateAcademicCommand) (DeactivationReport, error)
+	}
 )
 
 type (
 	Queries struct {
-		SpecificCourse specificCourseHandler
-		AllCourses     allCoursesHandler
-		SpecificTask   specificTaskHandler
-		AllTasks       allTasksHandler
+		SpecificCourse      specificCourseHandler
+		AllCourses          allCoursesHandler
+		SpecificTask        specificTaskHandler
+		AllTasks            allTasksHandler
+		TaskVersions        taskVersionsHandler
+		SpecificTaskVersion specificTaskVersionHandler
+		TaskVersionsDiff    taskVersionsDiffHandler
+		StoredTestData      storedTestDataHandler
+
+		AllAuxiliaryMaterials     allAuxiliaryMaterialsHandler
+		SpecificAuxiliaryMaterial specificAuxiliaryMaterialHandler
+		AuxiliaryMaterialFile     auxiliaryMaterialFileHandler
+
+		AllCourseStudents      allCourseStudentsHandler
+		AllCourseCollaborators allCourseCollaboratorsHandler
+
+		CourseViewsReport courseViewsReportHandler
+		AllInvitations    allInvitationsHandler
+
+		EnrollmentRequests enrollmentRequestsHandler
+
+		AllTeams allTeamsHandler
+
+		CourseWaitlist courseWaitlistHandler
 	}
 
 	specificCourseHandler interface {
@@ -100,11 +419,31 @@ type (
 		Handle(ctx context.Context, qry AllCoursesQuery) ([]CommonCourse, error)
 	}
 
+	allCourseStudentsHandler interface {
+		// Handle is AllCourseStudentsQuery handler.
+		// Returns page of course students with full names and enrollments sorted by full name,
+		// students are filtered by full name substring. Withdrawn students are included on
+		// demand only for teachers.
+		// Student without resolved profile has empty full name and is placed last.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry AllCourseStudentsQuery) (AcademicsPage, error)
+	}
+
+	allCourseCollaboratorsHandler interface {
+		// Handle is AllCourseCollaboratorsQuery handler.
+		// Returns page of course collaborators with full names sorted by full name.
+		// Collaborator without resolved profile has empty full name and is placed last.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry AllCourseCollaboratorsQuery) (AcademicsPage, error)
+	}
+
 	specificTaskHandler interface {
 		// Handle is SpecificTaskQuery handler.
-		// Returns course task with given number.
+		// Returns course task with given number and auxiliary materials attached to it.
+		// Student gets only sample test data and number of hidden test data.
 		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
 		// If task doesn't exist, an error equal app.ErrTaskDoesntExist.
+		// View of task is recorded when student obtains it.
 		Handle(ctx context.Context, qry SpecificTaskQuery) (SpecificTask, error)
 	}
 
@@ -115,4 +454,106 @@ type (
 		// If course doesn't exist, error equal app.ErrCourseDoesntExist.
 		Handle(ctx context.Context, qry AllTasksQuery) ([]GeneralTask, error)
 	}
+
+	taskVersionsHandler interface {
+		// Handle is TaskVersionsQuery handler.
+		// Returns versions of course task, only teachers of course
+		// with review access, e.g. not observers, can obtain them.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		// If task doesn't exist, an error equal app.ErrTaskDoesntExist.
+		Handle(ctx context.Context, qry TaskVersionsQuery) ([]GeneralTaskVersion, error)
+	}
+
+	specificTaskVersionHandler interface {
+		// Handle is SpecificTaskVersionQuery handler.
+		// Returns task content as it was in version, only teachers
+		// of course with review access can obtain it.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		// If task or its version doesn't exist, an error equal app.ErrTaskDoesntExist
+		// or app.ErrTaskVersionDoesntExist.
+		Handle(ctx context.Context, qry SpecificTaskVersionQuery) (TaskVersion, error)
+	}
+
+	taskVersionsDiffHandler interface {
+		// Handle is TaskVersionsDiffQuery handler.
+		// Returns both task versions, changed task fields and line diff of description.
+		// Returns the same errors as SpecificTaskVersionQuery handler.
+		Handle(ctx context.Context, qry TaskVersionsDiffQuery) (TaskVersionsDiff, error)
+	}
+
+	storedTestDataHandler interface {
+		// Handle is StoredTestDataQuery handler.
+		// Returns content of stored test data, teachers of course can obtain any of it,
+		// students only data of sample tests of course tasks.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		// If test data doesn't exist, an error equal app.ErrTestDataDoesntExist.
+		Handle(ctx context.Context, qry StoredTestDataQuery) (io.ReadCloser, error)
+	}
+
+	allAuxiliaryMaterialsHandler interface {
+		// Handle is AllAuxiliaryMaterialsQuery handler.
+		// Returns auxiliary materials of course in their order filtered by resource type and task,
+		// teachers and students of course can obtain them, students get only released ones.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry AllAuxiliaryMaterialsQuery) ([]AuxiliaryMaterial, error)
+	}
+
+	specificAuxiliaryMaterialHandler interface {
+		// Handle is SpecificAuxiliaryMaterialQuery handler.
+		// Returns auxiliary material of course with given ID, students get only released one.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		// If material doesn't exist, an error equal app.ErrAuxiliaryMaterialDoesntExist.
+		// View of material is recorded when student obtains it.
+		Handle(ctx context.Context, qry SpecificAuxiliaryMaterialQuery) (AuxiliaryMaterial, error)
+	}
+
+	auxiliaryMaterialFileHandler interface {
+		// Handle is AuxiliaryMaterialFileQuery handler.
+		// Opens uploaded file of auxiliary material, teachers and students of course can obtain it.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		// If material doesn't exist, an error equal app.ErrAuxiliaryMaterialDoesntExist.
+		// If material isn't file or its content is lost, an error equal app.ErrMaterialFileDoesntExist.
+		// View of material is recorded when student opens its file.
+		Handle(ctx context.Context, qry AuxiliaryMaterialFileQuery) (MaterialFileContent, error)
+	}
+
+	courseViewsReportHandler interface {
+		// Handle is CourseViewsReportQuery handler.
+		// Returns number of unique student viewers and students who never opened
+		// each task and auxiliary material, only teachers of course with review access can obtain it.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry CourseViewsReportQuery) (CourseViewsReport, error)
+	}
+
+	allInvitationsHandler interface {
+		// Handle is AllInvitationsQuery handler.
+		// Returns invitations of course sorted by expiry, only creator
+		// and co-teachers of course, who edit its members, can obtain them.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry AllInvitationsQuery) ([]Invitation, error)
+	}
+
+	enrollmentRequestsHandler interface {
+		// Handle is EnrollmentRequestsQuery handler.
+		// Returns enrollment requests of course from the oldest, only creator
+		// and co-teachers of course, who edit its members, can obtain them.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry EnrollmentRequestsQuery) ([]EnrollmentRequest, error)
+	}
+
+	allTeamsHandler interface {
+		// Handle is AllTeamsQuery handler.
+		// Returns team settings and teams of course sorted by name,
+		// only teachers and students of course can obtain them.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry AllTeamsQuery) (Teams, error)
+	}
+
+	courseWaitlistHandler interface {
+		// Handle is CourseWaitlistQuery handler.
+		// Returns capacity, waitlist and promotions from waitlist of course,
+		// only teachers of course can obtain them.
+		// If course doesn't exist, an error equal app.ErrCourseDoesntExist.
+		Handle(ctx context.Context, qry CourseWaitlistQuery) (Waitlist, error)
+	}
 )
@@ -1,18 +1,33 @@
 package app
 
-import "github.com/authena-ru/courses-organization/internal/domain/course"
+import (
+	"io"
+	"time"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
 
 type (
+	// AddCollaboratorCommand adds one or several teachers to course,
+	// existence of several teachers is checked by one request.
 	AddCollaboratorCommand struct {
-		Academic       course.Academic
-		CourseID       string
-		CollaboratorID string
+		Academic        course.Academic
+		CourseID        string
+		CollaboratorIDs []string
+	}
+
+	AddGroupCommand struct {
+		Academic course.Academic
+		CourseID string
+		GroupID  string
 	}
 
+	// AddStudentCommand adds one or several students to course,
+	// existence of several students is checked by one request.
 	AddStudentCommand struct {
-		Academic  course.Academic
-		CourseID  string
-		StudentID string
+		Academic   course.Academic
+		CourseID   string
+		StudentIDs []string
 	}
 
 	AddTaskCommand struct {
@@ -21,9 +36,62 @@ type (
 		TaskTitle       string
 		TaskDescription string
 		TaskType        course.TaskType
+		TeamWork        bool
 		Deadline        course.Deadline
 		TestPoints      []course.TestPoint
 		TestData        []course.TestData
+		Checker         course.Checker
+		Languages       []string
+		Limits          course.ExecutionLimits
+	}
+
+	AddTeamMembersCommand struct {
+		Academic   course.Academic
+		CourseID   string
+		TeamID     string
+		StudentIDs []string
+	}
+
+	ApproveEnrollmentRequestsCommand struct {
+		Academic   course.Academic
+		CourseID   string
+		RequestIDs []string
+	}
+
+	AttachAuxiliaryMaterialCommand struct {
+		Academic     course.Academic
+		CourseID     string
+		TaskNumber   int
+		Resource     string
+		ResourceType course.ResourceType
+		ReleaseTime  time.Time
+		Hidden       bool
+	}
+
+	ChangeCollaboratorRoleCommand struct {
+		Academic       course.Academic
+		CourseID       string
+		CollaboratorID string
+		Role           course.CollaboratorRole
+	}
+
+	ChangeCourseCapacityCommand struct {
+		Academic course.Academic
+		CourseID string
+		Capacity int
+	}
+
+	ChangeTeamSettingsCommand struct {
+		Academic      course.Academic
+		CourseID      string
+		SelfFormation bool
+		MaxSize       int
+	}
+
+	CompleteStudentCommand struct {
+		Academic  course.Academic
+		CourseID  string
+		StudentID string
 	}
 
 	CreateCourseCommand struct {
@@ -33,6 +101,62 @@ type (
 		CoursePeriod  course.Period
 	}
 
+	CreateInvitationCommand struct {
+		Academic  course.Academic
+		CourseID  string
+		Role      course.AcademicType
+		ExpiresAt time.Time
+		MaxUses   int
+	}
+
+	CreateTeamCommand struct {
+		Academic  course.Academic
+		CourseID  string
+		Name      string
+		MemberIDs []string
+	}
+
+	// DeactivateAcademicCommand comes from academics directory when
+	// student or teacher is deleted or deactivated there.
+	DeactivateAcademicCommand struct {
+		AcademicID string
+	}
+
+	EditAuxiliaryMaterialCommand struct {
+		Academic     course.Academic
+		CourseID     string
+		MaterialID   string
+		TaskNumber   int
+		Resource     string
+		ResourceType course.ResourceType
+		ReleaseTime  time.Time
+		Hidden       bool
+	}
+
+	EditTaskCommand struct {
+		Academic        course.Academic
+		CourseID        string
+		TaskNumber      int
+		TaskTitle       *string
+		TaskDescription *string
+		TeamWork        *bool
+		Deadline        *course.Deadline
+		TestPoints      []course.TestPoint
+		TestData        []course.TestData
+		Checker         *course.Checker
+		Languages       []string
+		Limits          *course.ExecutionLimits
+	}
+
+	// EnrollStudentsCommand enrolls students by their IDs or emails,
+	// in dry run mode course isn't changed, only report is built.
+	EnrollStudentsCommand struct {
+		Academic course.Academic
+		CourseID string
+		Rows     []string
+		DryRun   bool
+	}
+
 	ExtendCourseCommand struct {
 		Academic       course.Academic
 		OriginCourseID string
@@ -41,15 +165,113 @@ type (
 		CoursePeriod   course.Period
 	}
 
+	RedeemInvitationCommand struct {
+		Academic course.Academic
+		Code     string
+	}
+
+	RejectEnrollmentRequestsCommand struct {
+		Academic   course.Academic
+		CourseID   string
+		RequestIDs []string
+	}
+
+	RemoveAuxiliaryMaterialCommand struct {
+		Academic   course.Academic
+		CourseID   string
+		MaterialID string
+	}
+
 	RemoveCollaboratorCommand struct {
 		Academic       course.Academic
 		CourseID       string
 		CollaboratorID string
 	}
 
+	RemoveFromWaitlistCommand struct {
+		Academic  course.Academic
+		CourseID  string
+		StudentID string
+	}
+
+	RemoveGroupCommand struct {
+		Academic course.Academic
+		CourseID string
+		GroupID  string
+	}
+
 	RemoveStudentCommand struct {
 		Academic  course.Academic
 		CourseID  string
 		StudentID string
+		Reason    string
+	}
+
+	RemoveTeamCommand struct {
+		Academic course.Academic
+		CourseID string
+		TeamID   string
+	}
+
+	RemoveTeamMemberCommand struct {
+		Academic  course.Academic
+		CourseID  string
+		TeamID    string
+		StudentID string
+	}
+
+	RenameTeamCommand struct {
+		Academic course.Academic
+		CourseID string
+		TeamID   string
+		Name     string
+	}
+
+	ReorderAuxiliaryMaterialsCommand struct {
+		Academic    course.Academic
+		CourseID    string
+		MaterialIDs []string
+	}
+
+	RequestEnrollmentCommand struct {
+		Academic course.Academic
+		CourseID string
+		Message  string
+	}
+
+	RestoreTaskVersionCommand struct {
+		Academic   course.Academic
+		CourseID   string
+		TaskNumber int
+		Version    int
+	}
+
+	RevokeInvitationCommand struct {
+		Academic course.Academic
+		CourseID string
+		Code     string
+	}
+
+	SyncGroupCommand struct {
+		Academic course.Academic
+		CourseID string
+		GroupID  string
+	}
+
+	UploadAuxiliaryMaterialCommand struct {
+		Academic     course.Academic
+		CourseID     string
+		TaskNumber   int
+		ResourceType course.ResourceType
+		ReleaseTime  time.Time
+		Hidden       bool
+		FileName     string
+		Data         io.Reader
+	}
+
+	UploadTestDataCommand struct {
+		Academic course.Academic
+		CourseID string
+		Data     io.Reader
 	}
 )
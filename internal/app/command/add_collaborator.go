@@ -30,22 +30,22 @@ func NewAddCollaboratorHandler(repository coursesRepository, service academicsSe
 }
 
 func (h AddCollaboratorHandler) Handle(ctx context.Context, cmd app.AddCollaboratorCommand) error {
-	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.addCollaborator(cmd))
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.addCollaborators(cmd))
 
 	return errors.Wrapf(
 		err,
-		"adding collaborator #%s to course #%s by academic #%s",
-		cmd.CollaboratorID, cmd.CourseID, cmd.Academic.ID(),
+		"adding collaborators %v to course #%s by academic #%s",
+		cmd.CollaboratorIDs, cmd.CourseID, cmd.Academic.ID(),
 	)
 }
 
-func (h AddCollaboratorHandler) addCollaborator(cmd app.AddCollaboratorCommand) UpdateFunction {
+func (h AddCollaboratorHandler) addCollaborators(cmd app.AddCollaboratorCommand) UpdateFunction {
 	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
-		if err := h.academicsService.TeacherExists(ctx, cmd.CollaboratorID); err != nil {
+		if err := teachersExist(ctx, h.academicsService, cmd.CollaboratorIDs); err != nil {
 			return nil, err
 		}
 
-		if err := crs.AddCollaborators(cmd.Academic, cmd.CollaboratorID); err != nil {
+		if err := crs.AddCollaborators(cmd.Academic, cmd.CollaboratorIDs...); err != nil {
 			return nil, err
 		}
 
@@ -20,7 +20,7 @@ func TestAddCollaboratorHandler_Handle(t *testing.T) {
 		return mock.NewCoursesRepository(crs)
 	}
 	addCollaborator := func() *mock.AcademicsService {
-		return mock.NewAcademicsService([]string{"collaborator-id"}, nil, nil)
+		return mock.NewAcademicsService([]string{"collaborator-id", "another-collaborator-id"}, nil, nil)
 	}
 	testCases := []struct {
 		Name                     string
@@ -32,19 +32,42 @@ func TestAddCollaboratorHandler_Handle(t *testing.T) {
 		{
 			Name: "add_collaborator",
 			Command: app.AddCollaboratorCommand{
-				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:       "course-id",
-				CollaboratorID: "collaborator-id",
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService:  addCollaborator,
 		},
+		{
+			Name: "add_several_collaborators",
+			Command: app.AddCollaboratorCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id", "another-collaborator-id"},
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addCollaborator,
+		},
+		{
+			Name: "dont_add_any_when_some_collaborators_dont_exist",
+			Command: app.AddCollaboratorCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id", "unknown-collaborator-id"},
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addCollaborator,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrTeacherDoesntExist)
+			},
+		},
 		{
 			Name: "dont_add_when_teacher_cant_edit_course",
 			Command: app.AddCollaboratorCommand{
-				Academic:       course.MustNewAcademic("other-creator-id", course.TeacherType),
-				CourseID:       "course-id",
-				CollaboratorID: "collaborator-id",
+				Academic:        course.MustNewAcademic("other-creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService:  addCollaborator,
@@ -53,9 +76,9 @@ func TestAddCollaboratorHandler_Handle(t *testing.T) {
 		{
 			Name: "dont_add_when_collaborator_doesnt_exist_as_teacher",
 			Command: app.AddCollaboratorCommand{
-				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:       "course-id",
-				CollaboratorID: "collaborator-id",
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService: func() *mock.AcademicsService {
@@ -68,9 +91,9 @@ func TestAddCollaboratorHandler_Handle(t *testing.T) {
 		{
 			Name: "dont_add_when_course_doesnt_exist",
 			Command: app.AddCollaboratorCommand{
-				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:       "course-id",
-				CollaboratorID: "collaborator-id",
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				CollaboratorIDs: []string{"collaborator-id"},
 			},
 			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
 				return mock.NewCoursesRepository()
@@ -102,12 +125,14 @@ func TestAddCollaboratorHandler_Handle(t *testing.T) {
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
-				require.NotContains(t, crs.Collaborators(), c.Command.CollaboratorID)
+				for _, id := range c.Command.CollaboratorIDs {
+					require.NotContains(t, crs.Collaborators(), id)
+				}
 
 				return
 			}
 			require.NoError(t, err)
-			require.Contains(t, crs.Collaborators(), c.Command.CollaboratorID)
+			require.Subset(t, crs.Collaborators(), c.Command.CollaboratorIDs)
 		})
 	}
 }
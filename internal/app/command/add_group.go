@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type AddGroupHandler struct {
+	coursesRepository coursesRepository
+	academicsService  academicsService
+}
+
+func NewAddGroupHandler(repository coursesRepository, service academicsService) AddGroupHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	return AddGroupHandler{
+		coursesRepository: repository,
+		academicsService:  service,
+	}
+}
+
+func (h AddGroupHandler) Handle(ctx context.Context, cmd app.AddGroupCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.addGroup(cmd))
+
+	return errors.Wrapf(
+		err,
+		"adding group #%s to course #%s by academic #%s",
+		cmd.GroupID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func (h AddGroupHandler) addGroup(cmd app.AddGroupCommand) UpdateFunction {
+	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
+		studentIDs, err := h.academicsService.GroupStudents(ctx, cmd.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := crs.AddGroup(cmd.Academic, cmd.GroupID, studentIDs, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
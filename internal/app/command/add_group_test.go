@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestAddGroupHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	addGroup := func() *mock.AcademicsService {
+		return mock.NewAcademicsService(nil, nil, nil).
+			WithGroupStudents("group-id", "student1-id", "student2-id")
+	}
+	testCases := []struct {
+		Name                     string
+		Command                  app.AddGroupCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		PrepareAcademicsService  func() *mock.AcademicsService
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "add_group",
+			Command: app.AddGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addGroup,
+		},
+		{
+			Name: "dont_add_when_teacher_cant_edit_course",
+			Command: app.AddGroupCommand{
+				Academic: course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addGroup,
+			IsErr:                    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_add_when_group_doesnt_exist",
+			Command: app.AddGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService: func() *mock.AcademicsService {
+				return mock.NewAcademicsService(nil, nil, nil)
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrGroupDoesntExist)
+			},
+		},
+		{
+			Name: "dont_add_when_course_doesnt_exist",
+			Command: app.AddGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			PrepareAcademicsService: addGroup,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:   "Math",
+				Period:  course.MustNewPeriod(2028, 2029, course.SecondSemester),
+			})
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			handler := command.NewAddGroupHandler(coursesRepository, c.PrepareAcademicsService())
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"student1-id", "student2-id"}, updatedCourse.Students())
+			require.Equal(t, []string{"group-id"}, updatedCourse.Groups())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -30,22 +31,22 @@ func NewAddStudentHandler(repository coursesRepository, service academicsService
 }
 
 func (h AddStudentHandler) Handle(ctx context.Context, cmd app.AddStudentCommand) error {
-	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.addStudent(cmd))
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.addStudents(cmd))
 
 	return errors.Wrapf(
 		err,
-		"adding student #%s to course #%s by academic #%s",
-		cmd.StudentID, cmd.CourseID, cmd.Academic.ID(),
+		"adding students %v to course #%s by academic #%s",
+		cmd.StudentIDs, cmd.CourseID, cmd.Academic.ID(),
 	)
 }
 
-func (h AddStudentHandler) addStudent(cmd app.AddStudentCommand) UpdateFunction {
+func (h AddStudentHandler) addStudents(cmd app.AddStudentCommand) UpdateFunction {
 	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
-		if err := h.academicsService.StudentExists(ctx, cmd.StudentID); err != nil {
+		if err := studentsExist(ctx, h.academicsService, cmd.StudentIDs); err != nil {
 			return nil, err
 		}
 
-		if err := crs.AddStudents(cmd.Academic, cmd.StudentID); err != nil {
+		if err := crs.AddStudents(cmd.Academic, time.Now(), cmd.StudentIDs...); err != nil {
 			return nil, err
 		}
 
@@ -20,7 +20,7 @@ func TestAddStudentHandler_Handle(t *testing.T) {
 		return mock.NewCoursesRepository(crs)
 	}
 	addStudent := func() *mock.AcademicsService {
-		return mock.NewAcademicsService(nil, []string{"student-id"}, nil)
+		return mock.NewAcademicsService(nil, []string{"student-id", "another-student-id"}, nil)
 	}
 	testCases := []struct {
 		Name                     string
@@ -32,19 +32,42 @@ func TestAddStudentHandler_Handle(t *testing.T) {
 		{
 			Name: "add_student",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:  "course-id",
-				StudentID: "student-id",
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService:  addStudent,
 		},
+		{
+			Name: "add_several_students",
+			Command: app.AddStudentCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id", "another-student-id"},
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addStudent,
+		},
+		{
+			Name: "dont_add_any_when_some_students_dont_exist",
+			Command: app.AddStudentCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id", "unknown-student-id"},
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  addStudent,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrStudentDoesntExist)
+			},
+		},
 		{
 			Name: "dont_add_when_teacher_cant_edit_course",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("other-teacher-id", course.TeacherType),
-				CourseID:  "course-id",
-				StudentID: "student-id",
+				Academic:   course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService:  addStudent,
@@ -53,9 +76,9 @@ func TestAddStudentHandler_Handle(t *testing.T) {
 		{
 			Name: "dont_add_when_student_doesnt_exist",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:  "course-id",
-				StudentID: "student-id",
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id"},
 			},
 			PrepareCoursesRepository: addCourse,
 			PrepareAcademicsService: func() *mock.AcademicsService {
@@ -68,9 +91,9 @@ func TestAddStudentHandler_Handle(t *testing.T) {
 		{
 			Name: "dont_add_when_course_doesnt_exist",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
-				CourseID:  "course-id",
-				StudentID: "student-id",
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				StudentIDs: []string{"student-id"},
 			},
 			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
 				return mock.NewCoursesRepository()
@@ -102,12 +125,14 @@ func TestAddStudentHandler_Handle(t *testing.T) {
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
-				require.NotContains(t, crs.Students(), c.Command.StudentID)
+				for _, id := range c.Command.StudentIDs {
+					require.NotContains(t, crs.Students(), id)
+				}
 
 				return
 			}
 			require.NoError(t, err)
-			require.Contains(t, crs.Students(), c.Command.StudentID)
+			require.Subset(t, crs.Students(), c.Command.StudentIDs)
 		})
 	}
 }
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -11,24 +12,98 @@ import (
 
 type AddTaskHandler struct {
 	coursesRepository coursesRepository
+	testDataStorage   testDataStorage
+	languageRegistry  languageRegistry
+	descriptionMaxLen int
 }
 
-func NewAddTaskHandler(repository coursesRepository) AddTaskHandler {
+func NewAddTaskHandler(
+	repository coursesRepository,
+	storage testDataStorage,
+	registry languageRegistry,
+	descriptionMaxLen int,
+) AddTaskHandler {
 	if repository == nil {
 		panic("coursesRepository is nil")
 	}
 
-	return AddTaskHandler{coursesRepository: repository}
+	if storage == nil {
+		panic("testDataStorage is nil")
+	}
+
+	if registry == nil {
+		panic("languageRegistry is nil")
+	}
+
+	if descriptionMaxLen <= 0 || descriptionMaxLen > course.TaskDescriptionMaxLen {
+		panic("descriptionMaxLen is out of range")
+	}
+
+	return AddTaskHandler{
+		coursesRepository: repository,
+		testDataStorage:   storage,
+		languageRegistry:  registry,
+		descriptionMaxLen: descriptionMaxLen,
+	}
 }
 
 func (h AddTaskHandler) Handle(ctx context.Context, cmd app.AddTaskCommand) (taskNumber int, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"adding %s task to course #%s by academic #%s",
+			cmd.TaskType, cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	if len(cmd.TaskDescription) > h.descriptionMaxLen {
+		return 0, course.ErrTaskDescriptionTooLong
+	}
+
+	if err := checkStoredTestDataExist(ctx, h.testDataStorage, cmd.CourseID, cmd.TestData); err != nil {
+		return 0, err
+	}
+
+	if err := checkLanguagesSupported(ctx, h.languageRegistry, cmd.Languages); err != nil {
+		return 0, err
+	}
+
 	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, addTask(cmd, &taskNumber))
 
-	return taskNumber, errors.Wrapf(
-		err,
-		"adding %s task to course #%s by academic #%s",
-		cmd.TaskType, cmd.CourseID, cmd.Academic.ID(),
-	)
+	return taskNumber, err
+}
+
+func checkStoredTestDataExist(
+	ctx context.Context,
+	storage testDataStorage,
+	courseID string,
+	testData []course.TestData,
+) error {
+	for _, td := range testData {
+		if !td.IsStored() {
+			continue
+		}
+
+		if err := storage.TestDataExists(ctx, courseID, td.InputDataID()); err != nil {
+			return err
+		}
+
+		if err := storage.TestDataExists(ctx, courseID, td.OutputDataID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkLanguagesSupported(ctx context.Context, registry languageRegistry, languages []string) error {
+	for _, l := range languages {
+		if err := registry.LanguageSupported(ctx, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 var errInvalidTaskType = errors.New("invalid task type")
@@ -45,21 +120,27 @@ func addTask(cmd app.AddTaskCommand, givenTaskNumber *int) UpdateFunction {
 			number, err = crs.AddManualCheckingTask(cmd.Academic, course.ManualCheckingTaskCreationParams{
 				Title:       cmd.TaskTitle,
 				Description: cmd.TaskDescription,
+				TeamWork:    cmd.TeamWork,
 				Deadline:    cmd.Deadline,
-			})
+			}, time.Now())
 		case course.AutoCodeCheckingType:
 			number, err = crs.AddAutoCodeCheckingTask(cmd.Academic, course.AutoCodeCheckingTaskCreationParams{
 				Title:       cmd.TaskTitle,
 				Description: cmd.TaskDescription,
+				TeamWork:    cmd.TeamWork,
 				Deadline:    cmd.Deadline,
 				TestData:    cmd.TestData,
-			})
+				Checker:     cmd.Checker,
+				Languages:   cmd.Languages,
+				Limits:      cmd.Limits,
+			}, time.Now())
 		case course.TestingType:
 			number, err = crs.AddTestingTask(cmd.Academic, course.TestingTaskCreationParams{
 				Title:       cmd.TaskTitle,
 				Description: cmd.TaskDescription,
+				TeamWork:    cmd.TeamWork,
 				TestPoints:  cmd.TestPoints,
-			})
+			}, time.Now())
 		default:
 			number, err = 0, errInvalidTaskType
 		}
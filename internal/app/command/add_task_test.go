@@ -50,10 +50,70 @@ func TestAddTaskHandler_Handle(t *testing.T) {
 				TaskTitle:       "Auto code checking task",
 				TaskDescription: "Do this task",
 				TaskType:        course.AutoCodeCheckingType,
-				TestData:        []course.TestData{course.MustNewTestData("1 + 1", "3")},
+				TestData:        []course.TestData{course.MustNewTestData("1 + 1", "3", course.HiddenTestData, "")},
 			},
 			PrepareCoursesRepository: addCourse,
 		},
+		{
+			Name: "add_auto_code_checking_task_with_stored_test_data",
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskTitle:       "Auto code checking task with large tests",
+				TaskDescription: "Do this task fast",
+				TaskType:        course.AutoCodeCheckingType,
+				TestData: []course.TestData{
+					course.MustNewStoredTestData("test-data-1", "test-data-2", course.HiddenTestData, ""),
+				},
+				Checker: course.MustNewChecker(course.FloatChecker, 0.001),
+			},
+			PrepareCoursesRepository: addCourse,
+		},
+		{
+			Name: "dont_add_when_stored_test_data_doesnt_exist",
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskTitle:       "Auto code checking task with lost tests",
+				TaskDescription: "Don't do this task",
+				TaskType:        course.AutoCodeCheckingType,
+				TestData: []course.TestData{
+					course.MustNewStoredTestData("test-data-1", "test-data-3", course.HiddenTestData, ""),
+				},
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrTestDataDoesntExist)
+			},
+		},
+		{
+			Name: "add_auto_code_checking_task_with_languages_and_limits",
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskTitle:       "Auto code checking task in Go or Python",
+				TaskDescription: "Do this task in 2 seconds",
+				TaskType:        course.AutoCodeCheckingType,
+				Languages:       []string{"go", "python3"},
+				Limits:          course.MustNewExecutionLimits(2*time.Second, 128<<20, 32<<10),
+			},
+			PrepareCoursesRepository: addCourse,
+		},
+		{
+			Name: "dont_add_when_language_not_supported",
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskTitle:       "Auto code checking task in Brainfuck",
+				TaskDescription: "Don't do this task",
+				TaskType:        course.AutoCodeCheckingType,
+				Languages:       []string{"go", "brainfuck"},
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrLanguageNotSupported)
+			},
+		},
 		{
 			Name: "add_testing_task",
 			Command: app.AddTaskCommand{
@@ -150,7 +210,13 @@ func TestAddTaskHandler_Handle(t *testing.T) {
 				Period:  course.MustNewPeriod(2043, 2044, course.FirstSemester),
 			})
 			coursesRepository := c.PrepareCoursesRepository(crs)
-			handler := command.NewAddTaskHandler(coursesRepository)
+			testDataStorage := mock.NewTestDataStorage()
+			_, err := testDataStorage.SaveTestData(context.Background(), "course-id", strings.NewReader("1 2"))
+			require.NoError(t, err)
+			_, err = testDataStorage.SaveTestData(context.Background(), "course-id", strings.NewReader("3"))
+			require.NoError(t, err)
+			languageRegistry := mock.NewLanguageRegistry("go", "python3")
+			handler := command.NewAddTaskHandler(coursesRepository, testDataStorage, languageRegistry, 1000)
 
 			number, err := handler.Handle(context.Background(), c.Command)
 
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type AddTeamMembersHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewAddTeamMembersHandler(repository coursesRepository) AddTeamMembersHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return AddTeamMembersHandler{coursesRepository: repository}
+}
+
+func (h AddTeamMembersHandler) Handle(ctx context.Context, cmd app.AddTeamMembersCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, addTeamMembers(cmd))
+
+	return errors.Wrapf(
+		err,
+		"adding students %v to team #%s of course #%s by academic #%s",
+		cmd.StudentIDs, cmd.TeamID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func addTeamMembers(cmd app.AddTeamMembersCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.AddTeamMembers(cmd.Academic, cmd.TeamID, cmd.StudentIDs...); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,102 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestAddTeamMembersHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		Command         app.AddTeamMembersCommand
+		ExpectedMembers []string
+		IsErr           func(err error) bool
+	}{
+		{
+			Name: "add_team_members",
+			Command: app.AddTeamMembersCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TeamID:     "team-id",
+				StudentIDs: []string{"student2-id", "student3-id"},
+			},
+			ExpectedMembers: []string{"student1-id", "student2-id", "student3-id"},
+		},
+		{
+			Name: "dont_add_team_members_when_team_doesnt_exist",
+			Command: app.AddTeamMembersCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TeamID:     "other-team-id",
+				StudentIDs: []string{"student2-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchTeam)
+			},
+		},
+		{
+			Name: "dont_add_team_members_when_team_is_full",
+			Command: app.AddTeamMembersCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TeamID:     "team-id",
+				StudentIDs: []string{"student2-id", "student3-id", "student4-id"},
+			},
+			IsErr: course.IsTeamMembershipError,
+		},
+		{
+			Name: "dont_add_another_student_by_student",
+			Command: app.AddTeamMembersCommand{
+				Academic:   course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID:   "course-id",
+				TeamID:     "team-id",
+				StudentIDs: []string{"student2-id"},
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Chemistry",
+				Period:   course.MustNewPeriod(2032, 2033, course.FirstSemester),
+				Students: []string{"student1-id", "student2-id", "student3-id", "student4-id"},
+			})
+			require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(true, 3)))
+			require.NoError(t, crs.CreateTeam(creator, "team-id", "Xenon", "student1-id"))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewAddTeamMembersHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, c.ExpectedMembers, updatedCourse.Teams()[0].Members())
+		})
+	}
+}
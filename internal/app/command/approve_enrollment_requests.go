@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type ApproveEnrollmentRequestsHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewApproveEnrollmentRequestsHandler(repository coursesRepository) ApproveEnrollmentRequestsHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return ApproveEnrollmentRequestsHandler{coursesRepository: repository}
+}
+
+func (h ApproveEnrollmentRequestsHandler) Handle(ctx context.Context, cmd app.ApproveEnrollmentRequestsCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, approveEnrollmentRequests(cmd))
+
+	return errors.Wrapf(
+		err,
+		"approving %d enrollment requests of course #%s by academic #%s",
+		len(cmd.RequestIDs), cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func approveEnrollmentRequests(cmd app.ApproveEnrollmentRequestsCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.ApproveEnrollmentRequests(cmd.Academic, cmd.RequestIDs, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,142 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestApproveEnrollmentRequestsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.ApproveEnrollmentRequestsCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "approve_requests",
+			Command: app.ApproveEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				RequestIDs: []string{"request1-id", "request2-id"},
+			},
+		},
+		{
+			Name: "dont_approve_requests_when_course_doesnt_exist",
+			Command: app.ApproveEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "other-course-id",
+				RequestIDs: []string{"request1-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_approve_requests_when_academic_cant_edit_course",
+			Command: app.ApproveEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID:   "course-id",
+				RequestIDs: []string{"request1-id"},
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_approve_any_request_when_one_doesnt_exist",
+			Command: app.ApproveEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				RequestIDs: []string{"request1-id", "other-request-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchEnrollmentRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			coursesRepository := mock.NewCoursesRepository(newCourseWithEnrollmentRequests(t))
+			handler := command.NewApproveEnrollmentRequestsHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"student-id", "student1-id", "student2-id"}, updatedCourse.Students())
+
+			for _, r := range updatedCourse.EnrollmentRequests() {
+				require.Equal(t, course.ApprovedRequest, r.Status())
+			}
+		})
+	}
+}
+
+func TestApproveEnrollmentRequestsHandler_Handle_waitlistsWhenCourseIsFull(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourseWithEnrollmentRequests(t)
+	require.NoError(t, crs.ChangeCapacity(creator, 1, time.Now()))
+
+	coursesRepository := mock.NewCoursesRepository(crs)
+	handler := command.NewApproveEnrollmentRequestsHandler(coursesRepository)
+
+	err := handler.Handle(context.Background(), app.ApproveEnrollmentRequestsCommand{
+		Academic:   creator,
+		CourseID:   "course-id",
+		RequestIDs: []string{"request1-id", "request2-id"},
+	})
+	require.NoError(t, err)
+
+	updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student-id"}, updatedCourse.Students())
+	require.True(t, updatedCourse.IsWaitlisted("student1-id"))
+	require.True(t, updatedCourse.IsWaitlisted("student2-id"))
+
+	for _, r := range updatedCourse.EnrollmentRequests() {
+		require.Equal(t, course.ApprovedRequest, r.Status())
+	}
+}
+
+func newCourseWithEnrollmentRequests(t *testing.T) *course.Course {
+	t.Helper()
+
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:       "course-id",
+		Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+		Title:    "Elective physics",
+		Period:   course.MustNewPeriod(2029, 2030, course.FirstSemester),
+		Students: []string{"student-id"},
+	})
+	now := time.Now()
+	require.NoError(t, crs.RequestEnrollment(
+		course.MustNewAcademic("student1-id", course.StudentType), "request1-id", "", now,
+	))
+	require.NoError(t, crs.RequestEnrollment(
+		course.MustNewAcademic("student2-id", course.StudentType), "request2-id", "", now,
+	))
+
+	return crs
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type AttachAuxiliaryMaterialHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewAttachAuxiliaryMaterialHandler(repository coursesRepository) AttachAuxiliaryMaterialHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return AttachAuxiliaryMaterialHandler{coursesRepository: repository}
+}
+
+func (h AttachAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	cmd app.AttachAuxiliaryMaterialCommand,
+) (materialID string, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"attaching auxiliary material to course #%s by academic #%s",
+			cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	material, err := course.NewAuxiliaryMaterial(uuid.NewString(), cmd.Resource, cmd.ResourceType)
+	if err != nil {
+		return "", err
+	}
+
+	material = material.ForTask(cmd.TaskNumber).WithRelease(cmd.ReleaseTime, cmd.Hidden)
+
+	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, attachAuxiliaryMaterial(cmd.Academic, material))
+	if err != nil {
+		return "", err
+	}
+
+	return material.ID(), nil
+}
+
+func attachAuxiliaryMaterial(academic course.Academic, material course.AuxiliaryMaterial) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.AttachAuxiliaryMaterial(academic, material); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
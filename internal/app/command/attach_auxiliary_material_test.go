@@ -0,0 +1,146 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestAttachAuxiliaryMaterialHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	testCases := []struct {
+		Name                     string
+		Command                  app.AttachAuxiliaryMaterialCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "attach_auxiliary_material",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				Resource:     "https://example.com/lectures/1",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: addCourse,
+		},
+		{
+			Name: "attach_auxiliary_material_to_task",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				TaskNumber:   1,
+				Resource:     "https://example.com/lectures/1",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: func(crs *course.Course) *mock.CoursesRepository {
+				_, err := crs.AddManualCheckingTask(
+					course.MustNewAcademic("creator-id", course.TeacherType),
+					course.ManualCheckingTaskCreationParams{Title: "Scheduler", Description: "Implement scheduler"},
+					time.Now(),
+				)
+				require.NoError(t, err)
+
+				return mock.NewCoursesRepository(crs)
+			},
+		},
+		{
+			Name: "dont_attach_to_task_that_doesnt_exist",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				TaskNumber:   1,
+				Resource:     "https://example.com/lectures/1",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchTask)
+			},
+		},
+		{
+			Name: "dont_attach_invalid_material",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				Resource:     "first lecture",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr:                    course.IsInvalidAuxiliaryMaterialError,
+		},
+		{
+			Name: "dont_attach_when_academic_cant_edit_course",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:     "course-id",
+				Resource:     "https://example.com/lectures/1",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr:                    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_attach_when_course_doesnt_exist",
+			Command: app.AttachAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				Resource:     "https://example.com/lectures/1",
+				ResourceType: course.VideoResource,
+			},
+			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Operating systems",
+				Period:   course.MustNewPeriod(2043, 2044, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			handler := command.NewAttachAuxiliaryMaterialHandler(coursesRepository)
+
+			materialID, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Empty(t, materialID)
+
+				return
+			}
+			require.NoError(t, err)
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+			require.NoError(t, err)
+			material, err := updatedCourse.AuxiliaryMaterial(materialID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.Resource, material.Resource())
+			require.Equal(t, c.Command.ResourceType, material.ResourceType())
+			require.Equal(t, c.Command.TaskNumber, material.TaskNumber())
+		})
+	}
+}
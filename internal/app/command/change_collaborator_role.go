@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type ChangeCollaboratorRoleHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewChangeCollaboratorRoleHandler(repository coursesRepository) ChangeCollaboratorRoleHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return ChangeCollaboratorRoleHandler{coursesRepository: repository}
+}
+
+func (h ChangeCollaboratorRoleHandler) Handle(ctx context.Context, cmd app.ChangeCollaboratorRoleCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, changeCollaboratorRole(cmd))
+
+	return errors.Wrapf(
+		err,
+		"changing role of collaborator #%s of course #%s to %s by teacher #%s",
+		cmd.CollaboratorID, cmd.CourseID, cmd.Role, cmd.Academic.ID(),
+	)
+}
+
+func changeCollaboratorRole(cmd app.ChangeCollaboratorRoleCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.ChangeCollaboratorRole(cmd.Academic, cmd.CollaboratorID, cmd.Role); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
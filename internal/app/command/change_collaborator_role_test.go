@@ -0,0 +1,112 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestChangeCollaboratorRoleHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                     string
+		Command                  app.ChangeCollaboratorRoleCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "change_role",
+			Command: app.ChangeCollaboratorRoleCommand{
+				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:       "course-id",
+				CollaboratorID: "collaborator-id",
+				Role:           course.AssistantRole,
+			},
+			PrepareCoursesRepository: func(crs *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository(crs)
+			},
+		},
+		{
+			Name: "dont_change_role_when_course_doesnt_exist",
+			Command: app.ChangeCollaboratorRoleCommand{
+				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:       "course-id",
+				CollaboratorID: "collaborator-id",
+				Role:           course.AssistantRole,
+			},
+			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_change_role_when_academic_isnt_creator",
+			Command: app.ChangeCollaboratorRoleCommand{
+				Academic:       course.MustNewAcademic("collaborator-id", course.TeacherType),
+				CourseID:       "course-id",
+				CollaboratorID: "collaborator-id",
+				Role:           course.AssistantRole,
+			},
+			PrepareCoursesRepository: func(crs *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository(crs)
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_change_role_of_not_collaborator",
+			Command: app.ChangeCollaboratorRoleCommand{
+				Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:       "course-id",
+				CollaboratorID: "other-teacher-id",
+				Role:           course.ObserverRole,
+			},
+			PrepareCoursesRepository: func(crs *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository(crs)
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchCollaborator)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:            "course-id",
+				Creator:       course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:         "Chemistry",
+				Period:        course.MustNewPeriod(2032, 2033, course.SecondSemester),
+				Collaborators: []string{"collaborator-id"},
+			})
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			handler := command.NewChangeCollaboratorRoleHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCrs, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.Role, updatedCrs.CollaboratorRoles()[c.Command.CollaboratorID])
+		})
+	}
+}
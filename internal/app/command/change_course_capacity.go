@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type ChangeCourseCapacityHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewChangeCourseCapacityHandler(repository coursesRepository) ChangeCourseCapacityHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return ChangeCourseCapacityHandler{coursesRepository: repository}
+}
+
+func (h ChangeCourseCapacityHandler) Handle(ctx context.Context, cmd app.ChangeCourseCapacityCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, changeCourseCapacity(cmd))
+
+	return errors.Wrapf(
+		err,
+		"changing capacity of course #%s by academic #%s",
+		cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func changeCourseCapacity(cmd app.ChangeCourseCapacityCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.ChangeCapacity(cmd.Academic, cmd.Capacity, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,126 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestChangeCourseCapacityHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Command          app.ChangeCourseCapacityCommand
+		ExpectedStudents []string
+		IsErr            func(err error) bool
+	}{
+		{
+			Name: "increase_capacity_and_promote_waitlisted_student",
+			Command: app.ChangeCourseCapacityCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Capacity: 2,
+			},
+			ExpectedStudents: []string{"student-id", "waitlisted-id"},
+		},
+		{
+			Name: "decrease_capacity_and_keep_students",
+			Command: app.ChangeCourseCapacityCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Capacity: 1,
+			},
+			ExpectedStudents: []string{"student-id"},
+		},
+		{
+			Name: "dont_change_capacity_when_course_doesnt_exist",
+			Command: app.ChangeCourseCapacityCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+				Capacity: 2,
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_change_capacity_when_it_is_negative",
+			Command: app.ChangeCourseCapacityCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Capacity: -1,
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrNegativeCourseCapacity)
+			},
+		},
+		{
+			Name: "dont_change_capacity_when_academic_is_student",
+			Command: app.ChangeCourseCapacityCommand{
+				Academic: course.MustNewAcademic("student-id", course.StudentType),
+				CourseID: "course-id",
+				Capacity: 2,
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			coursesRepository := mock.NewCoursesRepository(newFullCourseWithWaitlist(t))
+			handler := command.NewChangeCourseCapacityHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.Capacity, updatedCourse.Capacity())
+			require.ElementsMatch(t, c.ExpectedStudents, updatedCourse.Students())
+		})
+	}
+}
+
+// newFullCourseWithWaitlist returns course with capacity of one student
+// and student waitlisted-id who waits for free seat.
+func newFullCourseWithWaitlist(t *testing.T) *course.Course {
+	t.Helper()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:       "course-id",
+		Creator:  creator,
+		Title:    "Biology",
+		Period:   course.MustNewPeriod(2031, 2032, course.SecondSemester),
+		Students: []string{"student-id"},
+	})
+	now := time.Now()
+	require.NoError(t, crs.ChangeCapacity(creator, 1, now))
+	require.NoError(t, crs.AddInvitation(
+		creator,
+		course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 10),
+		now,
+	))
+	require.NoError(t, crs.RedeemInvitation(course.MustNewAcademic("waitlisted-id", course.StudentType), "code", now))
+
+	return crs
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type ChangeTeamSettingsHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewChangeTeamSettingsHandler(repository coursesRepository) ChangeTeamSettingsHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return ChangeTeamSettingsHandler{coursesRepository: repository}
+}
+
+func (h ChangeTeamSettingsHandler) Handle(ctx context.Context, cmd app.ChangeTeamSettingsCommand) (err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"changing team settings of course #%s by academic #%s",
+			cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	settings, err := course.NewTeamSettings(cmd.SelfFormation, cmd.MaxSize)
+	if err != nil {
+		return err
+	}
+
+	return h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, changeTeamSettings(cmd.Academic, settings))
+}
+
+func changeTeamSettings(academic course.Academic, settings course.TeamSettings) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.ChangeTeamSettings(academic, settings); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
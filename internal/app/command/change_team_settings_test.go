@@ -0,0 +1,93 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestChangeTeamSettingsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.ChangeTeamSettingsCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "change_team_settings",
+			Command: app.ChangeTeamSettingsCommand{
+				Academic:      course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:      "course-id",
+				SelfFormation: true,
+				MaxSize:       4,
+			},
+		},
+		{
+			Name: "dont_change_team_settings_when_course_doesnt_exist",
+			Command: app.ChangeTeamSettingsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_change_team_settings_with_negative_max_size",
+			Command: app.ChangeTeamSettingsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				MaxSize:  -1,
+			},
+			IsErr: course.IsInvalidTeamParametersError,
+		},
+		{
+			Name: "dont_change_team_settings_when_academic_is_student",
+			Command: app.ChangeTeamSettingsCommand{
+				Academic:      course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:      "course-id",
+				SelfFormation: true,
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Chemistry",
+				Period:   course.MustNewPeriod(2032, 2033, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewChangeTeamSettingsHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, course.MustNewTeamSettings(true, 4), updatedCourse.TeamSettings())
+		})
+	}
+}
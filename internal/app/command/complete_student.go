@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type CompleteStudentHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewCompleteStudentHandler(repository coursesRepository) CompleteStudentHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return CompleteStudentHandler{coursesRepository: repository}
+}
+
+func (h CompleteStudentHandler) Handle(ctx context.Context, cmd app.CompleteStudentCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, completeStudent(cmd))
+
+	return errors.Wrapf(
+		err,
+		"marking student #%s completed course #%s by teacher #%s",
+		cmd.StudentID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func completeStudent(cmd app.CompleteStudentCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.CompleteStudent(cmd.Academic, cmd.StudentID); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
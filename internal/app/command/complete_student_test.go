@@ -0,0 +1,88 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCompleteStudentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.CompleteStudentCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "complete_student",
+			Command: app.CompleteStudentCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				StudentID: "student-id",
+			},
+		},
+		{
+			Name: "dont_complete_student_when_course_has_no_such_student",
+			Command: app.CompleteStudentCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				StudentID: "other-student-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchStudent)
+			},
+		},
+		{
+			Name: "dont_complete_student_when_academic_is_student",
+			Command: app.CompleteStudentCommand{
+				Academic:  course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:  "course-id",
+				StudentID: "student-id",
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Geometry",
+				Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewCompleteStudentHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+
+			enrollment, ok := updatedCourse.StudentEnrollment("student-id")
+			require.True(t, ok)
+			require.Equal(t, course.CompletedStudent, enrollment.Status())
+			require.Equal(t, []string{"student-id"}, updatedCourse.Students())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+// invitationCodeBytes gives 16 characters code of base32 alphabet
+// that is easy to dictate and hard to guess.
+const invitationCodeBytes = 10
+
+type CreateInvitationHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewCreateInvitationHandler(repository coursesRepository) CreateInvitationHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return CreateInvitationHandler{coursesRepository: repository}
+}
+
+func (h CreateInvitationHandler) Handle(ctx context.Context, cmd app.CreateInvitationCommand) (code string, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"creating invitation to course #%s by academic #%s",
+			cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	code, err = newInvitationCode()
+	if err != nil {
+		return "", err
+	}
+
+	invitation, err := course.NewInvitation(code, cmd.Role, cmd.ExpiresAt, cmd.MaxUses)
+	if err != nil {
+		return "", err
+	}
+
+	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, addInvitation(cmd.Academic, invitation))
+	if err != nil {
+		return "", err
+	}
+
+	return invitation.Code(), nil
+}
+
+func newInvitationCode() (string, error) {
+	b := make([]byte, invitationCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+func addInvitation(academic course.Academic, invitation course.Invitation) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.AddInvitation(academic, invitation, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,128 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCreateInvitationHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC()
+
+	testCases := []struct {
+		Name    string
+		Command app.CreateInvitationCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "create_student_invitation",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Role:      course.StudentType,
+				ExpiresAt: expiresAt,
+				MaxUses:   30,
+			},
+		},
+		{
+			Name: "create_collaborator_invitation",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("collaborator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Role:      course.TeacherType,
+				ExpiresAt: expiresAt,
+				MaxUses:   1,
+			},
+		},
+		{
+			Name: "dont_create_invitation_when_course_doesnt_exist",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "other-course-id",
+				Role:      course.StudentType,
+				ExpiresAt: expiresAt,
+				MaxUses:   30,
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_create_invitation_when_academic_cant_edit_course",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:  "course-id",
+				Role:      course.StudentType,
+				ExpiresAt: expiresAt,
+				MaxUses:   30,
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_create_expired_invitation",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Role:      course.StudentType,
+				ExpiresAt: time.Now().Add(-time.Hour),
+				MaxUses:   30,
+			},
+			IsErr: course.IsInvalidInvitationError,
+		},
+		{
+			Name: "dont_create_invitation_without_uses",
+			Command: app.CreateInvitationCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Role:      course.StudentType,
+				ExpiresAt: expiresAt,
+			},
+			IsErr: course.IsInvalidInvitationError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:            "course-id",
+				Creator:       course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:         "Physics",
+				Period:        course.MustNewPeriod(2029, 2030, course.FirstSemester),
+				Collaborators: []string{"collaborator-id"},
+				Students:      []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewCreateInvitationHandler(coursesRepository)
+
+			code, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, code)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, []course.Invitation{
+				course.MustNewInvitation(code, c.Command.Role, c.Command.ExpiresAt, c.Command.MaxUses),
+			}, updatedCourse.Invitations())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type CreateTeamHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewCreateTeamHandler(repository coursesRepository) CreateTeamHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return CreateTeamHandler{coursesRepository: repository}
+}
+
+func (h CreateTeamHandler) Handle(ctx context.Context, cmd app.CreateTeamCommand) (teamID string, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "creating team in course #%s by academic #%s", cmd.CourseID, cmd.Academic.ID())
+	}()
+
+	teamID = uuid.NewString()
+
+	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, createTeam(cmd, teamID))
+	if err != nil {
+		return "", err
+	}
+
+	return teamID, nil
+}
+
+func createTeam(cmd app.CreateTeamCommand, teamID string) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.CreateTeam(cmd.Academic, teamID, cmd.Name, cmd.MemberIDs...); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
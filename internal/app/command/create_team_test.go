@@ -0,0 +1,112 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCreateTeamHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		Command         app.CreateTeamCommand
+		ExpectedMembers []string
+		IsErr           func(err error) bool
+	}{
+		{
+			Name: "create_team",
+			Command: app.CreateTeamCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Name:      "Hydrogen",
+				MemberIDs: []string{"student1-id", "student2-id"},
+			},
+			ExpectedMembers: []string{"student1-id", "student2-id"},
+		},
+		{
+			Name: "create_team_of_student_himself",
+			Command: app.CreateTeamCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID: "course-id",
+				Name:     "Helium",
+			},
+			ExpectedMembers: []string{"student1-id"},
+		},
+		{
+			Name: "dont_create_team_when_course_doesnt_exist",
+			Command: app.CreateTeamCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+				Name:     "Lithium",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_create_team_with_empty_name",
+			Command: app.CreateTeamCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+			},
+			IsErr: course.IsInvalidTeamParametersError,
+		},
+		{
+			Name: "dont_create_team_of_not_course_students",
+			Command: app.CreateTeamCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				Name:      "Beryllium",
+				MemberIDs: []string{"other-student-id"},
+			},
+			IsErr: course.IsTeamMembershipError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Chemistry",
+				Period:   course.MustNewPeriod(2032, 2033, course.FirstSemester),
+				Students: []string{"student1-id", "student2-id"},
+			})
+			require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(true, 0)))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewCreateTeamHandler(coursesRepository)
+
+			teamID, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+
+			teams := updatedCourse.Teams()
+			require.Len(t, teams, 1)
+			require.Equal(t, teamID, teams[0].ID())
+			require.Equal(t, c.Command.Name, teams[0].Name())
+			require.Equal(t, c.ExpectedMembers, teams[0].Members())
+		})
+	}
+}
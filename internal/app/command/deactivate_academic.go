@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type DeactivateAcademicHandler struct {
+	coursesRepository coursesRepository
+	academicsCache    academicsCache
+}
+
+func NewDeactivateAcademicHandler(repository coursesRepository, cache academicsCache) DeactivateAcademicHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if cache == nil {
+		panic("academicsCache is nil")
+	}
+
+	return DeactivateAcademicHandler{coursesRepository: repository, academicsCache: cache}
+}
+
+func (h DeactivateAcademicHandler) Handle(
+	ctx context.Context,
+	cmd app.DeactivateAcademicCommand,
+) (app.DeactivationReport, error) {
+	report := app.DeactivationReport{AcademicID: cmd.AcademicID}
+
+	if cmd.AcademicID == "" {
+		return report, course.ErrEmptyAcademicID
+	}
+
+	h.academicsCache.InvalidateAcademic(cmd.AcademicID)
+
+	courseIDs, err := h.coursesRepository.FindAcademicCourseIDs(ctx, cmd.AcademicID)
+	if err != nil {
+		return report, errors.Wrapf(err, "finding courses of deactivated academic #%s", cmd.AcademicID)
+	}
+
+	for _, courseID := range courseIDs {
+		var deactivation course.Deactivation
+
+		err := h.coursesRepository.UpdateCourse(ctx, courseID, func(
+			_ context.Context,
+			crs *course.Course,
+		) (*course.Course, error) {
+			d, err := crs.RemoveDeactivatedAcademic(cmd.AcademicID, time.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			deactivation = d
+
+			return crs, nil
+		})
+		if errors.Is(err, app.ErrCourseDoesntExist) {
+			continue
+		}
+
+		if err != nil {
+			return report, errors.Wrapf(
+				err,
+				"removing deactivated academic #%s from course #%s",
+				cmd.AcademicID, courseID,
+			)
+		}
+
+		if deactivation.Changed() {
+			report.Courses = append(report.Courses, newCourseDeactivation(courseID, deactivation))
+		}
+	}
+
+	return report, nil
+}
+
+func newCourseDeactivation(courseID string, d course.Deactivation) app.CourseDeactivation {
+	return app.CourseDeactivation{
+		CourseID:            courseID,
+		StudentWithdrawn:    d.StudentWithdrawn(),
+		WaitlistLeft:        d.WaitlistLeft(),
+		RejectedRequests:    d.RejectedRequests(),
+		CollaboratorRemoved: d.CollaboratorRemoved(),
+		NewCreatorID:        d.NewCreatorID(),
+		CreatorOrphaned:     d.CreatorOrphaned(),
+	}
+}
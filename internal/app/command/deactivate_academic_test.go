@@ -0,0 +1,134 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestDeactivateAcademicHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AcademicID     string
+		ExpectedReport app.DeactivationReport
+	}{
+		{
+			Name:       "withdraw_student_from_all_courses",
+			AcademicID: "student-id",
+			ExpectedReport: app.DeactivationReport{
+				AcademicID: "student-id",
+				Courses: []app.CourseDeactivation{
+					{CourseID: "algebra-course-id", StudentWithdrawn: true},
+					{CourseID: "geometry-course-id", StudentWithdrawn: true},
+				},
+			},
+		},
+		{
+			Name:       "replace_deactivated_creator",
+			AcademicID: "creator-id",
+			ExpectedReport: app.DeactivationReport{
+				AcademicID: "creator-id",
+				Courses: []app.CourseDeactivation{
+					{CourseID: "algebra-course-id", NewCreatorID: "teacher-id"},
+					{CourseID: "geometry-course-id", CreatorOrphaned: true},
+				},
+			},
+		},
+		{
+			Name:       "remove_collaborator",
+			AcademicID: "teacher-id",
+			ExpectedReport: app.DeactivationReport{
+				AcademicID: "teacher-id",
+				Courses: []app.CourseDeactivation{
+					{CourseID: "algebra-course-id", CollaboratorRemoved: true},
+				},
+			},
+		},
+		{
+			Name:           "report_nothing_for_academic_without_courses",
+			AcademicID:     "other-id",
+			ExpectedReport: app.DeactivationReport{AcademicID: "other-id"},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			algebraCourse := course.MustNewCourse(course.CreationParams{
+				ID:            "algebra-course-id",
+				Creator:       creator,
+				Title:         "Algebra",
+				Period:        course.MustNewPeriod(2030, 2031, course.FirstSemester),
+				Collaborators: []string{"teacher-id"},
+				Students:      []string{"student-id"},
+			})
+			geometryCourse := course.MustNewCourse(course.CreationParams{
+				ID:       "geometry-course-id",
+				Creator:  creator,
+				Title:    "Geometry",
+				Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(algebraCourse, geometryCourse)
+			academicsCache := mock.NewAcademicsCache()
+			handler := command.NewDeactivateAcademicHandler(coursesRepository, academicsCache)
+
+			report, err := handler.Handle(context.Background(), app.DeactivateAcademicCommand{AcademicID: c.AcademicID})
+			require.NoError(t, err)
+			require.Equal(t, c.ExpectedReport, report)
+			require.Equal(t, []string{c.AcademicID}, academicsCache.Invalidated())
+		})
+	}
+}
+
+func TestDeactivateAcademicHandler_Handle_is_idempotent(t *testing.T) {
+	t.Parallel()
+
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:       "course-id",
+		Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+		Title:    "Geometry",
+		Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+		Students: []string{"student-id"},
+	})
+	coursesRepository := mock.NewCoursesRepository(crs)
+	handler := command.NewDeactivateAcademicHandler(coursesRepository, mock.NewAcademicsCache())
+	cmd := app.DeactivateAcademicCommand{AcademicID: "student-id"}
+
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	report, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.Empty(t, report.Courses)
+
+	updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+	require.NoError(t, err)
+	require.Empty(t, updatedCourse.Students())
+
+	enrollment, ok := updatedCourse.StudentEnrollment("student-id")
+	require.True(t, ok)
+	require.Equal(t, course.DeactivationReason, enrollment.Reason())
+}
+
+func TestDeactivateAcademicHandler_Handle_with_empty_academic_id(t *testing.T) {
+	t.Parallel()
+
+	academicsCache := mock.NewAcademicsCache()
+	handler := command.NewDeactivateAcademicHandler(mock.NewCoursesRepository(), academicsCache)
+
+	_, err := handler.Handle(context.Background(), app.DeactivateAcademicCommand{})
+	require.ErrorIs(t, err, course.ErrEmptyAcademicID)
+	require.Empty(t, academicsCache.Invalidated())
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type EditAuxiliaryMaterialHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewEditAuxiliaryMaterialHandler(repository coursesRepository) EditAuxiliaryMaterialHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return EditAuxiliaryMaterialHandler{coursesRepository: repository}
+}
+
+func (h EditAuxiliaryMaterialHandler) Handle(ctx context.Context, cmd app.EditAuxiliaryMaterialCommand) (err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"editing auxiliary material #%s of course #%s by academic #%s",
+			cmd.MaterialID, cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	return h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, replaceAuxiliaryMaterial(cmd))
+}
+
+func replaceAuxiliaryMaterial(cmd app.EditAuxiliaryMaterialCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		material, err := editedAuxiliaryMaterial(crs, cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := crs.ReplaceAuxiliaryMaterial(cmd.Academic, material); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
+
+// editedAuxiliaryMaterial keeps uploaded file of material,
+// so resource of file material can't be replaced.
+func editedAuxiliaryMaterial(
+	crs *course.Course,
+	cmd app.EditAuxiliaryMaterialCommand,
+) (course.AuxiliaryMaterial, error) {
+	var (
+		material course.AuxiliaryMaterial
+		err      error
+	)
+
+	origin, originErr := crs.AuxiliaryMaterial(cmd.MaterialID)
+	if file, ok := origin.File(); originErr == nil && ok {
+		material, err = course.NewFileAuxiliaryMaterial(cmd.MaterialID, file, cmd.ResourceType)
+	} else {
+		material, err = course.NewAuxiliaryMaterial(cmd.MaterialID, cmd.Resource, cmd.ResourceType)
+	}
+
+	if err != nil {
+		return course.AuxiliaryMaterial{}, err
+	}
+
+	return material.ForTask(cmd.TaskNumber).WithRelease(cmd.ReleaseTime, cmd.Hidden), nil
+}
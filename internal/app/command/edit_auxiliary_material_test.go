@@ -0,0 +1,151 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestEditAuxiliaryMaterialHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.EditAuxiliaryMaterialCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "edit_auxiliary_material",
+			Command: app.EditAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				MaterialID:   "material-id",
+				Resource:     "Tanenbaum, Modern Operating Systems",
+				ResourceType: course.OtherResource,
+			},
+		},
+		{
+			Name: "dont_edit_when_material_doesnt_exist",
+			Command: app.EditAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				MaterialID:   "other-material-id",
+				Resource:     "https://example.com/slides",
+				ResourceType: course.PresentationResource,
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchMaterial)
+			},
+		},
+		{
+			Name: "dont_edit_when_material_invalid",
+			Command: app.EditAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				MaterialID:   "material-id",
+				ResourceType: course.OtherResource,
+			},
+			IsErr: course.IsInvalidAuxiliaryMaterialError,
+		},
+		{
+			Name: "dont_edit_when_academic_cant_edit_course",
+			Command: app.EditAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID:     "course-id",
+				MaterialID:   "material-id",
+				Resource:     "https://example.com/slides",
+				ResourceType: course.PresentationResource,
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: creator,
+				Title:   "Operating systems",
+				Period:  course.MustNewPeriod(2043, 2044, course.FirstSemester),
+			})
+			err := crs.AttachAuxiliaryMaterial(
+				creator,
+				course.MustNewAuxiliaryMaterial("material-id", "https://example.com/video", course.VideoResource),
+			)
+			require.NoError(t, err)
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewEditAuxiliaryMaterialHandler(coursesRepository)
+
+			err = handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+			require.NoError(t, err)
+			material, err := updatedCourse.AuxiliaryMaterial(c.Command.MaterialID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.Resource, material.Resource())
+			require.Equal(t, c.Command.ResourceType, material.ResourceType())
+		})
+	}
+}
+
+func TestEditAuxiliaryMaterialHandler_Handle_fileMaterial(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:      "course-id",
+		Creator: creator,
+		Title:   "Operating systems",
+		Period:  course.MustNewPeriod(2043, 2044, course.FirstSemester),
+	})
+	file := course.MustNewMaterialFile("blob-key", "Lecture 1.pdf", "application/pdf", 1024)
+	err := crs.AttachAuxiliaryMaterial(
+		creator,
+		course.MustNewFileAuxiliaryMaterial("material-id", file, course.PresentationResource),
+	)
+	require.NoError(t, err)
+	coursesRepository := mock.NewCoursesRepository(crs)
+	handler := command.NewEditAuxiliaryMaterialHandler(coursesRepository)
+	releaseTime := time.Date(2043, time.September, 8, 9, 0, 0, 0, time.UTC)
+
+	err = handler.Handle(context.Background(), app.EditAuxiliaryMaterialCommand{
+		Academic:     creator,
+		CourseID:     "course-id",
+		MaterialID:   "material-id",
+		ResourceType: course.TrainingManualResource,
+		ReleaseTime:  releaseTime,
+		Hidden:       true,
+	})
+	require.NoError(t, err)
+
+	updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+	require.NoError(t, err)
+	material, err := updatedCourse.AuxiliaryMaterial("material-id")
+	require.NoError(t, err)
+	updatedFile, ok := material.File()
+	require.True(t, ok)
+	require.Equal(t, file, updatedFile)
+	require.Equal(t, "Lecture 1.pdf", material.Resource())
+	require.Equal(t, course.TrainingManualResource, material.ResourceType())
+	require.Equal(t, releaseTime, material.ReleaseTime())
+	require.Equal(t, course.HiddenMaterial, material.Status(releaseTime))
+}
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type EditTaskHandler struct {
+	coursesRepository coursesRepository
+	testDataStorage   testDataStorage
+	languageRegistry  languageRegistry
+	descriptionMaxLen int
+}
+
+func NewEditTaskHandler(
+	repository coursesRepository,
+	storage testDataStorage,
+	registry languageRegistry,
+	descriptionMaxLen int,
+) EditTaskHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if storage == nil {
+		panic("testDataStorage is nil")
+	}
+
+	if registry == nil {
+		panic("languageRegistry is nil")
+	}
+
+	if descriptionMaxLen <= 0 || descriptionMaxLen > course.TaskDescriptionMaxLen {
+		panic("descriptionMaxLen is out of range")
+	}
+
+	return EditTaskHandler{
+		coursesRepository: repository,
+		testDataStorage:   storage,
+		languageRegistry:  registry,
+		descriptionMaxLen: descriptionMaxLen,
+	}
+}
+
+func (h EditTaskHandler) Handle(ctx context.Context, cmd app.EditTaskCommand) (err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"editing task No %d of course #%s by academic #%s",
+			cmd.TaskNumber, cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	if cmd.TaskDescription != nil && len(*cmd.TaskDescription) > h.descriptionMaxLen {
+		return course.ErrTaskDescriptionTooLong
+	}
+
+	if err := checkStoredTestDataExist(ctx, h.testDataStorage, cmd.CourseID, cmd.TestData); err != nil {
+		return err
+	}
+
+	if err := checkLanguagesSupported(ctx, h.languageRegistry, cmd.Languages); err != nil {
+		return err
+	}
+
+	return h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, editTask(cmd))
+}
+
+func editTask(cmd app.EditTaskCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.EditTask(cmd.Academic, cmd.TaskNumber, course.TaskEditParams{
+			Title:       cmd.TaskTitle,
+			Description: cmd.TaskDescription,
+			TeamWork:    cmd.TeamWork,
+			Deadline:    cmd.Deadline,
+			TestPoints:  cmd.TestPoints,
+			TestData:    cmd.TestData,
+			Checker:     cmd.Checker,
+			Languages:   cmd.Languages,
+			Limits:      cmd.Limits,
+		}, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,156 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestEditTaskHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		title           = "Reverse linked list"
+		description     = "Reverse list **in place**"
+		longDescription = strings.Repeat("x", 1001)
+	)
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	testCases := []struct {
+		Name                     string
+		Command                  app.EditTaskCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "edit_task",
+			Command: app.EditTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskNumber:      1,
+				TaskTitle:       &title,
+				TaskDescription: &description,
+				Languages:       []string{"go"},
+			},
+			PrepareCoursesRepository: addCourse,
+		},
+		{
+			Name: "dont_edit_when_description_too_long",
+			Command: app.EditTaskCommand{
+				Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:        "course-id",
+				TaskNumber:      1,
+				TaskDescription: &longDescription,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskDescriptionTooLong)
+			},
+		},
+		{
+			Name: "dont_edit_when_language_not_supported",
+			Command: app.EditTaskCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				Languages:  []string{"cobol"},
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrLanguageNotSupported)
+			},
+		},
+		{
+			Name: "dont_edit_when_stored_test_data_doesnt_exist",
+			Command: app.EditTaskCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				TestData: []course.TestData{
+					course.MustNewStoredTestData("test-data-1", "test-data-2", course.HiddenTestData, ""),
+				},
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrTestDataDoesntExist)
+			},
+		},
+		{
+			Name: "dont_edit_when_academic_cant_edit_course",
+			Command: app.EditTaskCommand{
+				Academic:   course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				TaskTitle:  &title,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr:                    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_edit_when_course_doesnt_exist",
+			Command: app.EditTaskCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				TaskTitle:  &title,
+			},
+			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: creator,
+				Title:   "Algorithms",
+				Period:  course.MustNewPeriod(2043, 2044, course.FirstSemester),
+			})
+			_, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{
+				Title:    "Linked list",
+				TestData: []course.TestData{course.MustNewTestData("1 2 3", "3 2 1", course.SampleTestData, "")},
+			}, time.Now())
+			require.NoError(t, err)
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			languageRegistry := mock.NewLanguageRegistry("go", "python3")
+			handler := command.NewEditTaskHandler(coursesRepository, mock.NewTestDataStorage(), languageRegistry, 1000)
+
+			err = handler.Handle(context.Background(), c.Command)
+
+			task, taskErr := crs.Task(1)
+			require.NoError(t, taskErr)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, 1, task.Version())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 2, task.Version())
+			require.Equal(t, title, task.Title())
+			require.Equal(t, description, task.Description())
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package command
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type EnrollStudentsHandler struct {
+	coursesRepository coursesRepository
+	academicsService  academicsService
+	concurrency       int
+	maxRows           int
+}
+
+func NewEnrollStudentsHandler(
+	repository coursesRepository,
+	service academicsService,
+	concurrency, maxRows int,
+) EnrollStudentsHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	if concurrency <= 0 {
+		panic("concurrency isn't positive")
+	}
+
+	if maxRows <= 0 {
+		panic("maxRows isn't positive")
+	}
+
+	return EnrollStudentsHandler{
+		coursesRepository: repository,
+		academicsService:  service,
+		concurrency:       concurrency,
+		maxRows:           maxRows,
+	}
+}
+
+func (h EnrollStudentsHandler) Handle(
+	ctx context.Context,
+	cmd app.EnrollStudentsCommand,
+) (rows []app.EnrollmentRow, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"enrolling %d students to course #%s by academic #%s",
+			len(cmd.Rows), cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	if len(cmd.Rows) > h.maxRows {
+		return nil, app.ErrEnrollmentTooLarge
+	}
+
+	rows, err = h.resolveStudents(ctx, cmd.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.DryRun {
+		crs, err := h.coursesRepository.GetCourse(ctx, cmd.CourseID)
+		if err != nil {
+			return nil, err
+		}
+
+		addedIDs := newEnrolledStudentIDs(crs, rows)
+		if err := crs.CanAddStudents(cmd.Academic, addedIDs...); err != nil {
+			return nil, err
+		}
+
+		return markEnrollmentRows(rows, addedIDs), nil
+	}
+
+	var addedIDs []string
+	if err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, enrollStudents(cmd, rows, &addedIDs)); err != nil {
+		return nil, err
+	}
+
+	return markEnrollmentRows(rows, addedIDs), nil
+}
+
+// resolveStudents finds student of each row in academics service. Rows
+// with IDs are checked by one request, rows with emails are resolved
+// one by one and no more than concurrency requests are sent at once.
+// Academics service decides whether ID is student's, so any ID format
+// of directory is accepted.
+func (h EnrollStudentsHandler) resolveStudents(ctx context.Context, values []string) ([]app.EnrollmentRow, error) {
+	rows := make([]app.EnrollmentRow, len(values))
+	for i, v := range values {
+		rows[i] = app.EnrollmentRow{Number: i + 1, Value: strings.TrimSpace(v)}
+	}
+
+	if err := h.resolveStudentIDs(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	if err := h.resolveStudentEmails(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (h EnrollStudentsHandler) resolveStudentIDs(ctx context.Context, rows []app.EnrollmentRow) error {
+	var studentIDs []string
+
+	for _, row := range rows {
+		if isStudentID(row.Value) {
+			studentIDs = append(studentIDs, row.Value)
+		}
+	}
+
+	if len(studentIDs) == 0 {
+		return nil
+	}
+
+	missingIDs, err := h.academicsService.MissingStudents(ctx, studentIDs)
+	if err != nil {
+		return err
+	}
+
+	missing := make(map[string]bool, len(missingIDs))
+	for _, id := range missingIDs {
+		missing[id] = true
+	}
+
+	for i := range rows {
+		switch {
+		case !isStudentID(rows[i].Value):
+			continue
+		case missing[rows[i].Value]:
+			rows[i].Status = app.EnrollmentUnknown
+		default:
+			rows[i].StudentID = rows[i].Value
+		}
+	}
+
+	return nil
+}
+
+func (h EnrollStudentsHandler) resolveStudentEmails(ctx context.Context, rows []app.EnrollmentRow) error {
+	errs := make([]error, len(rows))
+	semaphore := make(chan struct{}, h.concurrency)
+
+	var wg sync.WaitGroup
+
+	for i := range rows {
+		if !isEmail(rows[i].Value) {
+			if !isStudentID(rows[i].Value) {
+				rows[i].Status = app.EnrollmentInvalid
+			}
+
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(row *app.EnrollmentRow, err *error) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			*err = h.resolveStudentEmail(ctx, row)
+		}(&rows[i], &errs[i])
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h EnrollStudentsHandler) resolveStudentEmail(ctx context.Context, row *app.EnrollmentRow) error {
+	studentID, err := h.academicsService.StudentByEmail(ctx, row.Value)
+	if errors.Is(err, app.ErrStudentDoesntExist) {
+		row.Status = app.EnrollmentUnknown
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	row.StudentID = studentID
+
+	return nil
+}
+
+// isStudentID reports whether value can be ID of student in any
+// directory, it's neither empty nor contains spaces or at sign.
+func isStudentID(value string) bool {
+	return value != "" && !strings.ContainsAny(value, " \t@")
+}
+
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+
+	return err == nil && address.Address == value
+}
+
+// enrollStudents keeps students added by the last run of update function in
+// addedIDs, repository may run function several times before course is saved.
+func enrollStudents(cmd app.EnrollStudentsCommand, rows []app.EnrollmentRow, addedIDs *[]string) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		studentIDs := newEnrolledStudentIDs(crs, rows)
+		if err := crs.AddStudents(cmd.Academic, time.Now(), studentIDs...); err != nil {
+			return nil, err
+		}
+
+		*addedIDs = studentIDs
+
+		return crs, nil
+	}
+}
+
+// newEnrolledStudentIDs returns found students of rows who aren't in course,
+// the same student found in several rows is returned only once.
+func newEnrolledStudentIDs(crs *course.Course, rows []app.EnrollmentRow) []string {
+	enrolled := make(map[string]bool)
+	for _, s := range crs.Students() {
+		enrolled[s] = true
+	}
+
+	var studentIDs []string
+
+	for _, row := range rows {
+		if row.StudentID == "" || enrolled[row.StudentID] {
+			continue
+		}
+
+		enrolled[row.StudentID] = true
+		studentIDs = append(studentIDs, row.StudentID)
+	}
+
+	return studentIDs
+}
+
+// markEnrollmentRows sets statuses of rows with found students, only the first
+// row of each added student is marked added, other rows are already enrolled.
+func markEnrollmentRows(rows []app.EnrollmentRow, addedIDs []string) []app.EnrollmentRow {
+	added := make(map[string]bool, len(addedIDs))
+	for _, id := range addedIDs {
+		added[id] = true
+	}
+
+	for i := range rows {
+		if rows[i].StudentID == "" {
+			continue
+		}
+
+		if added[rows[i].StudentID] {
+			rows[i].Status = app.EnrollmentAdded
+			added[rows[i].StudentID] = false
+
+			continue
+		}
+
+		rows[i].Status = app.EnrollmentAlreadyEnrolled
+	}
+
+	return rows
+}
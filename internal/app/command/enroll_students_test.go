@@ -0,0 +1,195 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestEnrollStudentsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	const (
+		enrolledStudentID = "0f1e2d3c-4b5a-4968-8776-655443322110"
+		newStudentID      = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		emailStudentID    = "9f8e7d6c-5b4a-4392-8180-7f6e5d4c3b2a"
+		unknownStudentID  = "5e4d3c2b-1a09-4f8e-8d7c-6b5a49382716"
+		rosterStudentID   = "st-2021-0042"
+	)
+
+	rows := []string{
+		enrolledStudentID,
+		" " + newStudentID + " ",
+		"student@university.ru",
+		unknownStudentID,
+		"unknown@university.ru",
+		"not a student",
+		newStudentID,
+		rosterStudentID,
+		"st-2021-0043",
+		"student@",
+	}
+	expectedReport := []app.EnrollmentRow{
+		{Number: 1, Value: enrolledStudentID, StudentID: enrolledStudentID, Status: app.EnrollmentAlreadyEnrolled},
+		{Number: 2, Value: newStudentID, StudentID: newStudentID, Status: app.EnrollmentAdded},
+		{Number: 3, Value: "student@university.ru", StudentID: emailStudentID, Status: app.EnrollmentAdded},
+		{Number: 4, Value: unknownStudentID, Status: app.EnrollmentUnknown},
+		{Number: 5, Value: "unknown@university.ru", Status: app.EnrollmentUnknown},
+		{Number: 6, Value: "not a student", Status: app.EnrollmentInvalid},
+		{Number: 7, Value: newStudentID, StudentID: newStudentID, Status: app.EnrollmentAlreadyEnrolled},
+		{Number: 8, Value: rosterStudentID, StudentID: rosterStudentID, Status: app.EnrollmentAdded},
+		{Number: 9, Value: "st-2021-0043", Status: app.EnrollmentUnknown},
+		{Number: 10, Value: "student@", Status: app.EnrollmentInvalid},
+	}
+
+	testCases := []struct {
+		Name             string
+		Command          app.EnrollStudentsCommand
+		IsErr            func(err error) bool
+		ExpectedStudents []string
+	}{
+		{
+			Name: "enroll_students",
+			Command: app.EnrollStudentsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Rows:     rows,
+			},
+			ExpectedStudents: []string{enrolledStudentID, newStudentID, emailStudentID, rosterStudentID},
+		},
+		{
+			Name: "dont_change_course_in_dry_run",
+			Command: app.EnrollStudentsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Rows:     rows,
+				DryRun:   true,
+			},
+			ExpectedStudents: []string{enrolledStudentID},
+		},
+		{
+			Name: "dont_enroll_when_academic_cant_edit_course",
+			Command: app.EnrollStudentsCommand{
+				Academic: course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID: "course-id",
+				Rows:     rows,
+				DryRun:   true,
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_enroll_when_too_many_rows",
+			Command: app.EnrollStudentsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Rows:     make([]string, 21),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrEnrollmentTooLarge)
+			},
+		},
+		{
+			Name: "dont_enroll_when_course_doesnt_exist",
+			Command: app.EnrollStudentsCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+				Rows:     rows,
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Linear algebra",
+				Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+				Students: []string{enrolledStudentID},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			academicsService := mock.NewAcademicsService(nil, []string{enrolledStudentID, newStudentID, rosterStudentID}, nil).
+				WithStudentEmail(emailStudentID, "student@university.ru")
+			handler := command.NewEnrollStudentsHandler(coursesRepository, academicsService, 2, 20)
+
+			report, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expectedReport, report)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.ElementsMatch(t, c.ExpectedStudents, updatedCourse.Students())
+		})
+	}
+}
+
+func TestEnrollStudentsHandler_Handle_reportsSavedUpdate(t *testing.T) {
+	t.Parallel()
+
+	const studentID = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+	newCourse := func(students ...string) *course.Course {
+		return course.MustNewCourse(course.CreationParams{
+			ID:       "course-id",
+			Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+			Title:    "Linear algebra",
+			Period:   course.MustNewPeriod(2030, 2031, course.FirstSemester),
+			Students: students,
+		})
+	}
+	coursesRepository := &retryingCoursesRepository{
+		CoursesRepository: mock.NewCoursesRepository(newCourse(studentID)),
+		staleCourse:       newCourse(),
+	}
+	academicsService := mock.NewAcademicsService(nil, []string{studentID}, nil)
+	handler := command.NewEnrollStudentsHandler(coursesRepository, academicsService, 2, 10)
+
+	report, err := handler.Handle(context.Background(), app.EnrollStudentsCommand{
+		Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		CourseID: "course-id",
+		Rows:     []string{studentID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []app.EnrollmentRow{
+		{Number: 1, Value: studentID, StudentID: studentID, Status: app.EnrollmentAlreadyEnrolled},
+	}, report, "student was enrolled by concurrent update that made repository run update again")
+}
+
+// retryingCoursesRepository runs update function on stale course
+// before running it on saved one, like after concurrent update.
+type retryingCoursesRepository struct {
+	*mock.CoursesRepository
+	staleCourse *course.Course
+}
+
+func (r *retryingCoursesRepository) UpdateCourse(
+	ctx context.Context,
+	courseID string,
+	updateFn command.UpdateFunction,
+) error {
+	if _, err := updateFn(ctx, r.staleCourse); err != nil {
+		return err
+	}
+
+	return r.CoursesRepository.UpdateCourse(ctx, courseID, updateFn)
+}
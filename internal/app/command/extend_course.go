@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -12,14 +13,19 @@ import (
 
 type ExtendCourseHandler struct {
 	coursesRepository coursesRepository
+	testDataStorage   testDataStorage
 }
 
-func NewExtendCourseHandler(repository coursesRepository) ExtendCourseHandler {
+func NewExtendCourseHandler(repository coursesRepository, storage testDataStorage) ExtendCourseHandler {
 	if repository == nil {
 		panic("coursesRepository is nil")
 	}
 
-	return ExtendCourseHandler{coursesRepository: repository}
+	if storage == nil {
+		panic("testDataStorage is nil")
+	}
+
+	return ExtendCourseHandler{coursesRepository: repository, testDataStorage: storage}
 }
 
 func (h ExtendCourseHandler) Handle(ctx context.Context, cmd app.ExtendCourseCommand) (extendedCourseID string, err error) {
@@ -31,7 +37,7 @@ func (h ExtendCourseHandler) Handle(ctx context.Context, cmd app.ExtendCourseCom
 	if err := h.coursesRepository.UpdateCourse(
 		ctx,
 		cmd.OriginCourseID,
-		extendCourse(extendedCourseID, cmd),
+		h.extendCourse(extendedCourseID, cmd),
 	); err != nil {
 		return "", err
 	}
@@ -39,14 +45,29 @@ func (h ExtendCourseHandler) Handle(ctx context.Context, cmd app.ExtendCourseCom
 	return extendedCourseID, nil
 }
 
-func extendCourse(extendedCourseID string, cmd app.ExtendCourseCommand) UpdateFunction {
-	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
-		return crs.Extend(course.CreationParams{
+// extendCourse shares stored test data of copied tasks with extended course,
+// otherwise extended course tasks would reference test data of origin course.
+func (h ExtendCourseHandler) extendCourse(extendedCourseID string, cmd app.ExtendCourseCommand) UpdateFunction {
+	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
+		extendedCourse, err := crs.Extend(course.CreationParams{
 			ID:      extendedCourseID,
 			Creator: cmd.Academic,
 			Title:   cmd.CourseTitle,
 			Period:  cmd.CoursePeriod,
 			Started: cmd.CourseStarted,
-		})
+		}, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := h.testDataStorage.ShareTestData(
+			ctx,
+			crs.ID(), extendedCourseID,
+			extendedCourse.StoredTestDataIDs(),
+		); err != nil {
+			return nil, err
+		}
+
+		return extendedCourse, nil
 	}
 }
@@ -2,7 +2,10 @@ package command_test
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -81,7 +84,7 @@ func TestExtendCourseHandler_Handle(t *testing.T) {
 				Period:  course.MustNewPeriod(2023, 2024, course.FirstSemester),
 			})
 			coursesRepository := c.PrepareCoursesRepository(originCourse)
-			handler := command.NewExtendCourseHandler(coursesRepository)
+			handler := command.NewExtendCourseHandler(coursesRepository, mock.NewTestDataStorage())
 
 			extendedCourseID, err := handler.Handle(context.Background(), c.Command)
 
@@ -98,3 +101,55 @@ func TestExtendCourseHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestExtendCourseHandler_Handle_sharesStoredTestData(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+
+	testDataStorage := mock.NewTestDataStorage()
+	inputDataID, err := testDataStorage.SaveTestData(ctx, "origin-course-id", strings.NewReader("1 2"))
+	require.NoError(t, err)
+	outputDataID, err := testDataStorage.SaveTestData(ctx, "origin-course-id", strings.NewReader("3"))
+	require.NoError(t, err)
+
+	originCourse := course.MustNewCourse(course.CreationParams{
+		ID:      "origin-course-id",
+		Creator: creator,
+		Title:   "Programming",
+		Period:  course.MustNewPeriod(2023, 2024, course.FirstSemester),
+	})
+	_, err = originCourse.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{
+		Title: "Sum of two numbers",
+		TestData: []course.TestData{
+			course.MustNewStoredTestData(inputDataID, outputDataID, course.SampleTestData, ""),
+		},
+	}, time.Now())
+	require.NoError(t, err)
+
+	coursesRepository := mock.NewCoursesRepository(originCourse)
+	handler := command.NewExtendCourseHandler(coursesRepository, testDataStorage)
+
+	extendedCourseID, err := handler.Handle(ctx, app.ExtendCourseCommand{
+		Academic:       creator,
+		OriginCourseID: "origin-course-id",
+	})
+	require.NoError(t, err)
+
+	extendedCourse, err := coursesRepository.GetCourse(ctx, extendedCourseID)
+	require.NoError(t, err)
+	require.Equal(t, []string{inputDataID, outputDataID}, extendedCourse.StoredTestDataIDs())
+
+	for _, id := range extendedCourse.StoredTestDataIDs() {
+		require.NoError(t, testDataStorage.TestDataExists(ctx, extendedCourseID, id))
+		require.NoError(t, testDataStorage.TestDataExists(ctx, "origin-course-id", id))
+	}
+
+	data, err := testDataStorage.OpenTestData(ctx, extendedCourseID, inputDataID)
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(data)
+	require.NoError(t, err)
+	require.Equal(t, "1 2", string(content))
+}
@@ -18,6 +18,12 @@ func (m RemoveCollaboratorHandler) Handle(ctx context.Context, cmd app.RemoveCol
 	return m(ctx, cmd)
 }
 
+type ChangeCollaboratorRoleHandler func(ctx context.Context, cmd app.ChangeCollaboratorRoleCommand) error
+
+func (m ChangeCollaboratorRoleHandler) Handle(ctx context.Context, cmd app.ChangeCollaboratorRoleCommand) error {
+	return m(ctx, cmd)
+}
+
 type CreateCourseHandler func(ctx context.Context, cmd app.CreateCourseCommand) (string, error)
 
 func (m CreateCourseHandler) Handle(ctx context.Context, cmd app.CreateCourseCommand) (string, error) {
@@ -36,14 +42,197 @@ func (m AddStudentHandler) Handle(ctx context.Context, cmd app.AddStudentCommand
 	return m(ctx, cmd)
 }
 
+type AddGroupHandler func(ctx context.Context, cmd app.AddGroupCommand) error
+
+func (m AddGroupHandler) Handle(ctx context.Context, cmd app.AddGroupCommand) error {
+	return m(ctx, cmd)
+}
+
+type RemoveGroupHandler func(ctx context.Context, cmd app.RemoveGroupCommand) error
+
+func (m RemoveGroupHandler) Handle(ctx context.Context, cmd app.RemoveGroupCommand) error {
+	return m(ctx, cmd)
+}
+
+type SyncGroupHandler func(ctx context.Context, cmd app.SyncGroupCommand) error
+
+func (m SyncGroupHandler) Handle(ctx context.Context, cmd app.SyncGroupCommand) error {
+	return m(ctx, cmd)
+}
+
+type EnrollStudentsHandler func(ctx context.Context, cmd app.EnrollStudentsCommand) ([]app.EnrollmentRow, error)
+
+func (m EnrollStudentsHandler) Handle(ctx context.Context, cmd app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+	return m(ctx, cmd)
+}
+
 type RemoveStudentHandler func(ctx context.Context, cmd app.RemoveStudentCommand) error
 
 func (m RemoveStudentHandler) Handle(ctx context.Context, cmd app.RemoveStudentCommand) error {
 	return m(ctx, cmd)
 }
 
+type CompleteStudentHandler func(ctx context.Context, cmd app.CompleteStudentCommand) error
+
+func (m CompleteStudentHandler) Handle(ctx context.Context, cmd app.CompleteStudentCommand) error {
+	return m(ctx, cmd)
+}
+
 type AddTaskHandler func(ctx context.Context, cmd app.AddTaskCommand) (int, error)
 
 func (m AddTaskHandler) Handle(ctx context.Context, cmd app.AddTaskCommand) (int, error) {
 	return m(ctx, cmd)
 }
+
+type UploadTestDataHandler func(ctx context.Context, cmd app.UploadTestDataCommand) (string, error)
+
+func (m UploadTestDataHandler) Handle(ctx context.Context, cmd app.UploadTestDataCommand) (string, error) {
+	return m(ctx, cmd)
+}
+
+type EditTaskHandler func(ctx context.Context, cmd app.EditTaskCommand) error
+
+func (m EditTaskHandler) Handle(ctx context.Context, cmd app.EditTaskCommand) error {
+	return m(ctx, cmd)
+}
+
+type RestoreTaskVersionHandler func(ctx context.Context, cmd app.RestoreTaskVersionCommand) error
+
+func (m RestoreTaskVersionHandler) Handle(ctx context.Context, cmd app.RestoreTaskVersionCommand) error {
+	return m(ctx, cmd)
+}
+
+type AttachAuxiliaryMaterialHandler func(ctx context.Context, cmd app.AttachAuxiliaryMaterialCommand) (string, error)
+
+func (m AttachAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	cmd app.AttachAuxiliaryMaterialCommand,
+) (string, error) {
+	return m(ctx, cmd)
+}
+
+type EditAuxiliaryMaterialHandler func(ctx context.Context, cmd app.EditAuxiliaryMaterialCommand) error
+
+func (m EditAuxiliaryMaterialHandler) Handle(ctx context.Context, cmd app.EditAuxiliaryMaterialCommand) error {
+	return m(ctx, cmd)
+}
+
+type RemoveAuxiliaryMaterialHandler func(ctx context.Context, cmd app.RemoveAuxiliaryMaterialCommand) error
+
+func (m RemoveAuxiliaryMaterialHandler) Handle(ctx context.Context, cmd app.RemoveAuxiliaryMaterialCommand) error {
+	return m(ctx, cmd)
+}
+
+type ReorderAuxiliaryMaterialsHandler func(ctx context.Context, cmd app.ReorderAuxiliaryMaterialsCommand) error
+
+func (m ReorderAuxiliaryMaterialsHandler) Handle(ctx context.Context, cmd app.ReorderAuxiliaryMaterialsCommand) error {
+	return m(ctx, cmd)
+}
+
+type UploadAuxiliaryMaterialHandler func(ctx context.Context, cmd app.UploadAuxiliaryMaterialCommand) (string, error)
+
+func (m UploadAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	cmd app.UploadAuxiliaryMaterialCommand,
+) (string, error) {
+	return m(ctx, cmd)
+}
+
+type CreateInvitationHandler func(ctx context.Context, cmd app.CreateInvitationCommand) (string, error)
+
+func (m CreateInvitationHandler) Handle(ctx context.Context, cmd app.CreateInvitationCommand) (string, error) {
+	return m(ctx, cmd)
+}
+
+type RevokeInvitationHandler func(ctx context.Context, cmd app.RevokeInvitationCommand) error
+
+func (m RevokeInvitationHandler) Handle(ctx context.Context, cmd app.RevokeInvitationCommand) error {
+	return m(ctx, cmd)
+}
+
+type RedeemInvitationHandler func(
+	ctx context.Context,
+	cmd app.RedeemInvitationCommand,
+) (app.RedeemedInvitation, error)
+
+func (m RedeemInvitationHandler) Handle(
+	ctx context.Context,
+	cmd app.RedeemInvitationCommand,
+) (app.RedeemedInvitation, error) {
+	return m(ctx, cmd)
+}
+
+type RequestEnrollmentHandler func(ctx context.Context, cmd app.RequestEnrollmentCommand) (string, error)
+
+func (m RequestEnrollmentHandler) Handle(ctx context.Context, cmd app.RequestEnrollmentCommand) (string, error) {
+	return m(ctx, cmd)
+}
+
+type ApproveEnrollmentRequestsHandler func(ctx context.Context, cmd app.ApproveEnrollmentRequestsCommand) error
+
+func (m ApproveEnrollmentRequestsHandler) Handle(ctx context.Context, cmd app.ApproveEnrollmentRequestsCommand) error {
+	return m(ctx, cmd)
+}
+
+type RejectEnrollmentRequestsHandler func(ctx context.Context, cmd app.RejectEnrollmentRequestsCommand) error
+
+func (m RejectEnrollmentRequestsHandler) Handle(ctx context.Context, cmd app.RejectEnrollmentRequestsCommand) error {
+	return m(ctx, cmd)
+}
+
+type ChangeTeamSettingsHandler func(ctx context.Context, cmd app.ChangeTeamSettingsCommand) error
+
+func (m ChangeTeamSettingsHandler) Handle(ctx context.Context, cmd app.ChangeTeamSettingsCommand) error {
+	return m(ctx, cmd)
+}
+
+type CreateTeamHandler func(ctx context.Context, cmd app.CreateTeamCommand) (string, error)
+
+func (m CreateTeamHandler) Handle(ctx context.Context, cmd app.CreateTeamCommand) (string, error) {
+	return m(ctx, cmd)
+}
+
+type RenameTeamHandler func(ctx context.Context, cmd app.RenameTeamCommand) error
+
+func (m RenameTeamHandler) Handle(ctx context.Context, cmd app.RenameTeamCommand) error {
+	return m(ctx, cmd)
+}
+
+type RemoveTeamHandler func(ctx context.Context, cmd app.RemoveTeamCommand) error
+
+func (m RemoveTeamHandler) Handle(ctx context.Context, cmd app.RemoveTeamCommand) error {
+	return m(ctx, cmd)
+}
+
+type AddTeamMembersHandler func(ctx context.Context, cmd app.AddTeamMembersCommand) error
+
+func (m AddTeamMembersHandler) Handle(ctx context.Context, cmd app.AddTeamMembersCommand) error {
+	return m(ctx, cmd)
+}
+
+type RemoveTeamMemberHandler func(ctx context.Context, cmd app.RemoveTeamMemberCommand) error
+
+func (m RemoveTeamMemberHandler) Handle(ctx context.Context, cmd app.RemoveTeamMemberCommand) error {
+	return m(ctx, cmd)
+}
+
+type ChangeCourseCapacityHandler func(ctx context.Context, cmd app.ChangeCourseCapacityCommand) error
+
+func (m ChangeCourseCapacityHandler) Handle(ctx context.Context, cmd app.ChangeCourseCapacityCommand) error {
+	return m(ctx, cmd)
+}
+
+type RemoveFromWaitlistHandler func(ctx context.Context, cmd app.RemoveFromWaitlistCommand) error
+
+func (m RemoveFromWaitlistHandler) Handle(ctx context.Context, cmd app.RemoveFromWaitlistCommand) error {
+	return m(ctx, cmd)
+}
+
+type DeactivateAcademicHandler func(ctx context.Context, cmd app.DeactivateAcademicCommand) (app.DeactivationReport, error)
+
+func (m DeactivateAcademicHandler) Handle(
+	ctx context.Context,
+	cmd app.DeactivateAcademicCommand,
+) (app.DeactivationReport, error) {
+	return m(ctx, cmd)
+}
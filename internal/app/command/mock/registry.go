@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type LanguageRegistry struct {
+	languages map[string]bool
+}
+
+func NewLanguageRegistry(languages ...string) *LanguageRegistry {
+	lrm := &LanguageRegistry{
+		languages: make(map[string]bool, len(languages)),
+	}
+	for _, l := range languages {
+		lrm.languages[l] = true
+	}
+
+	return lrm
+}
+
+func (m *LanguageRegistry) LanguageSupported(_ context.Context, languageID string) error {
+	if m.languages[languageID] {
+		return nil
+	}
+
+	return app.ErrLanguageNotSupported
+}
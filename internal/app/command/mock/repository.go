@@ -2,34 +2,57 @@ package mock
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/app/command"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
 )
 
+// CoursesRepository keeps courses in memory, courses are
+// updated one by one like in transactional database.
 type CoursesRepository struct {
+	mu      sync.Mutex
 	courses map[string]course.Course
+
+	// versionsMu is separate, so update function can get task versions.
+	versionsMu   sync.Mutex
+	taskVersions map[taskVersionsKey][]course.TaskVersion
+}
+
+type taskVersionsKey struct {
+	courseID   string
+	taskNumber int
 }
 
 func NewCoursesRepository(courses ...*course.Course) *CoursesRepository {
 	crm := &CoursesRepository{
-		courses: make(map[string]course.Course, len(courses)),
+		courses:      make(map[string]course.Course, len(courses)),
+		taskVersions: make(map[taskVersionsKey][]course.TaskVersion),
 	}
 	for _, crs := range courses {
 		crm.courses[crs.ID()] = *crs
+		crm.appendTaskVersions(crs)
 	}
 
 	return crm
 }
 
 func (m *CoursesRepository) AddCourse(_ context.Context, crs *course.Course) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.courses[crs.ID()] = *crs
+	m.appendTaskVersions(crs)
 
 	return nil
 }
 
 func (m *CoursesRepository) GetCourse(_ context.Context, courseID string) (*course.Course, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	crs, ok := m.courses[courseID]
 	if !ok {
 		return nil, app.ErrCourseDoesntExist
@@ -43,6 +66,9 @@ func (m *CoursesRepository) UpdateCourse(
 	courseID string,
 	updateFn command.UpdateFunction,
 ) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	crs, ok := m.courses[courseID]
 	if !ok {
 		return app.ErrCourseDoesntExist
@@ -54,10 +80,104 @@ func (m *CoursesRepository) UpdateCourse(
 	}
 
 	m.courses[updatedCrs.ID()] = *updatedCrs
+	m.appendTaskVersions(updatedCrs)
 
 	return nil
 }
 
+// appendTaskVersions appends only versions newer than appended ones, because
+// kept course is given out with versions committed by previous updates.
+func (m *CoursesRepository) appendTaskVersions(crs *course.Course) {
+	m.versionsMu.Lock()
+	defer m.versionsMu.Unlock()
+
+	for _, v := range crs.NewTaskVersions() {
+		task := v.Task()
+		key := taskVersionsKey{courseID: crs.ID(), taskNumber: task.Number()}
+
+		versions := m.taskVersions[key]
+		if len(versions) > 0 && versions[len(versions)-1].Number() >= v.Number() {
+			continue
+		}
+
+		m.taskVersions[key] = append(versions, v)
+	}
+}
+
+func (m *CoursesRepository) GetTaskVersion(
+	_ context.Context,
+	courseID string, taskNumber, version int,
+) (course.TaskVersion, error) {
+	m.versionsMu.Lock()
+	defer m.versionsMu.Unlock()
+
+	for _, v := range m.taskVersions[taskVersionsKey{courseID: courseID, taskNumber: taskNumber}] {
+		if v.Number() == version {
+			return v, nil
+		}
+	}
+
+	return course.TaskVersion{}, course.ErrTaskHasNoSuchVersion
+}
+
+func (m *CoursesRepository) FindInvitationCourseID(_ context.Context, code string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, crs := range m.courses {
+		for _, invitation := range crs.Invitations() {
+			if invitation.Code() == code {
+				return id, nil
+			}
+		}
+	}
+
+	return "", app.ErrInvitationDoesntExist
+}
+
+func (m *CoursesRepository) FindAcademicCourseIDs(_ context.Context, academicID string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	courseIDs := make([]string, 0)
+
+	for id, crs := range m.courses {
+		crs := crs
+		if hasAcademic(&crs, academicID) {
+			courseIDs = append(courseIDs, id)
+		}
+	}
+
+	sort.Strings(courseIDs)
+
+	return courseIDs, nil
+}
+
+func hasAcademic(crs *course.Course, academicID string) bool {
+	if crs.CreatorID() == academicID || crs.IsWaitlisted(academicID) {
+		return true
+	}
+
+	if _, ok := crs.CollaboratorRoles()[academicID]; ok {
+		return true
+	}
+
+	if _, ok := crs.StudentEnrollment(academicID); ok {
+		return true
+	}
+
+	for _, r := range crs.EnrollmentRequests() {
+		if r.StudentID() == academicID {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (m *CoursesRepository) CoursesNumber() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	return len(m.courses)
 }
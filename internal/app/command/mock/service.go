@@ -2,21 +2,30 @@ package mock
 
 import (
 	"context"
+	"sync"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 )
 
 type AcademicsService struct {
-	teachers map[string]bool
-	students map[string]bool
-	groups   map[string]bool
+	teachers  map[string]bool
+	students  map[string]bool
+	groups    map[string]bool
+	fullNames map[string]string
+
+	groupStudents map[string][]string
+	emails        map[string]string
 }
 
 func NewAcademicsService(teachers []string, students []string, groups []string) *AcademicsService {
 	asm := &AcademicsService{
-		teachers: make(map[string]bool, len(teachers)),
-		students: make(map[string]bool, len(students)),
-		groups:   make(map[string]bool, len(groups)),
+		teachers:  make(map[string]bool, len(teachers)),
+		students:  make(map[string]bool, len(students)),
+		groups:    make(map[string]bool, len(groups)),
+		fullNames: make(map[string]string),
+
+		groupStudents: make(map[string][]string),
+		emails:        make(map[string]string),
 	}
 	for _, t := range teachers {
 		asm.teachers[t] = true
@@ -49,6 +58,26 @@ func (m *AcademicsService) StudentExists(_ context.Context, studentID string) er
 	return app.ErrStudentDoesntExist
 }
 
+func (m *AcademicsService) MissingTeachers(_ context.Context, teacherIDs []string) ([]string, error) {
+	return missing(m.teachers, teacherIDs), nil
+}
+
+func (m *AcademicsService) MissingStudents(_ context.Context, studentIDs []string) ([]string, error) {
+	return missing(m.students, studentIDs), nil
+}
+
+func missing(existing map[string]bool, ids []string) []string {
+	var missingIDs []string
+
+	for _, id := range ids {
+		if !existing[id] {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return missingIDs
+}
+
 func (m *AcademicsService) GroupExists(_ context.Context, groupID string) error {
 	if m.groups[groupID] {
 		return nil
@@ -56,3 +85,84 @@ func (m *AcademicsService) GroupExists(_ context.Context, groupID string) error
 
 	return app.ErrGroupDoesntExist
 }
+
+// WithFullNames sets full names of academics returned in their profiles.
+func (m *AcademicsService) WithFullNames(fullNames map[string]string) *AcademicsService {
+	for id, name := range fullNames {
+		m.fullNames[id] = name
+	}
+
+	return m
+}
+
+func (m *AcademicsService) AcademicProfiles(_ context.Context, academicIDs []string) ([]app.AcademicProfile, error) {
+	profiles := make([]app.AcademicProfile, 0, len(academicIDs))
+
+	for _, id := range academicIDs {
+		if m.teachers[id] || m.students[id] {
+			profiles = append(profiles, app.AcademicProfile{ID: id, FullName: m.fullNames[id]})
+		}
+	}
+
+	return profiles, nil
+}
+
+// WithGroupStudents puts students to group, group becomes existing.
+func (m *AcademicsService) WithGroupStudents(groupID string, studentIDs ...string) *AcademicsService {
+	m.groups[groupID] = true
+	m.groupStudents[groupID] = studentIDs
+
+	for _, s := range studentIDs {
+		m.students[s] = true
+	}
+
+	return m
+}
+
+func (m *AcademicsService) GroupStudents(_ context.Context, groupID string) ([]string, error) {
+	if !m.groups[groupID] {
+		return nil, app.ErrGroupDoesntExist
+	}
+
+	return m.groupStudents[groupID], nil
+}
+
+// WithStudentEmail sets email of student, student becomes existing.
+func (m *AcademicsService) WithStudentEmail(studentID, email string) *AcademicsService {
+	m.students[studentID] = true
+	m.emails[email] = studentID
+
+	return m
+}
+
+func (m *AcademicsService) StudentByEmail(_ context.Context, email string) (string, error) {
+	if studentID, ok := m.emails[email]; ok {
+		return studentID, nil
+	}
+
+	return "", app.ErrStudentDoesntExist
+}
+
+// AcademicsCache records academics whose cached answers are invalidated.
+type AcademicsCache struct {
+	mu          sync.Mutex
+	invalidated []string
+}
+
+func NewAcademicsCache() *AcademicsCache {
+	return &AcademicsCache{}
+}
+
+func (m *AcademicsCache) InvalidateAcademic(academicID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.invalidated = append(m.invalidated, academicID)
+}
+
+func (m *AcademicsCache) Invalidated() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.invalidated...)
+}
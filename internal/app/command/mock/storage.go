@@ -0,0 +1,145 @@
+package mock
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type storedTestData struct {
+	courseID        string
+	sharedCourseIDs map[string]bool
+	content         []byte
+}
+
+func (td storedTestData) belongsTo(courseID string) bool {
+	return td.courseID == courseID || td.sharedCourseIDs[courseID]
+}
+
+type TestDataStorage struct {
+	mu       sync.RWMutex
+	testData map[string]storedTestData
+}
+
+func NewTestDataStorage() *TestDataStorage {
+	return &TestDataStorage{testData: make(map[string]storedTestData)}
+}
+
+func (m *TestDataStorage) SaveTestData(_ context.Context, courseID string, data io.Reader) (string, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return "", err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := "test-data-" + strconv.Itoa(len(m.testData)+1)
+	m.testData[id] = storedTestData{courseID: courseID, content: content}
+
+	return id, nil
+}
+
+func (m *TestDataStorage) TestDataExists(_ context.Context, courseID, testDataID string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if td, ok := m.testData[testDataID]; ok && td.belongsTo(courseID) {
+		return nil
+	}
+
+	return app.ErrTestDataDoesntExist
+}
+
+func (m *TestDataStorage) OpenTestData(_ context.Context, courseID, testDataID string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	td, ok := m.testData[testDataID]
+	if !ok || !td.belongsTo(courseID) {
+		return nil, app.ErrTestDataDoesntExist
+	}
+
+	return io.NopCloser(bytes.NewReader(td.content)), nil
+}
+
+func (m *TestDataStorage) ShareTestData(_ context.Context, fromCourseID, toCourseID string, testDataIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, id := range testDataIDs {
+		td, ok := m.testData[id]
+		if !ok || !td.belongsTo(fromCourseID) {
+			continue
+		}
+
+		sharedCourseIDs := make(map[string]bool, len(td.sharedCourseIDs)+1)
+		for courseID := range td.sharedCourseIDs {
+			sharedCourseIDs[courseID] = true
+		}
+
+		sharedCourseIDs[toCourseID] = true
+		td.sharedCourseIDs = sharedCourseIDs
+		m.testData[id] = td
+	}
+
+	return nil
+}
+
+func (m *TestDataStorage) TestDataNumber() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.testData)
+}
+
+type BlobStorage struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+func NewBlobStorage() *BlobStorage {
+	return &BlobStorage{blobs: make(map[string][]byte)}
+}
+
+func (m *BlobStorage) SaveBlob(_ context.Context, data io.Reader) (app.Blob, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return app.Blob{}, err
+	}
+
+	sum := sha256.Sum256(content)
+	key := hex.EncodeToString(sum[:])
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blobs[key] = content
+
+	return app.Blob{Key: key, Size: int64(len(content))}, nil
+}
+
+func (m *BlobStorage) OpenBlob(_ context.Context, key string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	content, ok := m.blobs[key]
+	if !ok {
+		return nil, app.ErrBlobDoesntExist
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (m *BlobStorage) BlobsNumber() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.blobs)
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RedeemInvitationHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRedeemInvitationHandler(repository coursesRepository) RedeemInvitationHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RedeemInvitationHandler{coursesRepository: repository}
+}
+
+// Handle spends use of invitation inside UpdateCourse, so uses are
+// counted right as long as repository updates course atomically.
+func (h RedeemInvitationHandler) Handle(
+	ctx context.Context,
+	cmd app.RedeemInvitationCommand,
+) (redeemed app.RedeemedInvitation, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "redeeming invitation by academic #%s", cmd.Academic.ID())
+	}()
+
+	courseID, err := h.coursesRepository.FindInvitationCourseID(ctx, cmd.Code)
+	if err != nil {
+		return app.RedeemedInvitation{}, err
+	}
+
+	redeemed.CourseID = courseID
+
+	err = h.coursesRepository.UpdateCourse(ctx, courseID, redeemInvitation(cmd, &redeemed.Waitlisted))
+	if errors.Is(err, course.ErrCourseHasNoSuchInvitation) {
+		return app.RedeemedInvitation{}, app.Wrap(app.ErrInvitationDoesntExist, err)
+	}
+
+	if err != nil {
+		return app.RedeemedInvitation{}, err
+	}
+
+	return redeemed, nil
+}
+
+// redeemInvitation reports through waitlisted whether student got
+// into waitlist because course of invitation is full.
+func redeemInvitation(cmd app.RedeemInvitationCommand, waitlisted *bool) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RedeemInvitation(cmd.Academic, cmd.Code, time.Now()); err != nil {
+			return nil, err
+		}
+
+		*waitlisted = crs.IsWaitlisted(cmd.Academic.ID())
+
+		return crs, nil
+	}
+}
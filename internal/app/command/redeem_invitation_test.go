@@ -0,0 +1,183 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRedeemInvitationHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RedeemInvitationCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "student_joins_course",
+			Command: app.RedeemInvitationCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				Code:     "code",
+			},
+		},
+		{
+			Name: "dont_join_when_invitation_doesnt_exist",
+			Command: app.RedeemInvitationCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				Code:     "other-code",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrInvitationDoesntExist)
+			},
+		},
+		{
+			Name: "dont_join_when_invitation_is_for_collaborators",
+			Command: app.RedeemInvitationCommand{
+				Academic: course.MustNewAcademic("teacher-id", course.TeacherType),
+				Code:     "code",
+			},
+			IsErr: course.IsInvitationNotRedeemableError,
+		},
+		{
+			Name: "dont_join_when_student_already_in_course",
+			Command: app.RedeemInvitationCommand{
+				Academic: course.MustNewAcademic("student-id", course.StudentType),
+				Code:     "code",
+			},
+			IsErr: course.IsInvitationNotRedeemableError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			coursesRepository := mock.NewCoursesRepository(newCourseWithInvitation(t, 30))
+			handler := command.NewRedeemInvitationHandler(coursesRepository)
+
+			redeemed, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, app.RedeemedInvitation{CourseID: "course-id"}, redeemed)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), redeemed.CourseID)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"student-id", c.Command.Academic.ID()}, updatedCourse.Students())
+			require.Equal(t, 1, updatedCourse.Invitations()[0].Uses())
+		})
+	}
+}
+
+func TestRedeemInvitationHandler_Handle_concurrently(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxUses  = 5
+		students = 50
+	)
+
+	coursesRepository := mock.NewCoursesRepository(newCourseWithInvitation(t, maxUses))
+	handler := command.NewRedeemInvitationHandler(coursesRepository)
+
+	errs := make([]error, students)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < students; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, errs[i] = handler.Handle(context.Background(), app.RedeemInvitationCommand{
+				Academic: course.MustNewAcademic(fmt.Sprintf("student%d-id", i+1), course.StudentType),
+				Code:     "code",
+			})
+		}(i)
+	}
+
+	wg.Wait()
+
+	redeemed := 0
+
+	for _, err := range errs {
+		if err == nil {
+			redeemed++
+
+			continue
+		}
+
+		require.ErrorIs(t, err, course.ErrInvitationUsedUp)
+	}
+
+	updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+	require.NoError(t, err)
+	require.Equal(t, maxUses, redeemed)
+	require.Equal(t, maxUses, updatedCourse.Invitations()[0].Uses())
+	require.Len(t, updatedCourse.Students(), maxUses+1)
+}
+
+func TestRedeemInvitationHandler_Handle_when_course_is_full(t *testing.T) {
+	t.Parallel()
+
+	crs := newCourseWithInvitation(t, 30)
+	require.NoError(t, crs.ChangeCapacity(course.MustNewAcademic("creator-id", course.TeacherType), 1, time.Now()))
+
+	coursesRepository := mock.NewCoursesRepository(crs)
+	handler := command.NewRedeemInvitationHandler(coursesRepository)
+	cmd := app.RedeemInvitationCommand{
+		Academic: course.MustNewAcademic("student1-id", course.StudentType),
+		Code:     "code",
+	}
+
+	redeemed, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.Equal(t, app.RedeemedInvitation{CourseID: "course-id", Waitlisted: true}, redeemed)
+
+	_, err = handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, course.ErrStudentAlreadyWaitlisted)
+
+	updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student-id"}, updatedCourse.Students())
+	require.True(t, updatedCourse.IsWaitlisted("student1-id"))
+}
+
+func newCourseWithInvitation(t *testing.T, maxUses int) *course.Course {
+	t.Helper()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:       "course-id",
+		Creator:  creator,
+		Title:    "Physics",
+		Period:   course.MustNewPeriod(2029, 2030, course.FirstSemester),
+		Students: []string{"student-id"},
+	})
+	now := time.Now()
+	require.NoError(t, crs.AddInvitation(
+		creator,
+		course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), maxUses),
+		now,
+	))
+
+	return crs
+}
@@ -0,0 +1,9 @@
+package command
+
+import "context"
+
+type languageRegistry interface {
+	// LanguageSupported should return app.ErrLanguageNotSupported
+	// when registry has no toolchain for language with such id.
+	LanguageSupported(ctx context.Context, languageID string) error
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RejectEnrollmentRequestsHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRejectEnrollmentRequestsHandler(repository coursesRepository) RejectEnrollmentRequestsHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RejectEnrollmentRequestsHandler{coursesRepository: repository}
+}
+
+func (h RejectEnrollmentRequestsHandler) Handle(ctx context.Context, cmd app.RejectEnrollmentRequestsCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, rejectEnrollmentRequests(cmd))
+
+	return errors.Wrapf(
+		err,
+		"rejecting %d enrollment requests of course #%s by academic #%s",
+		len(cmd.RequestIDs), cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func rejectEnrollmentRequests(cmd app.RejectEnrollmentRequestsCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RejectEnrollmentRequests(cmd.Academic, cmd.RequestIDs, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,87 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRejectEnrollmentRequestsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RejectEnrollmentRequestsCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "reject_request",
+			Command: app.RejectEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				RequestIDs: []string{"request1-id"},
+			},
+		},
+		{
+			Name: "dont_reject_requests_when_academic_is_student",
+			Command: app.RejectEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:   "course-id",
+				RequestIDs: []string{"request1-id"},
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_reject_requests_when_course_doesnt_exist",
+			Command: app.RejectEnrollmentRequestsCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "other-course-id",
+				RequestIDs: []string{"request1-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			coursesRepository := mock.NewCoursesRepository(newCourseWithEnrollmentRequests(t))
+			handler := command.NewRejectEnrollmentRequestsHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, []string{"student-id"}, updatedCourse.Students())
+
+			requests := updatedCourse.EnrollmentRequests()
+			statuses := map[string]course.EnrollmentRequestStatus{
+				requests[0].ID(): requests[0].Status(),
+				requests[1].ID(): requests[1].Status(),
+			}
+			require.Equal(t, map[string]course.EnrollmentRequestStatus{
+				"request1-id": course.RejectedRequest,
+				"request2-id": course.PendingRequest,
+			}, statuses)
+		})
+	}
+}
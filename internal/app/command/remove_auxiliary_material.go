@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RemoveAuxiliaryMaterialHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRemoveAuxiliaryMaterialHandler(repository coursesRepository) RemoveAuxiliaryMaterialHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RemoveAuxiliaryMaterialHandler{coursesRepository: repository}
+}
+
+func (h RemoveAuxiliaryMaterialHandler) Handle(ctx context.Context, cmd app.RemoveAuxiliaryMaterialCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, removeAuxiliaryMaterial(cmd))
+
+	return errors.Wrapf(
+		err,
+		"removing auxiliary material #%s from course #%s by academic #%s",
+		cmd.MaterialID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func removeAuxiliaryMaterial(cmd app.RemoveAuxiliaryMaterialCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RemoveAuxiliaryMaterial(cmd.Academic, cmd.MaterialID); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
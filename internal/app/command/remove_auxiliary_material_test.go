@@ -0,0 +1,89 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRemoveAuxiliaryMaterialHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RemoveAuxiliaryMaterialCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "remove_auxiliary_material",
+			Command: app.RemoveAuxiliaryMaterialCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				MaterialID: "material-id",
+			},
+		},
+		{
+			Name: "dont_remove_when_material_doesnt_exist",
+			Command: app.RemoveAuxiliaryMaterialCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				MaterialID: "other-material-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchMaterial)
+			},
+		},
+		{
+			Name: "dont_remove_when_academic_cant_edit_course",
+			Command: app.RemoveAuxiliaryMaterialCommand{
+				Academic:   course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:   "course-id",
+				MaterialID: "material-id",
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Operating systems",
+				Period:   course.MustNewPeriod(2043, 2044, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			err := crs.AttachAuxiliaryMaterial(
+				creator,
+				course.MustNewAuxiliaryMaterial("material-id", "https://example.com/video", course.VideoResource),
+			)
+			require.NoError(t, err)
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewRemoveAuxiliaryMaterialHandler(coursesRepository)
+
+			err = handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+			require.NoError(t, err)
+			require.Empty(t, updatedCourse.AuxiliaryMaterials())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RemoveFromWaitlistHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRemoveFromWaitlistHandler(repository coursesRepository) RemoveFromWaitlistHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RemoveFromWaitlistHandler{coursesRepository: repository}
+}
+
+func (h RemoveFromWaitlistHandler) Handle(ctx context.Context, cmd app.RemoveFromWaitlistCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, removeFromWaitlist(cmd))
+
+	return errors.Wrapf(
+		err,
+		"removing student #%s from waitlist of course #%s by academic #%s",
+		cmd.StudentID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func removeFromWaitlist(cmd app.RemoveFromWaitlistCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RemoveFromWaitlist(cmd.Academic, cmd.StudentID); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,85 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRemoveFromWaitlistHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RemoveFromWaitlistCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "teacher_removes_student_from_waitlist",
+			Command: app.RemoveFromWaitlistCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				StudentID: "waitlisted-id",
+			},
+		},
+		{
+			Name: "student_leaves_waitlist",
+			Command: app.RemoveFromWaitlistCommand{
+				Academic:  course.MustNewAcademic("waitlisted-id", course.StudentType),
+				CourseID:  "course-id",
+				StudentID: "waitlisted-id",
+			},
+		},
+		{
+			Name: "dont_remove_from_waitlist_when_student_isnt_waitlisted",
+			Command: app.RemoveFromWaitlistCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				StudentID: "student-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchWaitlisted)
+			},
+		},
+		{
+			Name: "dont_remove_from_waitlist_when_academic_is_another_student",
+			Command: app.RemoveFromWaitlistCommand{
+				Academic:  course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:  "course-id",
+				StudentID: "waitlisted-id",
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			coursesRepository := mock.NewCoursesRepository(newFullCourseWithWaitlist(t))
+			handler := command.NewRemoveFromWaitlistHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Empty(t, updatedCourse.Waitlist())
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RemoveGroupHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRemoveGroupHandler(repository coursesRepository) RemoveGroupHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RemoveGroupHandler{coursesRepository: repository}
+}
+
+func (h RemoveGroupHandler) Handle(ctx context.Context, cmd app.RemoveGroupCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, removeGroup(cmd))
+
+	return errors.Wrapf(
+		err,
+		"removing group #%s from course #%s by academic #%s",
+		cmd.GroupID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func removeGroup(cmd app.RemoveGroupCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RemoveGroup(cmd.Academic, cmd.GroupID, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
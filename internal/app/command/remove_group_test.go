@@ -0,0 +1,109 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRemoveGroupHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	testCases := []struct {
+		Name                    string
+		Command                 app.RemoveGroupCommand
+		PrepareCourseRepository func(crs *course.Course) *mock.CoursesRepository
+		IsErr                   func(err error) bool
+	}{
+		{
+			Name: "remove_group",
+			Command: app.RemoveGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCourseRepository: addCourse,
+		},
+		{
+			Name: "dont_remove_group_when_course_doesnt_exist",
+			Command: app.RemoveGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCourseRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_remove_group_when_academic_cant_edit_course",
+			Command: app.RemoveGroupCommand{
+				Academic: course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCourseRepository: addCourse,
+			IsErr:                   course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_remove_group_when_course_has_no_such_group",
+			Command: app.RemoveGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "other-group-id",
+			},
+			PrepareCourseRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchGroup)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Physics",
+				Period:   course.MustNewPeriod(2029, 2030, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			require.NoError(t, crs.AddGroup(creator, "group-id", []string{"student1-id"}, time.Now()))
+			coursesRepository := c.PrepareCourseRepository(crs)
+			handler := command.NewRemoveGroupHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, []string{"student-id"}, updatedCourse.Students())
+			require.Empty(t, updatedCourse.Groups())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -33,7 +34,7 @@ func (h RemoveStudentHandler) Handle(ctx context.Context, cmd app.RemoveStudentC
 
 func removeStudent(cmd app.RemoveStudentCommand) UpdateFunction {
 	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
-		if err := crs.RemoveStudent(cmd.Academic, cmd.StudentID); err != nil {
+		if err := crs.RemoveStudent(cmd.Academic, cmd.StudentID, cmd.Reason, time.Now()); err != nil {
 			return nil, err
 		}
 
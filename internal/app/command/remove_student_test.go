@@ -31,6 +31,7 @@ func TestRemoveStudentHandler_Handle(t *testing.T) {
 				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
 				CourseID:  "course-id",
 				StudentID: "student-id",
+				Reason:    "moved to another university",
 			},
 			PrepareCourseRepository: addCourse,
 		},
@@ -85,6 +86,11 @@ func TestRemoveStudentHandler_Handle(t *testing.T) {
 			}
 			require.NoError(t, err)
 			require.NotContains(t, crs.Students(), "student-id")
+
+			enrollment, ok := crs.StudentEnrollment("student-id")
+			require.True(t, ok)
+			require.Equal(t, course.WithdrawnStudent, enrollment.Status())
+			require.Equal(t, c.Command.Reason, enrollment.Reason())
 		})
 	}
 }
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RemoveTeamHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRemoveTeamHandler(repository coursesRepository) RemoveTeamHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RemoveTeamHandler{coursesRepository: repository}
+}
+
+func (h RemoveTeamHandler) Handle(ctx context.Context, cmd app.RemoveTeamCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, removeTeam(cmd))
+
+	return errors.Wrapf(
+		err,
+		"removing team #%s from course #%s by academic #%s",
+		cmd.TeamID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func removeTeam(cmd app.RemoveTeamCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RemoveTeam(cmd.Academic, cmd.TeamID); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
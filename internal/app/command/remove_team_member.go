@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RemoveTeamMemberHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRemoveTeamMemberHandler(repository coursesRepository) RemoveTeamMemberHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RemoveTeamMemberHandler{coursesRepository: repository}
+}
+
+func (h RemoveTeamMemberHandler) Handle(ctx context.Context, cmd app.RemoveTeamMemberCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, removeTeamMember(cmd))
+
+	return errors.Wrapf(
+		err,
+		"removing student #%s from team #%s of course #%s by academic #%s",
+		cmd.StudentID, cmd.TeamID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func removeTeamMember(cmd app.RemoveTeamMemberCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RemoveTeamMember(cmd.Academic, cmd.TeamID, cmd.StudentID); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,99 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRemoveTeamMemberHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RemoveTeamMemberCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "remove_team_member",
+			Command: app.RemoveTeamMemberCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				TeamID:    "team-id",
+				StudentID: "student2-id",
+			},
+		},
+		{
+			Name: "leave_team_by_student",
+			Command: app.RemoveTeamMemberCommand{
+				Academic:  course.MustNewAcademic("student2-id", course.StudentType),
+				CourseID:  "course-id",
+				TeamID:    "team-id",
+				StudentID: "student2-id",
+			},
+		},
+		{
+			Name: "dont_remove_not_team_member",
+			Command: app.RemoveTeamMemberCommand{
+				Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:  "course-id",
+				TeamID:    "team-id",
+				StudentID: "student3-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTeamHasNoSuchMember)
+			},
+		},
+		{
+			Name: "dont_remove_another_student_by_student",
+			Command: app.RemoveTeamMemberCommand{
+				Academic:  course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID:  "course-id",
+				TeamID:    "team-id",
+				StudentID: "student2-id",
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Chemistry",
+				Period:   course.MustNewPeriod(2032, 2033, course.FirstSemester),
+				Students: []string{"student1-id", "student2-id", "student3-id"},
+			})
+			require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(true, 0)))
+			require.NoError(t, crs.CreateTeam(creator, "team-id", "Radon", "student1-id", "student2-id"))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewRemoveTeamMemberHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, []string{"student1-id"}, updatedCourse.Teams()[0].Members())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RenameTeamHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRenameTeamHandler(repository coursesRepository) RenameTeamHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RenameTeamHandler{coursesRepository: repository}
+}
+
+func (h RenameTeamHandler) Handle(ctx context.Context, cmd app.RenameTeamCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, renameTeam(cmd))
+
+	return errors.Wrapf(
+		err,
+		"renaming team #%s of course #%s by academic #%s",
+		cmd.TeamID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func renameTeam(cmd app.RenameTeamCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RenameTeam(cmd.Academic, cmd.TeamID, cmd.Name); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,91 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRenameTeamHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RenameTeamCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "rename_team",
+			Command: app.RenameTeamCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				TeamID:   "team-id",
+				Name:     "Neon",
+			},
+		},
+		{
+			Name: "dont_rename_team_when_team_doesnt_exist",
+			Command: app.RenameTeamCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				TeamID:   "other-team-id",
+				Name:     "Neon",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchTeam)
+			},
+		},
+		{
+			Name: "dont_rename_team_when_self_formation_disabled",
+			Command: app.RenameTeamCommand{
+				Academic: course.MustNewAcademic("student-id", course.StudentType),
+				CourseID: "course-id",
+				TeamID:   "team-id",
+				Name:     "Neon",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTeamSelfFormationDisabled)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Chemistry",
+				Period:   course.MustNewPeriod(2032, 2033, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			require.NoError(t, crs.CreateTeam(creator, "team-id", "Argon", "student-id"))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewRenameTeamHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.Name, updatedCourse.Teams()[0].Name())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type ReorderAuxiliaryMaterialsHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewReorderAuxiliaryMaterialsHandler(repository coursesRepository) ReorderAuxiliaryMaterialsHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return ReorderAuxiliaryMaterialsHandler{coursesRepository: repository}
+}
+
+func (h ReorderAuxiliaryMaterialsHandler) Handle(ctx context.Context, cmd app.ReorderAuxiliaryMaterialsCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, reorderAuxiliaryMaterials(cmd))
+
+	return errors.Wrapf(
+		err,
+		"reordering auxiliary materials of course #%s by academic #%s",
+		cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func reorderAuxiliaryMaterials(cmd app.ReorderAuxiliaryMaterialsCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.ReorderAuxiliaryMaterials(cmd.Academic, cmd.MaterialIDs); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
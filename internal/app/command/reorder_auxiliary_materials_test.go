@@ -0,0 +1,101 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestReorderAuxiliaryMaterialsHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.ReorderAuxiliaryMaterialsCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "reorder_auxiliary_materials",
+			Command: app.ReorderAuxiliaryMaterialsCommand{
+				Academic:    course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:    "course-id",
+				MaterialIDs: []string{"second-id", "first-id"},
+			},
+		},
+		{
+			Name: "dont_reorder_when_order_is_invalid",
+			Command: app.ReorderAuxiliaryMaterialsCommand{
+				Academic:    course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:    "course-id",
+				MaterialIDs: []string{"second-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrInvalidMaterialsOrder)
+			},
+		},
+		{
+			Name: "dont_reorder_when_academic_cant_edit_course",
+			Command: app.ReorderAuxiliaryMaterialsCommand{
+				Academic:    course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:    "course-id",
+				MaterialIDs: []string{"second-id", "first-id"},
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_reorder_when_course_doesnt_exist",
+			Command: app.ReorderAuxiliaryMaterialsCommand{
+				Academic:    course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:    "other-course-id",
+				MaterialIDs: []string{"second-id", "first-id"},
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  creator,
+				Title:    "Operating systems",
+				Period:   course.MustNewPeriod(2043, 2044, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			first := course.MustNewAuxiliaryMaterial("first-id", "https://example.com/1", course.VideoResource)
+			second := course.MustNewAuxiliaryMaterial("second-id", "https://example.com/2", course.VideoResource)
+			require.NoError(t, crs.AttachAuxiliaryMaterial(creator, first))
+			require.NoError(t, crs.AttachAuxiliaryMaterial(creator, second))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewReorderAuxiliaryMaterialsHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			updatedCourse, getErr := coursesRepository.GetCourse(context.Background(), "course-id")
+			require.NoError(t, getErr)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, []course.AuxiliaryMaterial{first, second}, updatedCourse.AuxiliaryMaterials())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []course.AuxiliaryMaterial{second, first}, updatedCourse.AuxiliaryMaterials())
+		})
+	}
+}
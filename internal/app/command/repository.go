@@ -7,17 +7,31 @@ import (
 )
 
 type coursesRepository interface {
-	// AddCourse returns app.ErrDatabaseProblems if repository can't
-	// add course due to database problems.
+	// AddCourse adds course and appends its new task versions to version history,
+	// returns app.ErrDatabaseProblems if repository can't add course due to database problems.
 	AddCourse(ctx context.Context, crs *course.Course) error
 
 	// GetCourse returns: app.ErrCourseDoesntExist if repository can't find course,
 	// app.ErrDatabaseProblems if repository can't get course due to database problems.
 	GetCourse(ctx context.Context, courseID string) (*course.Course, error)
 
-	// UpdateCourse returns: app.ErrCourseDoesntExist if repository can't find course,
+	// UpdateCourse saves updated course and appends its new task versions to version history,
+	// returns: app.ErrCourseDoesntExist if repository can't find course,
 	// app.ErrDatabaseProblems if repository can't update course due to database problems.
 	UpdateCourse(ctx context.Context, courseID string, updateFn UpdateFunction) error
+
+	// GetTaskVersion returns: course.ErrTaskHasNoSuchVersion if version history of course task
+	// has no such version, app.ErrDatabaseProblems if repository can't get version due to database problems.
+	GetTaskVersion(ctx context.Context, courseID string, taskNumber, version int) (course.TaskVersion, error)
+
+	// FindInvitationCourseID returns: app.ErrInvitationDoesntExist if no course has invitation,
+	// app.ErrDatabaseProblems if repository can't find course due to database problems.
+	FindInvitationCourseID(ctx context.Context, code string) (string, error)
+
+	// FindAcademicCourseIDs returns IDs of courses where academic is creator, collaborator,
+	// student, waitlisted or has enrollment request, returns app.ErrDatabaseProblems
+	// if repository can't find courses due to database problems.
+	FindAcademicCourseIDs(ctx context.Context, academicID string) ([]string, error)
 }
 
 type UpdateFunction func(ctx context.Context, crs *course.Course) (*course.Course, error)
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RequestEnrollmentHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRequestEnrollmentHandler(repository coursesRepository) RequestEnrollmentHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RequestEnrollmentHandler{coursesRepository: repository}
+}
+
+func (h RequestEnrollmentHandler) Handle(
+	ctx context.Context,
+	cmd app.RequestEnrollmentCommand,
+) (requestID string, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"requesting enrollment to course #%s by academic #%s",
+			cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	requestID = uuid.NewString()
+
+	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, requestEnrollment(cmd, requestID))
+	if err != nil {
+		return "", err
+	}
+
+	return requestID, nil
+}
+
+func requestEnrollment(cmd app.RequestEnrollmentCommand, requestID string) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RequestEnrollment(cmd.Academic, requestID, cmd.Message, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
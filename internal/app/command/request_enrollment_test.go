@@ -0,0 +1,100 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRequestEnrollmentHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RequestEnrollmentCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "request_enrollment",
+			Command: app.RequestEnrollmentCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID: "course-id",
+				Message:  "I'd like to attend elective course",
+			},
+		},
+		{
+			Name: "dont_request_enrollment_when_course_doesnt_exist",
+			Command: app.RequestEnrollmentCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID: "other-course-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_request_enrollment_when_academic_is_teacher",
+			Command: app.RequestEnrollmentCommand{
+				Academic: course.MustNewAcademic("teacher-id", course.TeacherType),
+				CourseID: "course-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrNotStudentCantRequestEnrollment)
+			},
+		},
+		{
+			Name: "dont_request_enrollment_when_student_already_in_course",
+			Command: app.RequestEnrollmentCommand{
+				Academic: course.MustNewAcademic("student-id", course.StudentType),
+				CourseID: "course-id",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrAcademicAlreadyInCourse)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Elective physics",
+				Period:   course.MustNewPeriod(2029, 2030, course.FirstSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewRequestEnrollmentHandler(coursesRepository)
+
+			requestID, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+
+			requests := updatedCourse.EnrollmentRequests()
+			require.Len(t, requests, 1)
+			require.Equal(t, requestID, requests[0].ID())
+			require.Equal(t, c.Command.Academic.ID(), requests[0].StudentID())
+			require.Equal(t, c.Command.Message, requests[0].Message())
+			require.Equal(t, course.PendingRequest, requests[0].Status())
+		})
+	}
+}
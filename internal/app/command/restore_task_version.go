@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RestoreTaskVersionHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRestoreTaskVersionHandler(repository coursesRepository) RestoreTaskVersionHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RestoreTaskVersionHandler{coursesRepository: repository}
+}
+
+func (h RestoreTaskVersionHandler) Handle(ctx context.Context, cmd app.RestoreTaskVersionCommand) (err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"restoring version %d of task No %d of course #%s by academic #%s",
+			cmd.Version, cmd.TaskNumber, cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	return h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.restoreTaskVersion(cmd))
+}
+
+func (h RestoreTaskVersionHandler) restoreTaskVersion(cmd app.RestoreTaskVersionCommand) UpdateFunction {
+	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.CanAcademicEditTasks(cmd.Academic); err != nil {
+			return nil, err
+		}
+
+		if _, err := crs.Task(cmd.TaskNumber); err != nil {
+			return nil, err
+		}
+
+		version, err := h.coursesRepository.GetTaskVersion(ctx, crs.ID(), cmd.TaskNumber, cmd.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := crs.RestoreTaskVersion(cmd.Academic, cmd.TaskNumber, version, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRestoreTaskVersionHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	testCases := []struct {
+		Name                     string
+		Command                  app.RestoreTaskVersionCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "restore_task_version",
+			Command: app.RestoreTaskVersionCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				Version:    1,
+			},
+			PrepareCoursesRepository: addCourse,
+		},
+		{
+			Name: "dont_restore_when_task_has_no_such_version",
+			Command: app.RestoreTaskVersionCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				Version:    10,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskHasNoSuchVersion)
+			},
+		},
+		{
+			Name: "dont_restore_when_academic_cant_edit_course",
+			Command: app.RestoreTaskVersionCommand{
+				Academic:   course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				Version:    1,
+			},
+			PrepareCoursesRepository: addCourse,
+			IsErr:                    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_restore_when_course_doesnt_exist",
+			Command: app.RestoreTaskVersionCommand{
+				Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:   "course-id",
+				TaskNumber: 1,
+				Version:    1,
+			},
+			PrepareCoursesRepository: func(_ *course.Course) *mock.CoursesRepository {
+				return mock.NewCoursesRepository()
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: creator,
+				Title:   "Databases",
+				Period:  course.MustNewPeriod(2043, 2044, course.SecondSemester),
+			})
+			_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{Title: "Normal forms"}, time.Now())
+			require.NoError(t, err)
+			require.NoError(t, crs.RenameTask(creator, 1, "Denormalization", time.Now()))
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			handler := command.NewRestoreTaskVersionHandler(coursesRepository)
+
+			err = handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			task, err := crs.Task(1)
+			require.NoError(t, err)
+			require.Equal(t, "Normal forms", task.Title())
+			require.Equal(t, 3, task.Version())
+			version, err := coursesRepository.GetTaskVersion(context.Background(), "course-id", 1, 3)
+			require.NoError(t, err)
+			require.Equal(t, creator.ID(), version.AuthorID())
+		})
+	}
+}
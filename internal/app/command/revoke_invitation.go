@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type RevokeInvitationHandler struct {
+	coursesRepository coursesRepository
+}
+
+func NewRevokeInvitationHandler(repository coursesRepository) RevokeInvitationHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	return RevokeInvitationHandler{coursesRepository: repository}
+}
+
+func (h RevokeInvitationHandler) Handle(ctx context.Context, cmd app.RevokeInvitationCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, revokeInvitation(cmd))
+
+	return errors.Wrapf(
+		err,
+		"revoking invitation of course #%s by academic #%s",
+		cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func revokeInvitation(cmd app.RevokeInvitationCommand) UpdateFunction {
+	return func(_ context.Context, crs *course.Course) (*course.Course, error) {
+		if err := crs.RevokeInvitation(cmd.Academic, cmd.Code); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
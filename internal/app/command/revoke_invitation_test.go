@@ -0,0 +1,102 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestRevokeInvitationHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.RevokeInvitationCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "revoke_invitation",
+			Command: app.RevokeInvitationCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Code:     "code",
+			},
+		},
+		{
+			Name: "dont_revoke_invitation_when_course_doesnt_exist",
+			Command: app.RevokeInvitationCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+				Code:     "code",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+		{
+			Name: "dont_revoke_invitation_when_academic_cant_edit_course",
+			Command: app.RevokeInvitationCommand{
+				Academic: course.MustNewAcademic("other-teacher-id", course.TeacherType),
+				CourseID: "course-id",
+				Code:     "code",
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_revoke_invitation_when_course_has_no_such_invitation",
+			Command: app.RevokeInvitationCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Code:     "other-code",
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchInvitation)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: creator,
+				Title:   "Physics",
+				Period:  course.MustNewPeriod(2029, 2030, course.FirstSemester),
+			})
+			now := time.Now()
+			require.NoError(t, crs.AddInvitation(
+				creator,
+				course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 30),
+				now,
+			))
+			coursesRepository := mock.NewCoursesRepository(crs)
+			handler := command.NewRevokeInvitationHandler(coursesRepository)
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.Empty(t, updatedCourse.Invitations())
+		})
+	}
+}
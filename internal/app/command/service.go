@@ -1,6 +1,13 @@
 package command
 
-import "context"
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
 
 type academicsService interface {
 	// TeacherExists should return app.ErrTeacherDoesntExist
@@ -11,7 +18,67 @@ type academicsService interface {
 	// when academics service can't find student with such id.
 	StudentExists(ctx context.Context, studentID string) error
 
+	// MissingTeachers should return IDs of given teachers that
+	// academics service can't find, in order they are given.
+	MissingTeachers(ctx context.Context, teacherIDs []string) ([]string, error)
+
+	// MissingStudents should return IDs of given students that
+	// academics service can't find, in order they are given.
+	MissingStudents(ctx context.Context, studentIDs []string) ([]string, error)
+
+	// StudentByEmail should return ID of student with email or
+	// app.ErrStudentDoesntExist when academics service can't find student.
+	StudentByEmail(ctx context.Context, email string) (string, error)
+
 	// GroupExists should return app.ErrGroupDoesntExist
 	// when academics service can't find group with such id.
 	GroupExists(ctx context.Context, groupID string) error
+
+	// GroupStudents should return IDs of group students or
+	// app.ErrGroupDoesntExist when academics service can't find group.
+	GroupStudents(ctx context.Context, groupID string) ([]string, error)
+}
+
+// teachersExist checks single teacher with TeacherExists and
+// several teachers with one MissingTeachers call.
+func teachersExist(ctx context.Context, service academicsService, teacherIDs []string) error {
+	if len(teacherIDs) == 1 {
+		return service.TeacherExists(ctx, teacherIDs[0])
+	}
+
+	missingIDs, err := service.MissingTeachers(ctx, teacherIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(missingIDs) > 0 {
+		return errors.Wrapf(app.ErrTeacherDoesntExist, "teachers %s", strings.Join(missingIDs, ", "))
+	}
+
+	return nil
+}
+
+// studentsExist checks single student with StudentExists and
+// several students with one MissingStudents call.
+func studentsExist(ctx context.Context, service academicsService, studentIDs []string) error {
+	if len(studentIDs) == 1 {
+		return service.StudentExists(ctx, studentIDs[0])
+	}
+
+	missingIDs, err := service.MissingStudents(ctx, studentIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(missingIDs) > 0 {
+		return errors.Wrapf(app.ErrStudentDoesntExist, "students %s", strings.Join(missingIDs, ", "))
+	}
+
+	return nil
+}
+
+// academicsCache forgets cached answers of academics service.
+type academicsCache interface {
+	// InvalidateAcademic should forget everything cached about academic.
+	InvalidateAcademic(academicID string)
 }
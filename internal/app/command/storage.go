@@ -0,0 +1,29 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type testDataStorage interface {
+	// SaveTestData saves test data of course and returns its ID or app.ErrDatabaseProblems
+	// if storage can't save test data due to database problems.
+	SaveTestData(ctx context.Context, courseID string, data io.Reader) (string, error)
+
+	// TestDataExists returns: app.ErrTestDataDoesntExist if storage can't find test data of course,
+	// app.ErrDatabaseProblems if storage can't check test data due to database problems.
+	TestDataExists(ctx context.Context, courseID, testDataID string) error
+
+	// ShareTestData makes test data of one course also test data of another one,
+	// test data that isn't found in origin course is skipped. Returns
+	// app.ErrDatabaseProblems if storage can't share test data due to database problems.
+	ShareTestData(ctx context.Context, fromCourseID, toCourseID string, testDataIDs []string) error
+}
+
+type blobStorage interface {
+	// SaveBlob saves content and returns its blob, same content may be saved once
+	// and share key. Returns app.ErrBlobStorageProblems if storage can't save content.
+	SaveBlob(ctx context.Context, data io.Reader) (app.Blob, error)
+}
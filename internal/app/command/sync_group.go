@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type SyncGroupHandler struct {
+	coursesRepository coursesRepository
+	academicsService  academicsService
+}
+
+func NewSyncGroupHandler(repository coursesRepository, service academicsService) SyncGroupHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	return SyncGroupHandler{
+		coursesRepository: repository,
+		academicsService:  service,
+	}
+}
+
+func (h SyncGroupHandler) Handle(ctx context.Context, cmd app.SyncGroupCommand) error {
+	err := h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, h.syncGroup(cmd))
+
+	return errors.Wrapf(
+		err,
+		"syncing group #%s of course #%s by academic #%s",
+		cmd.GroupID, cmd.CourseID, cmd.Academic.ID(),
+	)
+}
+
+func (h SyncGroupHandler) syncGroup(cmd app.SyncGroupCommand) UpdateFunction {
+	return func(ctx context.Context, crs *course.Course) (*course.Course, error) {
+		studentIDs, err := h.academicsService.GroupStudents(ctx, cmd.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := crs.SyncGroup(cmd.Academic, cmd.GroupID, studentIDs, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return crs, nil
+	}
+}
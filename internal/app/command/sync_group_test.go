@@ -0,0 +1,118 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestSyncGroupHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	addCourse := func(crs *course.Course) *mock.CoursesRepository {
+		return mock.NewCoursesRepository(crs)
+	}
+	changeGroup := func() *mock.AcademicsService {
+		return mock.NewAcademicsService(nil, nil, nil).
+			WithGroupStudents("group-id", "student2-id", "student3-id")
+	}
+	testCases := []struct {
+		Name                     string
+		Command                  app.SyncGroupCommand
+		PrepareCoursesRepository func(crs *course.Course) *mock.CoursesRepository
+		PrepareAcademicsService  func() *mock.AcademicsService
+		IsErr                    func(err error) bool
+	}{
+		{
+			Name: "sync_group",
+			Command: app.SyncGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  changeGroup,
+		},
+		{
+			Name: "dont_sync_when_group_doesnt_exist",
+			Command: app.SyncGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService: func() *mock.AcademicsService {
+				return mock.NewAcademicsService(nil, nil, nil)
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrGroupDoesntExist)
+			},
+		},
+		{
+			Name: "dont_sync_when_course_has_no_such_group",
+			Command: app.SyncGroupCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				GroupID:  "other-group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService: func() *mock.AcademicsService {
+				return mock.NewAcademicsService(nil, nil, nil).WithGroupStudents("other-group-id")
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchGroup)
+			},
+		},
+		{
+			Name: "dont_sync_when_academic_cant_edit_course",
+			Command: app.SyncGroupCommand{
+				Academic: course.MustNewAcademic("student1-id", course.StudentType),
+				CourseID: "course-id",
+				GroupID:  "group-id",
+			},
+			PrepareCoursesRepository: addCourse,
+			PrepareAcademicsService:  changeGroup,
+			IsErr:                    course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: creator,
+				Title:   "Algorithms",
+				Period:  course.MustNewPeriod(2029, 2030, course.FirstSemester),
+			})
+			require.NoError(t, crs.AddGroup(creator, "group-id", []string{"student1-id", "student2-id"}, time.Now()))
+			coursesRepository := c.PrepareCoursesRepository(crs)
+			handler := command.NewSyncGroupHandler(coursesRepository, c.PrepareAcademicsService())
+
+			err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), c.Command.CourseID)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"student2-id", "student3-id"}, updatedCourse.Students())
+		})
+	}
+}
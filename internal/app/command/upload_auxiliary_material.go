@@ -0,0 +1,143 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+// sniffLen is number of bytes used by http.DetectContentType.
+const sniffLen = 512
+
+// forbiddenMaterialFileTypes are active content types,
+// browsers could execute them on behalf of the service.
+var forbiddenMaterialFileTypes = []string{"text/html", "text/xml"}
+
+type UploadAuxiliaryMaterialHandler struct {
+	coursesRepository coursesRepository
+	blobStorage       blobStorage
+	fileMaxSize       int64
+}
+
+func NewUploadAuxiliaryMaterialHandler(
+	repository coursesRepository,
+	storage blobStorage,
+	fileMaxSize int64,
+) UploadAuxiliaryMaterialHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if storage == nil {
+		panic("blobStorage is nil")
+	}
+
+	if fileMaxSize <= 0 {
+		panic("fileMaxSize is out of range")
+	}
+
+	return UploadAuxiliaryMaterialHandler{
+		coursesRepository: repository,
+		blobStorage:       storage,
+		fileMaxSize:       fileMaxSize,
+	}
+}
+
+func (h UploadAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	cmd app.UploadAuxiliaryMaterialCommand,
+) (materialID string, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"uploading auxiliary material file to course #%s by academic #%s",
+			cmd.CourseID, cmd.Academic.ID(),
+		)
+	}()
+
+	crs, err := h.coursesRepository.GetCourse(ctx, cmd.CourseID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := crs.CanAcademicEditTasks(cmd.Academic); err != nil {
+		return "", err
+	}
+
+	if cmd.TaskNumber != 0 {
+		if _, err := crs.Task(cmd.TaskNumber); err != nil {
+			return "", err
+		}
+	}
+
+	head, contentType, err := sniffContentType(cmd.Data)
+	if err != nil {
+		return "", err
+	}
+
+	data := &sizeLimitedReader{
+		reader:   io.MultiReader(bytes.NewReader(head), cmd.Data),
+		left:     h.fileMaxSize,
+		tooLarge: app.ErrMaterialFileTooLarge,
+	}
+
+	blob, err := h.blobStorage.SaveBlob(ctx, data)
+	if data.exceeded {
+		return "", app.ErrMaterialFileTooLarge
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	file, err := course.NewMaterialFile(blob.Key, cmd.FileName, contentType, blob.Size)
+	if err != nil {
+		return "", err
+	}
+
+	material, err := course.NewFileAuxiliaryMaterial(uuid.NewString(), file, cmd.ResourceType)
+	if err != nil {
+		return "", err
+	}
+
+	material = material.ForTask(cmd.TaskNumber).WithRelease(cmd.ReleaseTime, cmd.Hidden)
+
+	err = h.coursesRepository.UpdateCourse(ctx, cmd.CourseID, attachAuxiliaryMaterial(cmd.Academic, material))
+	if err != nil {
+		return "", err
+	}
+
+	return material.ID(), nil
+}
+
+// sniffContentType reads beginning of data and detects its content type
+// instead of trusting client, read beginning is returned to be saved.
+func sniffContentType(data io.Reader) ([]byte, string, error) {
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(data, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, "", err
+	}
+
+	if n == 0 {
+		return nil, "", course.ErrEmptyMaterialFileContent
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	for _, forbidden := range forbiddenMaterialFileTypes {
+		if strings.HasPrefix(contentType, forbidden) {
+			return nil, "", app.ErrMaterialFileTypeNotAllowed
+		}
+	}
+
+	return head[:n], contentType, nil
+}
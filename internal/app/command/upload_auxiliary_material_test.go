@@ -0,0 +1,162 @@
+package command_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestUploadAuxiliaryMaterialHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fileMaxSize = 1 << 20
+		pdfContent  = "%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\n"
+	)
+
+	testCases := []struct {
+		Name                string
+		Command             app.UploadAuxiliaryMaterialCommand
+		ExpectedContentType string
+		IsErr               func(err error) bool
+	}{
+		{
+			Name: "upload_pdf",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				ResourceType: course.TrainingManualResource,
+				FileName:     "manual.pdf",
+				Data:         strings.NewReader(pdfContent),
+			},
+			ExpectedContentType: "application/pdf",
+		},
+		{
+			Name: "upload_plain_text_video_notes",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				ResourceType: course.VideoResource,
+				FileName:     "notes.txt",
+				Data:         strings.NewReader("Lecture 1 notes"),
+			},
+			ExpectedContentType: "text/plain; charset=utf-8",
+		},
+		{
+			Name: "dont_upload_html",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				ResourceType: course.OtherResource,
+				FileName:     "slides.pdf",
+				Data:         strings.NewReader("<!DOCTYPE html><script>alert(1)</script>"),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrMaterialFileTypeNotAllowed)
+			},
+		},
+		{
+			Name: "dont_upload_too_large_file",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				ResourceType: course.VideoResource,
+				FileName:     "lecture.mp4",
+				Data:         io.LimitReader(zeroReader{}, fileMaxSize+1),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrMaterialFileTooLarge)
+			},
+		},
+		{
+			Name: "dont_upload_empty_file",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "course-id",
+				ResourceType: course.OtherResource,
+				FileName:     "empty.txt",
+				Data:         strings.NewReader(""),
+			},
+			IsErr: course.IsInvalidAuxiliaryMaterialError,
+		},
+		{
+			Name: "dont_upload_when_academic_cant_edit_course",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("student-id", course.StudentType),
+				CourseID:     "course-id",
+				ResourceType: course.TrainingManualResource,
+				FileName:     "manual.pdf",
+				Data:         strings.NewReader(pdfContent),
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_upload_when_course_doesnt_exist",
+			Command: app.UploadAuxiliaryMaterialCommand{
+				Academic:     course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID:     "other-course-id",
+				ResourceType: course.TrainingManualResource,
+				FileName:     "manual.pdf",
+				Data:         strings.NewReader(pdfContent),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:       "course-id",
+				Creator:  course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:    "Computer networks",
+				Period:   course.MustNewPeriod(2043, 2044, course.SecondSemester),
+				Students: []string{"student-id"},
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			blobStorage := mock.NewBlobStorage()
+			handler := command.NewUploadAuxiliaryMaterialHandler(coursesRepository, blobStorage, fileMaxSize)
+
+			materialID, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Empty(t, materialID)
+				require.Equal(t, 0, blobStorage.BlobsNumber())
+
+				return
+			}
+			require.NoError(t, err)
+			updatedCourse, err := coursesRepository.GetCourse(context.Background(), "course-id")
+			require.NoError(t, err)
+			material, err := updatedCourse.AuxiliaryMaterial(materialID)
+			require.NoError(t, err)
+			require.Equal(t, c.Command.ResourceType, material.ResourceType())
+
+			file, ok := material.File()
+			require.True(t, ok)
+			require.Equal(t, c.Command.FileName, file.Name())
+			require.Equal(t, c.ExpectedContentType, file.ContentType())
+
+			data, err := blobStorage.OpenBlob(context.Background(), file.Key())
+			require.NoError(t, err)
+			content, err := io.ReadAll(data)
+			require.NoError(t, err)
+			require.Equal(t, file.Size(), int64(len(content)))
+		})
+	}
+}
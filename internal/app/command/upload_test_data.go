@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+const testDataMaxSize = 64 << 20
+
+type UploadTestDataHandler struct {
+	coursesRepository coursesRepository
+	testDataStorage   testDataStorage
+}
+
+func NewUploadTestDataHandler(repository coursesRepository, storage testDataStorage) UploadTestDataHandler {
+	if repository == nil {
+		panic("coursesRepository is nil")
+	}
+
+	if storage == nil {
+		panic("testDataStorage is nil")
+	}
+
+	return UploadTestDataHandler{
+		coursesRepository: repository,
+		testDataStorage:   storage,
+	}
+}
+
+func (h UploadTestDataHandler) Handle(ctx context.Context, cmd app.UploadTestDataCommand) (testDataID string, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "uploading test data to course #%s by academic #%s", cmd.CourseID, cmd.Academic.ID())
+	}()
+
+	crs, err := h.coursesRepository.GetCourse(ctx, cmd.CourseID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := crs.CanAcademicEditTasks(cmd.Academic); err != nil {
+		return "", err
+	}
+
+	data := &sizeLimitedReader{reader: cmd.Data, left: testDataMaxSize, tooLarge: app.ErrTestDataTooLarge}
+
+	testDataID, err = h.testDataStorage.SaveTestData(ctx, cmd.CourseID, data)
+	if data.exceeded {
+		return "", app.ErrTestDataTooLarge
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return testDataID, nil
+}
+
+// sizeLimitedReader fails reading with tooLarge error
+// when underlying reader has more than left bytes.
+type sizeLimitedReader struct {
+	reader   io.Reader
+	left     int64
+	tooLarge error
+	exceeded bool
+}
+
+func (r *sizeLimitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > r.left+1 {
+		p = p[:r.left+1]
+	}
+
+	n, err := r.reader.Read(p)
+	if int64(n) > r.left {
+		r.exceeded = true
+
+		return 0, r.tooLarge
+	}
+
+	r.left -= int64(n)
+
+	return n, err
+}
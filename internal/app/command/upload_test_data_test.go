@@ -0,0 +1,106 @@
+package command_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestUploadTestDataHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Command app.UploadTestDataCommand
+		IsErr   func(err error) bool
+	}{
+		{
+			Name: "upload_test_data",
+			Command: app.UploadTestDataCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Data:     strings.NewReader(strings.Repeat("1 2 3\n", 1000)),
+			},
+		},
+		{
+			Name: "dont_upload_when_test_data_too_large",
+			Command: app.UploadTestDataCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "course-id",
+				Data:     io.LimitReader(zeroReader{}, 64<<20+1),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrTestDataTooLarge)
+			},
+		},
+		{
+			Name: "dont_upload_when_academic_cant_edit_course",
+			Command: app.UploadTestDataCommand{
+				Academic: course.MustNewAcademic("student-id", course.StudentType),
+				CourseID: "course-id",
+				Data:     strings.NewReader("1 2 3"),
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name: "dont_upload_when_course_doesnt_exist",
+			Command: app.UploadTestDataCommand{
+				Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+				CourseID: "other-course-id",
+				Data:     strings.NewReader("1 2 3"),
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, app.ErrCourseDoesntExist)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := course.MustNewCourse(course.CreationParams{
+				ID:      "course-id",
+				Creator: course.MustNewAcademic("creator-id", course.TeacherType),
+				Title:   "Algorithms and data structures",
+				Period:  course.MustNewPeriod(2043, 2044, course.FirstSemester),
+			})
+			coursesRepository := mock.NewCoursesRepository(crs)
+			testDataStorage := mock.NewTestDataStorage()
+			handler := command.NewUploadTestDataHandler(coursesRepository, testDataStorage)
+
+			testDataID, err := handler.Handle(context.Background(), c.Command)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Empty(t, testDataID)
+				require.Equal(t, 0, testDataStorage.TestDataNumber())
+
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, testDataStorage.TestDataExists(context.Background(), c.Command.CourseID, testDataID))
+		})
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	return len(p), nil
+}
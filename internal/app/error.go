@@ -7,12 +7,29 @@ import (
 )
 
 var (
-	ErrCourseDoesntExist  = errors.New("course doesn't exist")
-	ErrTeacherDoesntExist = errors.New("teacher doesn't exist")
-	ErrStudentDoesntExist = errors.New("student doesn't exist")
-	ErrGroupDoesntExist   = errors.New("group doesn't exist")
-	ErrTaskDoesntExist    = errors.New("course task doesn't exist")
-	ErrDatabaseProblems   = errors.New("database problems")
+	ErrCourseDoesntExist            = errors.New("course doesn't exist")
+	ErrTeacherDoesntExist           = errors.New("teacher doesn't exist")
+	ErrStudentDoesntExist           = errors.New("student doesn't exist")
+	ErrGroupDoesntExist             = errors.New("group doesn't exist")
+	ErrTaskDoesntExist              = errors.New("course task doesn't exist")
+	ErrTaskVersionDoesntExist       = errors.New("task version doesn't exist")
+	ErrAuxiliaryMaterialDoesntExist = errors.New("auxiliary material doesn't exist")
+	ErrInvitationDoesntExist        = errors.New("invitation doesn't exist")
+	ErrDatabaseProblems             = errors.New("database problems")
+	ErrAcademicsServiceProblems     = errors.New("academics service problems")
+
+	ErrTestDataDoesntExist = errors.New("stored test data doesn't exist")
+	ErrTestDataTooLarge    = errors.New("stored test data too large")
+
+	ErrMaterialFileDoesntExist    = errors.New("auxiliary material file doesn't exist")
+	ErrMaterialFileTooLarge       = errors.New("auxiliary material file too large")
+	ErrMaterialFileTypeNotAllowed = errors.New("auxiliary material file type isn't allowed")
+	ErrBlobDoesntExist            = errors.New("blob doesn't exist")
+	ErrBlobStorageProblems        = errors.New("blob storage problems")
+
+	ErrLanguageNotSupported = errors.New("language isn't supported")
+
+	ErrEnrollmentTooLarge = errors.New("bulk enrollment has too many rows")
 )
 
 type errorWrapper struct {
@@ -13,6 +13,20 @@ type (
 		CourseID string
 	}
 
+	AllCourseStudentsQuery struct {
+		Academic         course.Academic
+		CourseID         string
+		FullName         string
+		IncludeWithdrawn bool
+		Pagination       Pagination
+	}
+
+	AllCourseCollaboratorsQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		Pagination Pagination
+	}
+
 	AllTasksQuery struct {
 		Academic course.Academic
 		CourseID string
@@ -25,4 +39,77 @@ type (
 		CourseID   string
 		TaskNumber int
 	}
+
+	TaskVersionsQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		TaskNumber int
+	}
+
+	SpecificTaskVersionQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		TaskNumber int
+		Version    int
+	}
+
+	TaskVersionsDiffQuery struct {
+		Academic    course.Academic
+		CourseID    string
+		TaskNumber  int
+		FromVersion int
+		ToVersion   int
+	}
+
+	AllAuxiliaryMaterialsQuery struct {
+		Academic     course.Academic
+		CourseID     string
+		ResourceType course.ResourceType
+		TaskNumber   int
+	}
+
+	AuxiliaryMaterialFileQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		MaterialID string
+	}
+
+	SpecificAuxiliaryMaterialQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		MaterialID string
+	}
+
+	CourseViewsReportQuery struct {
+		Academic course.Academic
+		CourseID string
+	}
+
+	AllInvitationsQuery struct {
+		Academic course.Academic
+		CourseID string
+	}
+
+	// EnrollmentRequestsQuery with zero status returns requests of any status.
+	EnrollmentRequestsQuery struct {
+		Academic course.Academic
+		CourseID string
+		Status   course.EnrollmentRequestStatus
+	}
+
+	AllTeamsQuery struct {
+		Academic course.Academic
+		CourseID string
+	}
+
+	CourseWaitlistQuery struct {
+		Academic course.Academic
+		CourseID string
+	}
+
+	StoredTestDataQuery struct {
+		Academic   course.Academic
+		CourseID   string
+		TestDataID string
+	}
 )
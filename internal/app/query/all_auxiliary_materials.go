@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type AuxiliaryMaterialsFilterParams struct {
+	ResourceType course.ResourceType
+	// TaskNumber filters materials attached to the task, zero means any material.
+	TaskNumber int
+}
+
+type allAuxiliaryMaterialsReadModel interface {
+	FindAllAuxiliaryMaterials(
+		ctx context.Context,
+		academic course.Academic, courseID string,
+		filterParams AuxiliaryMaterialsFilterParams,
+	) ([]app.AuxiliaryMaterial, error)
+}
+
+type AllAuxiliaryMaterialsHandler struct {
+	readModel allAuxiliaryMaterialsReadModel
+}
+
+func NewAllAuxiliaryMaterialsHandler(readModel allAuxiliaryMaterialsReadModel) AllAuxiliaryMaterialsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return AllAuxiliaryMaterialsHandler{readModel: readModel}
+}
+
+func (h AllAuxiliaryMaterialsHandler) Handle(
+	ctx context.Context,
+	qry app.AllAuxiliaryMaterialsQuery,
+) (materials []app.AuxiliaryMaterial, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting all auxiliary materials of course #%s", qry.CourseID)
+	}()
+
+	return h.readModel.FindAllAuxiliaryMaterials(ctx, qry.Academic, qry.CourseID, AuxiliaryMaterialsFilterParams{
+		ResourceType: qry.ResourceType,
+		TaskNumber:   qry.TaskNumber,
+	})
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type AllCourseCollaboratorsHandler struct {
+	readModel        courseAcademicsReadModel
+	academicsService academicsService
+}
+
+func NewAllCourseCollaboratorsHandler(
+	readModel courseAcademicsReadModel,
+	service academicsService,
+) AllCourseCollaboratorsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	return AllCourseCollaboratorsHandler{
+		readModel:        readModel,
+		academicsService: service,
+	}
+}
+
+func (h AllCourseCollaboratorsHandler) Handle(
+	ctx context.Context,
+	qry app.AllCourseCollaboratorsQuery,
+) (page app.AcademicsPage, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting collaborators of course #%s", qry.CourseID)
+	}()
+
+	academics, err := h.readModel.FindCourseAcademics(ctx, qry.Academic, qry.CourseID)
+	if err != nil {
+		return app.AcademicsPage{}, err
+	}
+
+	profiles := resolveAcademicProfiles(ctx, h.academicsService, academics.CollaboratorIDs)
+	for i := range profiles {
+		profiles[i].Role = academics.CollaboratorRoles[profiles[i].ID]
+	}
+
+	return newAcademicsPage(profiles, qry.Pagination), nil
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type AllCourseStudentsHandler struct {
+	readModel        courseAcademicsReadModel
+	academicsService academicsService
+}
+
+func NewAllCourseStudentsHandler(
+	readModel courseAcademicsReadModel,
+	service academicsService,
+) AllCourseStudentsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if service == nil {
+		panic("academicsService is nil")
+	}
+
+	return AllCourseStudentsHandler{
+		readModel:        readModel,
+		academicsService: service,
+	}
+}
+
+func (h AllCourseStudentsHandler) Handle(
+	ctx context.Context,
+	qry app.AllCourseStudentsQuery,
+) (page app.AcademicsPage, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting students of course #%s", qry.CourseID)
+	}()
+
+	academics, err := h.readModel.FindCourseAcademics(ctx, qry.Academic, qry.CourseID)
+	if err != nil {
+		return app.AcademicsPage{}, err
+	}
+
+	includeWithdrawn := qry.IncludeWithdrawn && qry.Academic.Type() == course.TeacherType
+
+	enrollments := make(map[string]app.StudentEnrollment, len(academics.Enrollments))
+	studentIDs := academics.StudentIDs
+
+	for _, e := range academics.Enrollments {
+		enrollments[e.StudentID] = e
+
+		if includeWithdrawn && e.Status == course.WithdrawnStudent {
+			studentIDs = append(studentIDs, e.StudentID)
+		}
+	}
+
+	profiles := resolveAcademicProfiles(ctx, h.academicsService, studentIDs)
+	for i := range profiles {
+		if e, ok := enrollments[profiles[i].ID]; ok {
+			profiles[i].Enrollment = &e
+		}
+	}
+
+	return newAcademicsPage(filterAcademicProfilesByFullName(profiles, qry.FullName), qry.Pagination), nil
+}
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type invitationsReadModel interface {
+	courseAcademicsReadModel
+	FindCourseInvitations(ctx context.Context, academic course.Academic, courseID string) ([]app.Invitation, error)
+}
+
+type AllInvitationsHandler struct {
+	readModel invitationsReadModel
+}
+
+func NewAllInvitationsHandler(readModel invitationsReadModel) AllInvitationsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return AllInvitationsHandler{readModel: readModel}
+}
+
+func (h AllInvitationsHandler) Handle(
+	ctx context.Context,
+	qry app.AllInvitationsQuery,
+) (invitations []app.Invitation, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting invitations of course #%s", qry.CourseID)
+	}()
+
+	if err := checkCourseAccess(
+		ctx, h.readModel, qry.Academic, qry.CourseID,
+		course.TeacherAccess, app.ErrCourseDoesntExist,
+	); err != nil {
+		return nil, err
+	}
+
+	return h.readModel.FindCourseInvitations(ctx, qry.Academic, qry.CourseID)
+}
@@ -24,21 +24,39 @@ type allTasksReadModel interface {
 
 type AllTasksHandler struct {
 	readModel allTasksReadModel
+	renderer  descriptionRenderer
 }
 
-func NewAllTasksHandler(readModel allTasksReadModel) AllTasksHandler {
+func NewAllTasksHandler(readModel allTasksReadModel, renderer descriptionRenderer) AllTasksHandler {
 	if readModel == nil {
 		panic("readModel is nil")
 	}
 
-	return AllTasksHandler{readModel: readModel}
+	if renderer == nil {
+		panic("renderer is nil")
+	}
+
+	return AllTasksHandler{readModel: readModel, renderer: renderer}
 }
 
-func (h AllTasksHandler) Handle(ctx context.Context, qry app.AllTasksQuery) ([]app.GeneralTask, error) {
-	tasks, err := h.readModel.FindAllTasks(ctx, qry.Academic, qry.CourseID, TasksFilterParams{
+func (h AllTasksHandler) Handle(ctx context.Context, qry app.AllTasksQuery) (tasks []app.GeneralTask, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting all tasks of course #%s", qry.CourseID)
+	}()
+
+	tasks, err = h.readModel.FindAllTasks(ctx, qry.Academic, qry.CourseID, TasksFilterParams{
 		Type: qry.Type,
 		Text: qry.Text,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tasks {
+		if tasks[i].DescriptionHTML, err = h.renderer.RenderHTML(tasks[i].Description); err != nil {
+			return nil, err
+		}
+	}
 
-	return tasks, errors.Wrapf(err, "getting all tasks of course #%s", qry.CourseID)
+	return tasks, nil
 }
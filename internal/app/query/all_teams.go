@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type teamsReadModel interface {
+	FindCourseTeams(ctx context.Context, academic course.Academic, courseID string) (app.Teams, error)
+}
+
+type AllTeamsHandler struct {
+	readModel teamsReadModel
+}
+
+func NewAllTeamsHandler(readModel teamsReadModel) AllTeamsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return AllTeamsHandler{readModel: readModel}
+}
+
+func (h AllTeamsHandler) Handle(ctx context.Context, qry app.AllTeamsQuery) (teams app.Teams, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting teams of course #%s", qry.CourseID)
+	}()
+
+	return h.readModel.FindCourseTeams(ctx, qry.Academic, qry.CourseID)
+}
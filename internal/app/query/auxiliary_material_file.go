@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type blobStorage interface {
+	OpenBlob(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type AuxiliaryMaterialFileHandler struct {
+	readModel   specificAuxiliaryMaterialReadModel
+	blobStorage blobStorage
+	recorder    viewsRecorder
+}
+
+func NewAuxiliaryMaterialFileHandler(
+	readModel specificAuxiliaryMaterialReadModel,
+	storage blobStorage,
+	recorder viewsRecorder,
+) AuxiliaryMaterialFileHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if storage == nil {
+		panic("blobStorage is nil")
+	}
+
+	if recorder == nil {
+		panic("viewsRecorder is nil")
+	}
+
+	return AuxiliaryMaterialFileHandler{
+		readModel:   readModel,
+		blobStorage: storage,
+		recorder:    recorder,
+	}
+}
+
+func (h AuxiliaryMaterialFileHandler) Handle(
+	ctx context.Context,
+	qry app.AuxiliaryMaterialFileQuery,
+) (content app.MaterialFileContent, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting file of auxiliary material #%s of course #%s", qry.MaterialID, qry.CourseID)
+	}()
+
+	material, err := h.readModel.FindAuxiliaryMaterial(ctx, qry.Academic, qry.CourseID, qry.MaterialID)
+	if err != nil {
+		return app.MaterialFileContent{}, err
+	}
+
+	if material.File == nil {
+		return app.MaterialFileContent{}, app.ErrMaterialFileDoesntExist
+	}
+
+	data, err := h.blobStorage.OpenBlob(ctx, material.File.Key)
+	if err != nil {
+		if errors.Is(err, app.ErrBlobDoesntExist) {
+			return app.MaterialFileContent{}, app.Wrap(app.ErrMaterialFileDoesntExist, err)
+		}
+
+		return app.MaterialFileContent{}, err
+	}
+
+	recordStudentView(h.recorder, qry.Academic, qry.CourseID, app.MaterialItem(qry.MaterialID))
+
+	return app.MaterialFileContent{
+		Name:        material.File.Name,
+		ContentType: material.File.ContentType,
+		Size:        material.File.Size,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type courseAcademicsReadModel interface {
+	FindCourseAcademics(ctx context.Context, academic course.Academic, courseID string) (app.CourseAcademics, error)
+}
+
+// resolveAcademicProfiles enriches academic IDs with profiles. Academics whose
+// profiles can't be resolved, even if academics service fails, keep empty full name,
+// because list of course academics is still useful without names.
+func resolveAcademicProfiles(ctx context.Context, service academicsService, academicIDs []string) []app.AcademicProfile {
+	fullNames := make(map[string]string, len(academicIDs))
+
+	if len(academicIDs) > 0 {
+		found, err := service.AcademicProfiles(ctx, academicIDs)
+		if err != nil {
+			logrus.WithError(err).WithField("academics", len(academicIDs)).Warn("Failed to resolve academic profiles")
+		}
+
+		for _, p := range found {
+			fullNames[p.ID] = p.FullName
+		}
+	}
+
+	profiles := make([]app.AcademicProfile, 0, len(academicIDs))
+	for _, id := range academicIDs {
+		profiles = append(profiles, app.AcademicProfile{ID: id, FullName: fullNames[id]})
+	}
+
+	sortAcademicProfiles(profiles)
+
+	return profiles
+}
+
+// sortAcademicProfiles sorts by full name, then by ID,
+// profiles without full name are placed last.
+func sortAcademicProfiles(profiles []app.AcademicProfile) {
+	sort.Slice(profiles, func(i, j int) bool {
+		a, b := profiles[i], profiles[j]
+		if (a.FullName == "") != (b.FullName == "") {
+			return b.FullName == ""
+		}
+
+		if a.FullName != b.FullName {
+			return a.FullName < b.FullName
+		}
+
+		return a.ID < b.ID
+	})
+}
+
+func filterAcademicProfilesByFullName(profiles []app.AcademicProfile, fullName string) []app.AcademicProfile {
+	if fullName == "" {
+		return profiles
+	}
+
+	fullName = strings.ToLower(fullName)
+
+	filtered := make([]app.AcademicProfile, 0, len(profiles))
+	for _, p := range profiles {
+		if strings.Contains(strings.ToLower(p.FullName), fullName) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
+
+func newAcademicsPage(profiles []app.AcademicProfile, pagination app.Pagination) app.AcademicsPage {
+	page := app.AcademicsPage{
+		Academics: make([]app.AcademicProfile, 0),
+		Total:     len(profiles),
+	}
+
+	offset := pagination.Offset()
+	if offset >= len(profiles) {
+		return page
+	}
+
+	end := offset + pagination.PerPage
+	if end > len(profiles) {
+		end = len(profiles)
+	}
+
+	page.Academics = append(page.Academics, profiles[offset:end]...)
+
+	return page
+}
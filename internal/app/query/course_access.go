@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+// checkCourseAccess returns denied error if academic isn't creator of
+// course or collaborator whose role has access, like teacher-only queries
+// hide course from students. Errors of read model are returned as is.
+func checkCourseAccess(
+	ctx context.Context,
+	readModel courseAcademicsReadModel,
+	academic course.Academic, courseID string,
+	access course.Access, denied error,
+) error {
+	if academic.Type() != course.TeacherType {
+		return denied
+	}
+
+	academics, err := readModel.FindCourseAcademics(ctx, academic, courseID)
+	if err != nil {
+		return err
+	}
+
+	if academics.CreatorID == academic.ID() {
+		return nil
+	}
+
+	if role, ok := academics.CollaboratorRoles[academic.ID()]; ok && role.HasAccess(access) {
+		return nil
+	}
+
+	return denied
+}
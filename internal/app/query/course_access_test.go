@@ -0,0 +1,172 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/repository/memory"
+	storage "github.com/authena-ru/courses-organization/internal/adapter/storage/memory"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/query"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestTeacherQueries_collaboratorRoleAccesses(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "4f0f1a54-7a3c-4d2e-9a55-8d5c2f1b7e02"
+
+	var (
+		creator   = course.MustNewAcademic("creator-id", course.TeacherType)
+		coTeacher = course.MustNewAcademic("co-teacher-id", course.TeacherType)
+		assistant = course.MustNewAcademic("assistant-id", course.TeacherType)
+		observer  = course.MustNewAcademic("observer-id", course.TeacherType)
+		stranger  = course.MustNewAcademic("stranger-id", course.TeacherType)
+		student   = course.MustNewAcademic("student-id", course.StudentType)
+	)
+
+	ctx := context.Background()
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:            courseID,
+		Creator:       creator,
+		Title:         "Programming course",
+		Period:        course.MustNewPeriod(2021, 2022, course.FirstSemester),
+		Started:       true,
+		Collaborators: []string{coTeacher.ID(), assistant.ID(), observer.ID()},
+		Students:      []string{student.ID()},
+	})
+	require.NoError(t, crs.ChangeCollaboratorRole(creator, assistant.ID(), course.AssistantRole))
+	require.NoError(t, crs.ChangeCollaboratorRole(creator, observer.ID(), course.ObserverRole))
+	_, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{Title: "Essay"}, time.Now())
+	require.NoError(t, err)
+
+	repository := memory.NewCoursesRepository()
+	require.NoError(t, repository.AddCourse(ctx, crs))
+
+	queries := []struct {
+		Name        string
+		AllowedFor  []string
+		ExpectedErr error
+		Handle      func(academic course.Academic) error
+	}{
+		{
+			Name:        "all_invitations",
+			AllowedFor:  []string{"creator", "co_teacher"},
+			ExpectedErr: app.ErrCourseDoesntExist,
+			Handle: func(academic course.Academic) error {
+				_, err := query.NewAllInvitationsHandler(repository).Handle(ctx, app.AllInvitationsQuery{
+					Academic: academic,
+					CourseID: courseID,
+				})
+
+				return err
+			},
+		},
+		{
+			Name:        "enrollment_requests",
+			AllowedFor:  []string{"creator", "co_teacher"},
+			ExpectedErr: app.ErrCourseDoesntExist,
+			Handle: func(academic course.Academic) error {
+				_, err := query.NewEnrollmentRequestsHandler(repository).Handle(ctx, app.EnrollmentRequestsQuery{
+					Academic: academic,
+					CourseID: courseID,
+				})
+
+				return err
+			},
+		},
+		{
+			Name:        "course_views_report",
+			AllowedFor:  []string{"creator", "co_teacher", "assistant"},
+			ExpectedErr: app.ErrCourseDoesntExist,
+			Handle: func(academic course.Academic) error {
+				handler := query.NewCourseViewsReportHandler(repository, storage.NewViewsStorage())
+				_, err := handler.Handle(ctx, app.CourseViewsReportQuery{
+					Academic: academic,
+					CourseID: courseID,
+				})
+
+				return err
+			},
+		},
+		{
+			Name:        "task_versions",
+			AllowedFor:  []string{"creator", "co_teacher", "assistant"},
+			ExpectedErr: app.ErrTaskVersionDoesntExist,
+			Handle: func(academic course.Academic) error {
+				_, err := query.NewTaskVersionsHandler(repository).Handle(ctx, app.TaskVersionsQuery{
+					Academic:   academic,
+					CourseID:   courseID,
+					TaskNumber: 1,
+				})
+
+				return err
+			},
+		},
+		{
+			Name:        "course_waitlist",
+			AllowedFor:  []string{"creator", "co_teacher", "assistant", "observer"},
+			ExpectedErr: app.ErrCourseDoesntExist,
+			Handle: func(academic course.Academic) error {
+				_, err := query.NewCourseWaitlistHandler(repository).Handle(ctx, app.CourseWaitlistQuery{
+					Academic: academic,
+					CourseID: courseID,
+				})
+
+				return err
+			},
+		},
+	}
+
+	academics := []struct {
+		Name     string
+		Academic course.Academic
+	}{
+		{Name: "creator", Academic: creator},
+		{Name: "co_teacher", Academic: coTeacher},
+		{Name: "assistant", Academic: assistant},
+		{Name: "observer", Academic: observer},
+		{Name: "student", Academic: student},
+	}
+
+	for i := range academics {
+		a := academics[i]
+		for j := range queries {
+			q := queries[j]
+			t.Run(a.Name+"_"+q.Name, func(t *testing.T) {
+				t.Parallel()
+
+				err := q.Handle(a.Academic)
+
+				if contains(q.AllowedFor, a.Name) {
+					require.NoError(t, err)
+
+					return
+				}
+				require.True(t, errors.Is(err, q.ExpectedErr), "got %v", err)
+			})
+		}
+	}
+
+	t.Run("stranger", func(t *testing.T) {
+		t.Parallel()
+
+		for _, q := range queries {
+			require.True(t, errors.Is(q.Handle(stranger), app.ErrCourseDoesntExist), q.Name)
+		}
+	})
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
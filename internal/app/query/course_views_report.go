@@ -0,0 +1,121 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type courseContentReadModel interface {
+	courseAcademicsReadModel
+	FindCourseContent(ctx context.Context, academic course.Academic, courseID string) (app.CourseContent, error)
+}
+
+type viewsReadModel interface {
+	FindCourseViewers(ctx context.Context, courseID string) ([]app.ItemViewers, error)
+}
+
+type CourseViewsReportHandler struct {
+	readModel      courseContentReadModel
+	viewsReadModel viewsReadModel
+}
+
+func NewCourseViewsReportHandler(
+	readModel courseContentReadModel,
+	viewsReadModel viewsReadModel,
+) CourseViewsReportHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if viewsReadModel == nil {
+		panic("viewsReadModel is nil")
+	}
+
+	return CourseViewsReportHandler{
+		readModel:      readModel,
+		viewsReadModel: viewsReadModel,
+	}
+}
+
+func (h CourseViewsReportHandler) Handle(
+	ctx context.Context,
+	qry app.CourseViewsReportQuery,
+) (report app.CourseViewsReport, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting views report of course #%s", qry.CourseID)
+	}()
+
+	if err := checkCourseAccess(
+		ctx, h.readModel, qry.Academic, qry.CourseID,
+		course.ReviewAccess, app.ErrCourseDoesntExist,
+	); err != nil {
+		return app.CourseViewsReport{}, err
+	}
+
+	content, err := h.readModel.FindCourseContent(ctx, qry.Academic, qry.CourseID)
+	if err != nil {
+		return app.CourseViewsReport{}, err
+	}
+
+	viewers, err := h.viewsReadModel.FindCourseViewers(ctx, qry.CourseID)
+	if err != nil {
+		return app.CourseViewsReport{}, err
+	}
+
+	return newCourseViewsReport(content, viewers), nil
+}
+
+// newCourseViewsReport counts only current students of course,
+// views of removed students are kept but not reported.
+func newCourseViewsReport(content app.CourseContent, viewers []app.ItemViewers) app.CourseViewsReport {
+	itemViewers := make(map[app.ViewedItem][]string, len(viewers))
+	for _, v := range viewers {
+		itemViewers[v.Item] = append(itemViewers[v.Item], v.StudentIDs...)
+	}
+
+	report := app.CourseViewsReport{
+		Tasks:     make([]app.ItemViewsReport, 0, len(content.Tasks)),
+		Materials: make([]app.ItemViewsReport, 0, len(content.Materials)),
+	}
+
+	for _, t := range content.Tasks {
+		item := app.TaskItem(t.Number)
+		report.Tasks = append(report.Tasks, newItemViewsReport(item, t.Title, content.StudentIDs, itemViewers[item]))
+	}
+
+	for _, m := range content.Materials {
+		item := app.MaterialItem(m.ID)
+		report.Materials = append(report.Materials, newItemViewsReport(item, m.Resource, content.StudentIDs, itemViewers[item]))
+	}
+
+	return report
+}
+
+func newItemViewsReport(item app.ViewedItem, title string, studentIDs, viewerIDs []string) app.ItemViewsReport {
+	viewed := make(map[string]bool, len(viewerIDs))
+	for _, id := range viewerIDs {
+		viewed[id] = true
+	}
+
+	report := app.ItemViewsReport{
+		Item:              item,
+		Title:             title,
+		NotViewedStudents: make([]string, 0),
+	}
+
+	for _, id := range studentIDs {
+		if viewed[id] {
+			report.UniqueViewers++
+
+			continue
+		}
+
+		report.NotViewedStudents = append(report.NotViewedStudents, id)
+	}
+
+	return report
+}
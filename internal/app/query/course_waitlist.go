@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type waitlistReadModel interface {
+	courseAcademicsReadModel
+	FindCourseWaitlist(ctx context.Context, academic course.Academic, courseID string) (app.Waitlist, error)
+}
+
+type CourseWaitlistHandler struct {
+	readModel waitlistReadModel
+}
+
+func NewCourseWaitlistHandler(readModel waitlistReadModel) CourseWaitlistHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return CourseWaitlistHandler{readModel: readModel}
+}
+
+func (h CourseWaitlistHandler) Handle(ctx context.Context, qry app.CourseWaitlistQuery) (waitlist app.Waitlist, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting waitlist of course #%s", qry.CourseID)
+	}()
+
+	if err := checkCourseAccess(
+		ctx, h.readModel, qry.Academic, qry.CourseID,
+		course.ViewAccess, app.ErrCourseDoesntExist,
+	); err != nil {
+		return app.Waitlist{}, err
+	}
+
+	return h.readModel.FindCourseWaitlist(ctx, qry.Academic, qry.CourseID)
+}
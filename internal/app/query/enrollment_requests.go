@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type enrollmentRequestsReadModel interface {
+	courseAcademicsReadModel
+	FindEnrollmentRequests(
+		ctx context.Context,
+		academic course.Academic, courseID string,
+		status course.EnrollmentRequestStatus,
+	) ([]app.EnrollmentRequest, error)
+}
+
+type EnrollmentRequestsHandler struct {
+	readModel enrollmentRequestsReadModel
+}
+
+func NewEnrollmentRequestsHandler(readModel enrollmentRequestsReadModel) EnrollmentRequestsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return EnrollmentRequestsHandler{readModel: readModel}
+}
+
+func (h EnrollmentRequestsHandler) Handle(
+	ctx context.Context,
+	qry app.EnrollmentRequestsQuery,
+) (requests []app.EnrollmentRequest, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting enrollment requests of course #%s", qry.CourseID)
+	}()
+
+	if err := checkCourseAccess(
+		ctx, h.readModel, qry.Academic, qry.CourseID,
+		course.TeacherAccess, app.ErrCourseDoesntExist,
+	); err != nil {
+		return nil, err
+	}
+
+	return h.readModel.FindEnrollmentRequests(ctx, qry.Academic, qry.CourseID, qry.Status)
+}
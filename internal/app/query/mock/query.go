@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"io"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 )
@@ -17,3 +18,129 @@ type SpecificTaskHandler func(ctx context.Context, qry app.SpecificTaskQuery) (a
 func (m SpecificTaskHandler) Handle(ctx context.Context, qry app.SpecificTaskQuery) (app.SpecificTask, error) {
 	return m(ctx, qry)
 }
+
+type StoredTestDataHandler func(ctx context.Context, qry app.StoredTestDataQuery) (io.ReadCloser, error)
+
+func (m StoredTestDataHandler) Handle(ctx context.Context, qry app.StoredTestDataQuery) (io.ReadCloser, error) {
+	return m(ctx, qry)
+}
+
+type TaskVersionsHandler func(ctx context.Context, qry app.TaskVersionsQuery) ([]app.GeneralTaskVersion, error)
+
+func (m TaskVersionsHandler) Handle(ctx context.Context, qry app.TaskVersionsQuery) ([]app.GeneralTaskVersion, error) {
+	return m(ctx, qry)
+}
+
+type SpecificTaskVersionHandler func(ctx context.Context, qry app.SpecificTaskVersionQuery) (app.TaskVersion, error)
+
+func (m SpecificTaskVersionHandler) Handle(
+	ctx context.Context,
+	qry app.SpecificTaskVersionQuery,
+) (app.TaskVersion, error) {
+	return m(ctx, qry)
+}
+
+type TaskVersionsDiffHandler func(ctx context.Context, qry app.TaskVersionsDiffQuery) (app.TaskVersionsDiff, error)
+
+func (m TaskVersionsDiffHandler) Handle(
+	ctx context.Context,
+	qry app.TaskVersionsDiffQuery,
+) (app.TaskVersionsDiff, error) {
+	return m(ctx, qry)
+}
+
+type AllAuxiliaryMaterialsHandler func(
+	ctx context.Context,
+	qry app.AllAuxiliaryMaterialsQuery,
+) ([]app.AuxiliaryMaterial, error)
+
+func (m AllAuxiliaryMaterialsHandler) Handle(
+	ctx context.Context,
+	qry app.AllAuxiliaryMaterialsQuery,
+) ([]app.AuxiliaryMaterial, error) {
+	return m(ctx, qry)
+}
+
+type SpecificAuxiliaryMaterialHandler func(
+	ctx context.Context,
+	qry app.SpecificAuxiliaryMaterialQuery,
+) (app.AuxiliaryMaterial, error)
+
+func (m SpecificAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	qry app.SpecificAuxiliaryMaterialQuery,
+) (app.AuxiliaryMaterial, error) {
+	return m(ctx, qry)
+}
+
+type AuxiliaryMaterialFileHandler func(
+	ctx context.Context,
+	qry app.AuxiliaryMaterialFileQuery,
+) (app.MaterialFileContent, error)
+
+func (m AuxiliaryMaterialFileHandler) Handle(
+	ctx context.Context,
+	qry app.AuxiliaryMaterialFileQuery,
+) (app.MaterialFileContent, error) {
+	return m(ctx, qry)
+}
+
+type CourseViewsReportHandler func(
+	ctx context.Context,
+	qry app.CourseViewsReportQuery,
+) (app.CourseViewsReport, error)
+
+func (m CourseViewsReportHandler) Handle(
+	ctx context.Context,
+	qry app.CourseViewsReportQuery,
+) (app.CourseViewsReport, error) {
+	return m(ctx, qry)
+}
+
+type AllCourseStudentsHandler func(ctx context.Context, qry app.AllCourseStudentsQuery) (app.AcademicsPage, error)
+
+func (m AllCourseStudentsHandler) Handle(ctx context.Context, qry app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+	return m(ctx, qry)
+}
+
+type AllCourseCollaboratorsHandler func(
+	ctx context.Context,
+	qry app.AllCourseCollaboratorsQuery,
+) (app.AcademicsPage, error)
+
+func (m AllCourseCollaboratorsHandler) Handle(
+	ctx context.Context,
+	qry app.AllCourseCollaboratorsQuery,
+) (app.AcademicsPage, error) {
+	return m(ctx, qry)
+}
+
+type AllInvitationsHandler func(ctx context.Context, qry app.AllInvitationsQuery) ([]app.Invitation, error)
+
+func (m AllInvitationsHandler) Handle(ctx context.Context, qry app.AllInvitationsQuery) ([]app.Invitation, error) {
+	return m(ctx, qry)
+}
+
+type EnrollmentRequestsHandler func(
+	ctx context.Context,
+	qry app.EnrollmentRequestsQuery,
+) ([]app.EnrollmentRequest, error)
+
+func (m EnrollmentRequestsHandler) Handle(
+	ctx context.Context,
+	qry app.EnrollmentRequestsQuery,
+) ([]app.EnrollmentRequest, error) {
+	return m(ctx, qry)
+}
+
+type AllTeamsHandler func(ctx context.Context, qry app.AllTeamsQuery) (app.Teams, error)
+
+func (m AllTeamsHandler) Handle(ctx context.Context, qry app.AllTeamsQuery) (app.Teams, error) {
+	return m(ctx, qry)
+}
+
+type CourseWaitlistHandler func(ctx context.Context, qry app.CourseWaitlistQuery) (app.Waitlist, error)
+
+func (m CourseWaitlistHandler) Handle(ctx context.Context, qry app.CourseWaitlistQuery) (app.Waitlist, error) {
+	return m(ctx, qry)
+}
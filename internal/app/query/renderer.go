@@ -0,0 +1,6 @@
+package query
+
+type descriptionRenderer interface {
+	// RenderHTML converts Markdown description to sanitized HTML.
+	RenderHTML(markdown string) (string, error)
+}
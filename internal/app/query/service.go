@@ -0,0 +1,13 @@
+package query
+
+import (
+	"context"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+)
+
+type academicsService interface {
+	// AcademicProfiles should return profiles of academics that
+	// academics service can find, unknown IDs are skipped.
+	AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error)
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type specificAuxiliaryMaterialReadModel interface {
+	FindAuxiliaryMaterial(
+		ctx context.Context,
+		academic course.Academic, courseID, materialID string,
+	) (app.AuxiliaryMaterial, error)
+}
+
+type SpecificAuxiliaryMaterialHandler struct {
+	readModel specificAuxiliaryMaterialReadModel
+	recorder  viewsRecorder
+}
+
+func NewSpecificAuxiliaryMaterialHandler(
+	readModel specificAuxiliaryMaterialReadModel,
+	recorder viewsRecorder,
+) SpecificAuxiliaryMaterialHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if recorder == nil {
+		panic("viewsRecorder is nil")
+	}
+
+	return SpecificAuxiliaryMaterialHandler{readModel: readModel, recorder: recorder}
+}
+
+func (h SpecificAuxiliaryMaterialHandler) Handle(
+	ctx context.Context,
+	qry app.SpecificAuxiliaryMaterialQuery,
+) (material app.AuxiliaryMaterial, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting auxiliary material #%s of course #%s", qry.MaterialID, qry.CourseID)
+	}()
+
+	material, err = h.readModel.FindAuxiliaryMaterial(ctx, qry.Academic, qry.CourseID, qry.MaterialID)
+	if err != nil {
+		return app.AuxiliaryMaterial{}, err
+	}
+
+	recordStudentView(h.recorder, qry.Academic, qry.CourseID, app.MaterialItem(qry.MaterialID))
+
+	return material, nil
+}
@@ -15,18 +15,46 @@ type specificTaskReadModel interface {
 
 type SpecificTaskHandler struct {
 	readModel specificTaskReadModel
+	renderer  descriptionRenderer
+	recorder  viewsRecorder
 }
 
-func NewSpecificTaskHandler(readModel specificTaskReadModel) SpecificTaskHandler {
+func NewSpecificTaskHandler(
+	readModel specificTaskReadModel,
+	renderer descriptionRenderer,
+	recorder viewsRecorder,
+) SpecificTaskHandler {
 	if readModel == nil {
 		panic("readModel is nil")
 	}
 
-	return SpecificTaskHandler{readModel: readModel}
+	if renderer == nil {
+		panic("renderer is nil")
+	}
+
+	if recorder == nil {
+		panic("viewsRecorder is nil")
+	}
+
+	return SpecificTaskHandler{readModel: readModel, renderer: renderer, recorder: recorder}
 }
 
-func (h SpecificTaskHandler) Handle(ctx context.Context, qry app.SpecificTaskQuery) (app.SpecificTask, error) {
-	task, err := h.readModel.FindTask(ctx, qry.Academic, qry.CourseID, qry.TaskNumber)
+func (h SpecificTaskHandler) Handle(ctx context.Context, qry app.SpecificTaskQuery) (task app.SpecificTask, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting task No %d of course #%s", qry.TaskNumber, qry.CourseID)
+	}()
+
+	task, err = h.readModel.FindTask(ctx, qry.Academic, qry.CourseID, qry.TaskNumber)
+	if err != nil {
+		return app.SpecificTask{}, err
+	}
+
+	task.DescriptionHTML, err = h.renderer.RenderHTML(task.Description)
+	if err != nil {
+		return app.SpecificTask{}, err
+	}
+
+	recordStudentView(h.recorder, qry.Academic, qry.CourseID, app.TaskItem(qry.TaskNumber))
 
-	return task, errors.Wrapf(err, "getting task No %d of course #%s", qry.TaskNumber, qry.CourseID)
+	return task, nil
 }
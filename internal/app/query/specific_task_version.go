@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type specificTaskVersionReadModel interface {
+	courseAcademicsReadModel
+	FindTaskVersion(
+		ctx context.Context,
+		academic course.Academic, courseID string, taskNumber, version int,
+	) (app.TaskVersion, error)
+}
+
+type SpecificTaskVersionHandler struct {
+	readModel specificTaskVersionReadModel
+	renderer  descriptionRenderer
+}
+
+func NewSpecificTaskVersionHandler(
+	readModel specificTaskVersionReadModel,
+	renderer descriptionRenderer,
+) SpecificTaskVersionHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if renderer == nil {
+		panic("renderer is nil")
+	}
+
+	return SpecificTaskVersionHandler{readModel: readModel, renderer: renderer}
+}
+
+func (h SpecificTaskVersionHandler) Handle(
+	ctx context.Context,
+	qry app.SpecificTaskVersionQuery,
+) (version app.TaskVersion, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"getting version %d of task No %d of course #%s",
+			qry.Version, qry.TaskNumber, qry.CourseID,
+		)
+	}()
+
+	return findRenderedTaskVersion(ctx, h.readModel, h.renderer, qry)
+}
+
+func findRenderedTaskVersion(
+	ctx context.Context,
+	readModel specificTaskVersionReadModel,
+	renderer descriptionRenderer,
+	qry app.SpecificTaskVersionQuery,
+) (app.TaskVersion, error) {
+	if err := checkCourseAccess(
+		ctx, readModel, qry.Academic, qry.CourseID,
+		course.ReviewAccess, app.ErrTaskVersionDoesntExist,
+	); err != nil {
+		return app.TaskVersion{}, err
+	}
+
+	version, err := readModel.FindTaskVersion(ctx, qry.Academic, qry.CourseID, qry.TaskNumber, qry.Version)
+	if err != nil {
+		return app.TaskVersion{}, err
+	}
+
+	version.Task.DescriptionHTML, err = renderer.RenderHTML(version.Task.Description)
+	if err != nil {
+		return app.TaskVersion{}, err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type testDataStorage interface {
+	OpenTestData(ctx context.Context, courseID, testDataID string) (io.ReadCloser, error)
+}
+
+type storedTestDataReadModel interface {
+	specificCourseReadModel
+
+	// FindSampleTestDataIDs returns IDs of stored input and output
+	// data of sample tests of course tasks, students can read them.
+	FindSampleTestDataIDs(ctx context.Context, academic course.Academic, courseID string) ([]string, error)
+}
+
+type StoredTestDataHandler struct {
+	readModel       storedTestDataReadModel
+	testDataStorage testDataStorage
+}
+
+func NewStoredTestDataHandler(readModel storedTestDataReadModel, storage testDataStorage) StoredTestDataHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if storage == nil {
+		panic("testDataStorage is nil")
+	}
+
+	return StoredTestDataHandler{
+		readModel:       readModel,
+		testDataStorage: storage,
+	}
+}
+
+func (h StoredTestDataHandler) Handle(ctx context.Context, qry app.StoredTestDataQuery) (data io.ReadCloser, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting stored test data #%s of course #%s", qry.TestDataID, qry.CourseID)
+	}()
+
+	if qry.Academic.Type() == course.TeacherType {
+		if _, err := h.readModel.FindCourse(ctx, qry.Academic, qry.CourseID); err != nil {
+			return nil, err
+		}
+	} else if err := h.checkSampleTestData(ctx, qry); err != nil {
+		return nil, err
+	}
+
+	return h.testDataStorage.OpenTestData(ctx, qry.CourseID, qry.TestDataID)
+}
+
+// checkSampleTestData lets student read only data of sample tests,
+// teacher can read any test data uploaded to course.
+func (h StoredTestDataHandler) checkSampleTestData(ctx context.Context, qry app.StoredTestDataQuery) error {
+	sampleIDs, err := h.readModel.FindSampleTestDataIDs(ctx, qry.Academic, qry.CourseID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range sampleIDs {
+		if id == qry.TestDataID {
+			return nil
+		}
+	}
+
+	return app.ErrTestDataDoesntExist
+}
@@ -0,0 +1,144 @@
+package query_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/adapter/repository/memory"
+	storage "github.com/authena-ru/courses-organization/internal/adapter/storage/memory"
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/query"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestStoredTestDataHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "9b8b6d2a-4a0c-4b8e-9d1a-0f3f3c7f5a11"
+
+	var (
+		creator  = course.MustNewAcademic("f7f02ce0-9d3f-4843-a379-a7773c29e7c2", course.TeacherType)
+		student  = course.MustNewAcademic("ad2ee15f-0805-42dc-8b7c-003c440e88c7", course.StudentType)
+		stranger = course.MustNewAcademic("1f5f202a-8935-44eb-9544-ae78c4e90d46", course.StudentType)
+	)
+
+	ctx := context.Background()
+	testDataStorage := storage.NewTestDataStorage()
+
+	saveTestData := func(content string) string {
+		id, err := testDataStorage.SaveTestData(ctx, courseID, strings.NewReader(content))
+		require.NoError(t, err)
+
+		return id
+	}
+
+	sampleInputID, sampleOutputID := saveTestData("1 2"), saveTestData("3")
+	hiddenInputID, hiddenOutputID := saveTestData("2 2"), saveTestData("4")
+	unattachedID := saveTestData("5 5")
+
+	crs := course.MustNewCourse(course.CreationParams{
+		ID:       courseID,
+		Creator:  creator,
+		Title:    "Programming course",
+		Period:   course.MustNewPeriod(2021, 2022, course.FirstSemester),
+		Started:  true,
+		Students: []string{student.ID()},
+	})
+	_, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{
+		Title: "Sum of two numbers",
+		TestData: []course.TestData{
+			course.MustNewStoredTestData(sampleInputID, sampleOutputID, course.SampleTestData, "Sum"),
+			course.MustNewStoredTestData(hiddenInputID, hiddenOutputID, course.HiddenTestData, ""),
+		},
+	}, time.Now())
+	require.NoError(t, err)
+
+	repository := memory.NewCoursesRepository()
+	require.NoError(t, repository.AddCourse(ctx, crs))
+
+	handler := query.NewStoredTestDataHandler(repository, testDataStorage)
+
+	testCases := []struct {
+		Name            string
+		Academic        course.Academic
+		TestDataID      string
+		ExpectedContent string
+		ExpectedErr     error
+	}{
+		{
+			Name:            "teacher_reads_hidden_test_data",
+			Academic:        creator,
+			TestDataID:      hiddenOutputID,
+			ExpectedContent: "4",
+		},
+		{
+			Name:            "teacher_reads_unattached_test_data",
+			Academic:        creator,
+			TestDataID:      unattachedID,
+			ExpectedContent: "5 5",
+		},
+		{
+			Name:            "student_reads_sample_input_data",
+			Academic:        student,
+			TestDataID:      sampleInputID,
+			ExpectedContent: "1 2",
+		},
+		{
+			Name:            "student_reads_sample_output_data",
+			Academic:        student,
+			TestDataID:      sampleOutputID,
+			ExpectedContent: "3",
+		},
+		{
+			Name:        "dont_give_hidden_test_data_to_student",
+			Academic:    student,
+			TestDataID:  hiddenInputID,
+			ExpectedErr: app.ErrTestDataDoesntExist,
+		},
+		{
+			Name:        "dont_give_unattached_test_data_to_student",
+			Academic:    student,
+			TestDataID:  unattachedID,
+			ExpectedErr: app.ErrTestDataDoesntExist,
+		},
+		{
+			Name:        "dont_give_sample_test_data_to_stranger",
+			Academic:    stranger,
+			TestDataID:  sampleInputID,
+			ExpectedErr: app.ErrCourseDoesntExist,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := handler.Handle(ctx, app.StoredTestDataQuery{
+				Academic:   c.Academic,
+				CourseID:   courseID,
+				TestDataID: c.TestDataID,
+			})
+
+			if c.ExpectedErr != nil {
+				require.True(t, errors.Is(err, c.ExpectedErr))
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			defer data.Close()
+
+			content, err := io.ReadAll(data)
+			require.NoError(t, err)
+			require.Equal(t, c.ExpectedContent, string(content))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type taskVersionsReadModel interface {
+	courseAcademicsReadModel
+	FindTaskVersions(
+		ctx context.Context,
+		academic course.Academic, courseID string, taskNumber int,
+	) ([]app.GeneralTaskVersion, error)
+}
+
+type TaskVersionsHandler struct {
+	readModel taskVersionsReadModel
+}
+
+func NewTaskVersionsHandler(readModel taskVersionsReadModel) TaskVersionsHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	return TaskVersionsHandler{readModel: readModel}
+}
+
+func (h TaskVersionsHandler) Handle(
+	ctx context.Context,
+	qry app.TaskVersionsQuery,
+) (versions []app.GeneralTaskVersion, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "getting versions of task No %d of course #%s", qry.TaskNumber, qry.CourseID)
+	}()
+
+	if err := checkCourseAccess(
+		ctx, h.readModel, qry.Academic, qry.CourseID,
+		course.ReviewAccess, app.ErrTaskVersionDoesntExist,
+	); err != nil {
+		return nil, err
+	}
+
+	return h.readModel.FindTaskVersions(ctx, qry.Academic, qry.CourseID, qry.TaskNumber)
+}
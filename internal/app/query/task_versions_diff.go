@@ -0,0 +1,98 @@
+package query
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/pkg/textdiff"
+)
+
+type TaskVersionsDiffHandler struct {
+	readModel specificTaskVersionReadModel
+	renderer  descriptionRenderer
+}
+
+func NewTaskVersionsDiffHandler(
+	readModel specificTaskVersionReadModel,
+	renderer descriptionRenderer,
+) TaskVersionsDiffHandler {
+	if readModel == nil {
+		panic("readModel is nil")
+	}
+
+	if renderer == nil {
+		panic("renderer is nil")
+	}
+
+	return TaskVersionsDiffHandler{readModel: readModel, renderer: renderer}
+}
+
+func (h TaskVersionsDiffHandler) Handle(
+	ctx context.Context,
+	qry app.TaskVersionsDiffQuery,
+) (diff app.TaskVersionsDiff, err error) {
+	defer func() {
+		err = errors.Wrapf(
+			err,
+			"comparing versions %d and %d of task No %d of course #%s",
+			qry.FromVersion, qry.ToVersion, qry.TaskNumber, qry.CourseID,
+		)
+	}()
+
+	from, err := findRenderedTaskVersion(ctx, h.readModel, h.renderer, app.SpecificTaskVersionQuery{
+		Academic:   qry.Academic,
+		CourseID:   qry.CourseID,
+		TaskNumber: qry.TaskNumber,
+		Version:    qry.FromVersion,
+	})
+	if err != nil {
+		return app.TaskVersionsDiff{}, err
+	}
+
+	to, err := findRenderedTaskVersion(ctx, h.readModel, h.renderer, app.SpecificTaskVersionQuery{
+		Academic:   qry.Academic,
+		CourseID:   qry.CourseID,
+		TaskNumber: qry.TaskNumber,
+		Version:    qry.ToVersion,
+	})
+	if err != nil {
+		return app.TaskVersionsDiff{}, err
+	}
+
+	return app.TaskVersionsDiff{
+		From:            from,
+		To:              to,
+		ChangedFields:   changedTaskFields(from.Task, to.Task),
+		DescriptionDiff: textdiff.Lines(from.Task.Description, to.Task.Description),
+	}, nil
+}
+
+func changedTaskFields(from, to app.SpecificTask) []app.TaskField {
+	fields := []struct {
+		field    app.TaskField
+		from, to interface{}
+	}{
+		{field: app.TaskTitleField, from: from.Title, to: to.Title},
+		{field: app.TaskDescriptionField, from: from.Description, to: to.Description},
+		{field: app.TaskTeamWorkField, from: from.TeamWork, to: to.TeamWork},
+		{field: app.TaskDeadlineField, from: from.Deadline, to: to.Deadline},
+		{field: app.TaskTestPointsField, from: from.Points, to: to.Points},
+		{field: app.TaskTestDataField, from: from.TestData, to: to.TestData},
+		{field: app.TaskCheckerField, from: from.Checker, to: to.Checker},
+		{field: app.TaskLanguagesField, from: from.Languages, to: to.Languages},
+		{field: app.TaskLimitsField, from: from.Limits, to: to.Limits},
+	}
+
+	changed := make([]app.TaskField, 0, len(fields))
+
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.from, f.to) {
+			changed = append(changed, f.field)
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"time"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+type viewsRecorder interface {
+	// RecordView saves view event without blocking caller,
+	// event can be lost if recorder is overloaded.
+	RecordView(event app.ViewEvent)
+}
+
+// recordStudentView records view of item if academic is student,
+// views of teachers aren't interesting for engagement analytics.
+func recordStudentView(recorder viewsRecorder, academic course.Academic, courseID string, item app.ViewedItem) {
+	if academic.Type() != course.StudentType {
+		return
+	}
+
+	recorder.RecordView(app.ViewEvent{
+		CourseID:  courseID,
+		StudentID: academic.ID(),
+		Item:      item,
+		ViewedAt:  time.Now().UTC(),
+	})
+}
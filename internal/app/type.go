@@ -1,9 +1,11 @@
 package app
 
 import (
+	"io"
 	"time"
 
 	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/textdiff"
 )
 
 type (
@@ -14,23 +16,263 @@ type (
 		CreatorID   string
 		Started     bool
 		TasksNumber int
+		Capacity    int
 	}
 
 	SpecificTask struct {
-		Number      int
-		Title       string
-		Description string
-		Type        course.TaskType
-		Deadline    *Deadline
-		TestData    []TestData
-		Points      []TestPoint
+		Number               int
+		Title                string
+		Description          string
+		DescriptionHTML      string
+		Type                 course.TaskType
+		TeamWork             bool
+		Deadline             *Deadline
+		TestData             []TestData
+		HiddenTestDataNumber int
+		Checker              *Checker
+		Languages            []string
+		Limits               *ExecutionLimits
+		Points               []TestPoint
+		Version              int
+		Materials            []AuxiliaryMaterial
 	}
 
 	GeneralTask struct {
-		Number      int
-		Title       string
-		Description string
-		Type        course.TaskType
+		Number          int
+		Title           string
+		Description     string
+		DescriptionHTML string
+		Type            course.TaskType
+		TeamWork        bool
+	}
+
+	// GeneralTaskVersion is version of task without its content.
+	GeneralTaskVersion struct {
+		Version   int
+		AuthorID  string
+		CreatedAt time.Time
+		Title     string
+	}
+
+	TaskVersion struct {
+		Version   int
+		AuthorID  string
+		CreatedAt time.Time
+		Task      SpecificTask
+	}
+
+	TaskVersionsDiff struct {
+		From            TaskVersion
+		To              TaskVersion
+		ChangedFields   []TaskField
+		DescriptionDiff []textdiff.Line
+	}
+
+	AuxiliaryMaterial struct {
+		ID           string
+		Resource     string
+		ResourceType course.ResourceType
+		File         *MaterialFile
+		TaskNumber   int
+		ReleaseTime  time.Time
+		Hidden       bool
+		Status       course.MaterialStatus
+	}
+
+	MaterialFile struct {
+		Key         string
+		Name        string
+		ContentType string
+		Size        int64
+	}
+
+	// MaterialFileContent is opened content of auxiliary
+	// material file, reader should be closed by caller.
+	MaterialFileContent struct {
+		Name        string
+		ContentType string
+		Size        int64
+		Data        io.ReadCloser
+	}
+
+	// Blob is content saved to blob storage,
+	// key is used to open content later.
+	Blob struct {
+		Key  string
+		Size int64
+	}
+
+	// AcademicProfile is academic of course with data from academics
+	// service, full name is empty if profile can't be resolved.
+	// Role is set only for collaborators of course.
+	AcademicProfile struct {
+		ID         string
+		FullName   string
+		Role       course.CollaboratorRole
+		Enrollment *StudentEnrollment
+	}
+
+	// StudentEnrollment is record of student participation in course,
+	// enrolled at is zero for students enrolled before records were kept.
+	StudentEnrollment struct {
+		StudentID   string
+		Status      course.StudentStatus
+		EnrolledAt  time.Time
+		WithdrawnAt time.Time
+		Reason      string
+	}
+
+	// AcademicsPage is page of course academics,
+	// total is number of academics on all pages.
+	AcademicsPage struct {
+		Academics []AcademicProfile
+		Total     int
+	}
+
+	// CourseAcademics is IDs of creator, students and collaborators of course,
+	// enrollments also contain records of withdrawn students.
+	CourseAcademics struct {
+		CreatorID         string
+		StudentIDs        []string
+		CollaboratorIDs   []string
+		CollaboratorRoles map[string]course.CollaboratorRole
+		Enrollments       []StudentEnrollment
+	}
+
+	// Pagination is number of page starting from 1 and its size.
+	Pagination struct {
+		Page    int
+		PerPage int
+	}
+
+	// Invitation is code to join course, uses is number
+	// of academics who have joined course with it.
+	Invitation struct {
+		Code      string
+		Role      course.AcademicType
+		ExpiresAt time.Time
+		MaxUses   int
+		Uses      int
+	}
+
+	// EnrollmentRequest is request of student to join course,
+	// reviewer is teacher who approved or rejected it.
+	EnrollmentRequest struct {
+		ID         string
+		StudentID  string
+		Message    string
+		Status     course.EnrollmentRequestStatus
+		CreatedAt  time.Time
+		ReviewerID string
+		ReviewedAt time.Time
+	}
+
+	// Teams is team settings of course with its teams,
+	// zero max size means teams of unlimited size.
+	Teams struct {
+		SelfFormation bool
+		MaxSize       int
+		Teams         []Team
+	}
+
+	Team struct {
+		ID        string
+		Name      string
+		MemberIDs []string
+	}
+
+	// Waitlist is capacity of course with students waiting for free
+	// seat in order of waiting and promotions from the oldest.
+	Waitlist struct {
+		Capacity   int
+		Entries    []WaitlistEntry
+		Promotions []WaitlistPromotion
+	}
+
+	WaitlistEntry struct {
+		StudentID    string
+		WaitlistedAt time.Time
+	}
+
+	WaitlistPromotion struct {
+		StudentID    string
+		WaitlistedAt time.Time
+		PromotedAt   time.Time
+	}
+
+	// RedeemedInvitation is result of redeeming invitation, student
+	// is waitlisted instead of joining when course is full.
+	RedeemedInvitation struct {
+		CourseID   string
+		Waitlisted bool
+	}
+
+	// DeactivationReport lists courses changed when academic was deactivated.
+	DeactivationReport struct {
+		AcademicID string
+		Courses    []CourseDeactivation
+	}
+
+	// CourseDeactivation is change of one course, course is orphaned when
+	// deactivated creator stays in it, because no co-teacher can replace creator.
+	CourseDeactivation struct {
+		CourseID            string
+		StudentWithdrawn    bool
+		WaitlistLeft        bool
+		RejectedRequests    int
+		CollaboratorRemoved bool
+		NewCreatorID        string
+		CreatorOrphaned     bool
+	}
+
+	// EnrollmentRow is result of enrolling student from row of bulk
+	// enrollment, number of row starts from 1.
+	EnrollmentRow struct {
+		Number    int
+		Value     string
+		StudentID string
+		Status    EnrollmentStatus
+	}
+
+	// ViewEvent is fact that student opened course task or
+	// auxiliary material, events are only appended and never changed.
+	ViewEvent struct {
+		CourseID  string
+		StudentID string
+		Item      ViewedItem
+		ViewedAt  time.Time
+	}
+
+	// ViewedItem is either task with number or material with ID.
+	ViewedItem struct {
+		Type       ViewedItemType
+		TaskNumber int
+		MaterialID string
+	}
+
+	// ItemViewers is set of students who opened item at least once.
+	ItemViewers struct {
+		Item       ViewedItem
+		StudentIDs []string
+	}
+
+	// CourseContent is what students of course can open.
+	CourseContent struct {
+		StudentIDs []string
+		Tasks      []GeneralTask
+		Materials  []AuxiliaryMaterial
+	}
+
+	CourseViewsReport struct {
+		Tasks     []ItemViewsReport
+		Materials []ItemViewsReport
+	}
+
+	ItemViewsReport struct {
+		Item              ViewedItem
+		Title             string
+		UniqueViewers     int
+		NotViewedStudents []string
 	}
 
 	Period struct {
@@ -45,8 +287,23 @@ type (
 	}
 
 	TestData struct {
-		InputData  string
-		OutputData string
+		InputData    string
+		OutputData   string
+		InputDataID  string
+		OutputDataID string
+		Visibility   course.TestDataVisibility
+		Explanation  string
+	}
+
+	Checker struct {
+		Mode      course.CheckerMode
+		Tolerance float64
+	}
+
+	ExecutionLimits struct {
+		TimeLimit     time.Duration
+		MemoryLimit   int64
+		MaxSourceSize int
 	}
 
 	TestPoint struct {
@@ -56,3 +313,55 @@ type (
 		SingleCorrectVariant  bool
 	}
 )
+
+type TaskField string
+
+const (
+	TaskTitleField       TaskField = "title"
+	TaskDescriptionField TaskField = "description"
+	TaskTeamWorkField    TaskField = "teamWork"
+	TaskDeadlineField    TaskField = "deadline"
+	TaskTestPointsField  TaskField = "testPoints"
+	TaskTestDataField    TaskField = "testData"
+	TaskCheckerField     TaskField = "checker"
+	TaskLanguagesField   TaskField = "languages"
+	TaskLimitsField      TaskField = "limits"
+)
+
+type ViewedItemType string
+
+const (
+	ViewedTask     ViewedItemType = "task"
+	ViewedMaterial ViewedItemType = "material"
+)
+
+func TaskItem(taskNumber int) ViewedItem {
+	return ViewedItem{Type: ViewedTask, TaskNumber: taskNumber}
+}
+
+func MaterialItem(materialID string) ViewedItem {
+	return ViewedItem{Type: ViewedMaterial, MaterialID: materialID}
+}
+
+const (
+	DefaultPerPage = 20
+	MaxPerPage     = 100
+)
+
+// Offset returns number of items on previous pages.
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+func (p Pagination) IsValid() bool {
+	return p.Page > 0 && p.PerPage > 0 && p.PerPage <= MaxPerPage
+}
+
+type EnrollmentStatus string
+
+const (
+	EnrollmentAdded           EnrollmentStatus = "added"
+	EnrollmentAlreadyEnrolled EnrollmentStatus = "already-enrolled"
+	EnrollmentUnknown         EnrollmentStatus = "unknown"
+	EnrollmentInvalid         EnrollmentStatus = "invalid"
+)
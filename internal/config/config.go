@@ -7,19 +7,67 @@ import (
 )
 
 const (
+	defaultDatabase      = MongoDatabase
 	defaultHTTPPort      = "8080"
 	defaultHTTPRWTimeout = 10 * time.Second
 
+	defaultTaskDescriptionMaxLen = 20000
+
+	defaultMaterialFileMaxSize = 100 << 20
+	defaultMaterialsStorage    = LocalStorage
+	defaultMaterialsLocalDir   = "data/materials"
+
+	defaultEnrollmentConcurrency = 8
+	defaultEnrollmentMaxRows     = 1000
+
+	defaultViewsBufferSize    = 1000
+	defaultViewsFlushInterval = 5 * time.Second
+
+	defaultAcademicsSource         = RosterAcademics
+	defaultRosterReloadInterval    = 5 * time.Second
+	defaultAcademicsDialTimeout    = 5 * time.Second
+	defaultAcademicsRequestTimeout = 3 * time.Second
+
+	defaultResilienceCacheTTL         = 5 * time.Minute
+	defaultResilienceNegativeCacheTTL = 30 * time.Second
+	defaultResilienceStaleTTL         = time.Hour
+	defaultResilienceCacheMaxEntries  = 10000
+	defaultResilienceCallTimeout      = 2 * time.Second
+	defaultResilienceMaxRetries       = 2
+	defaultResilienceRetryBackoff     = 100 * time.Millisecond
+	defaultResilienceRetryMaxBackoff  = time.Second
+	defaultResilienceBreakerThreshold = 5
+	defaultResilienceBreakerCooldown  = 30 * time.Second
+
 	LocalEnv = "local"
+
+	MongoDatabase  = "mongo"
+	MemoryDatabase = "memory"
+
+	LocalStorage = "local"
+	S3Storage    = "s3"
+
+	GRPCAcademics   = "grpc"
+	RosterAcademics = "roster"
 )
 
 type (
 	Config struct {
 		Environment string
+		Database    string
 		Mongo       MongoConfig
 		HTTP        HTTPConfig
+		Tasks       TasksConfig
+		Languages   []LanguageConfig
+		Materials   MaterialsConfig
+		Views       ViewsConfig
+		Enrollment  EnrollmentConfig
+		Academics   AcademicsConfig
 	}
 
+	// MongoConfig describes connection to MongoDB, it's used only
+	// when database is mongo. Memory database keeps courses only
+	// while service runs, e.g. for local runs and tests.
 	MongoConfig struct {
 		URI          string
 		Username     string
@@ -27,11 +75,113 @@ type (
 		DatabaseName string
 	}
 
+	// HTTPConfig describes API server. Debug address is internal
+	// address of expvar metrics that shouldn't be exposed like API,
+	// metrics aren't served when address is empty.
 	HTTPConfig struct {
 		Host         string
 		Port         string
 		ReadTimeout  time.Duration
 		WriteTimeout time.Duration
+		DebugAddress string
+	}
+
+	TasksConfig struct {
+		DescriptionMaxLen int
+	}
+
+	// MaterialsConfig describes where uploaded files of auxiliary
+	// materials are kept, storage is either local or s3.
+	MaterialsConfig struct {
+		FileMaxSize int64
+		Storage     string
+		LocalDir    string
+		S3          S3Config
+	}
+
+	// EnrollmentConfig limits bulk enrollment, concurrency is
+	// number of simultaneous requests to academics service.
+	EnrollmentConfig struct {
+		Concurrency int
+		MaxRows     int
+	}
+
+	// AcademicsConfig describes where teachers, students and groups come from,
+	// source is either grpc academics service or roster file. Request timeout
+	// limits every call to service. Webhook secret signs events of academics
+	// service, webhook is turned off when secret is empty.
+	AcademicsConfig struct {
+		Source         string
+		Roster         RosterConfig
+		Address        string
+		DialTimeout    time.Duration
+		RequestTimeout time.Duration
+		TLS            TLSConfig
+		Resilience     ResilienceConfig
+		WebhookSecret  string
+	}
+
+	// RosterConfig describes yaml, json or csv file of teachers, students
+	// and groups, file is checked for changes by reload interval.
+	RosterConfig struct {
+		File           string
+		ReloadInterval time.Duration
+	}
+
+	// ResilienceConfig describes caching and failure handling of calls to
+	// academics service. Zero TTL turns off caching of found or not found
+	// academics, stale entries are used only while service fails. Call
+	// timeout limits every attempt, breaker opens after threshold failures
+	// in a row and lets trial call through after cooldown.
+	ResilienceConfig struct {
+		CacheTTL         time.Duration
+		NegativeCacheTTL time.Duration
+		StaleTTL         time.Duration
+		CacheMaxEntries  int
+		CallTimeout      time.Duration
+		MaxRetries       int
+		RetryBackoff     time.Duration
+		RetryMaxBackoff  time.Duration
+		BreakerThreshold int
+		BreakerCooldown  time.Duration
+	}
+
+	// TLSConfig describes TLS of client connection. CA file is needed
+	// only when server certificate isn't signed by system CA, cert and
+	// key files are needed only when server verifies clients.
+	TLSConfig struct {
+		Enabled    bool
+		CAFile     string
+		CertFile   string
+		KeyFile    string
+		ServerName string
+	}
+
+	// ViewsConfig describes buffering of view events,
+	// buffered events are appended to storage by interval.
+	ViewsConfig struct {
+		BufferSize    int
+		FlushInterval time.Duration
+	}
+
+	// S3Config describes bucket of S3 compatible storage,
+	// e.g. AWS S3 or self-hosted MinIO.
+	S3Config struct {
+		Endpoint        string
+		Region          string
+		Bucket          string
+		AccessKeyID     string
+		SecretAccessKey string
+	}
+
+	// LanguageConfig describes toolchain of language
+	// that can be allowed for auto code checking tasks.
+	LanguageConfig struct {
+		ID             string
+		Name           string
+		Version        string
+		CompileCommand string
+		RunCommand     string
 	}
 )
 
@@ -57,9 +207,32 @@ func New(configsDir string) (*Config, error) {
 }
 
 func setDefaults() {
+	viper.SetDefault("database", defaultDatabase)
 	viper.SetDefault("http.port", defaultHTTPPort)
 	viper.SetDefault("http.readTimeout", defaultHTTPRWTimeout)
 	viper.SetDefault("http.writeTimeout", defaultHTTPRWTimeout)
+	viper.SetDefault("tasks.descriptionMaxLen", defaultTaskDescriptionMaxLen)
+	viper.SetDefault("materials.fileMaxSize", defaultMaterialFileMaxSize)
+	viper.SetDefault("materials.storage", defaultMaterialsStorage)
+	viper.SetDefault("materials.localDir", defaultMaterialsLocalDir)
+	viper.SetDefault("enrollment.concurrency", defaultEnrollmentConcurrency)
+	viper.SetDefault("enrollment.maxRows", defaultEnrollmentMaxRows)
+	viper.SetDefault("views.bufferSize", defaultViewsBufferSize)
+	viper.SetDefault("views.flushInterval", defaultViewsFlushInterval)
+	viper.SetDefault("academics.source", defaultAcademicsSource)
+	viper.SetDefault("academics.roster.reloadInterval", defaultRosterReloadInterval)
+	viper.SetDefault("academics.dialTimeout", defaultAcademicsDialTimeout)
+	viper.SetDefault("academics.requestTimeout", defaultAcademicsRequestTimeout)
+	viper.SetDefault("academics.resilience.cacheTTL", defaultResilienceCacheTTL)
+	viper.SetDefault("academics.resilience.negativeCacheTTL", defaultResilienceNegativeCacheTTL)
+	viper.SetDefault("academics.resilience.staleTTL", defaultResilienceStaleTTL)
+	viper.SetDefault("academics.resilience.cacheMaxEntries", defaultResilienceCacheMaxEntries)
+	viper.SetDefault("academics.resilience.callTimeout", defaultResilienceCallTimeout)
+	viper.SetDefault("academics.resilience.maxRetries", defaultResilienceMaxRetries)
+	viper.SetDefault("academics.resilience.retryBackoff", defaultResilienceRetryBackoff)
+	viper.SetDefault("academics.resilience.retryMaxBackoff", defaultResilienceRetryMaxBackoff)
+	viper.SetDefault("academics.resilience.breakerThreshold", defaultResilienceBreakerThreshold)
+	viper.SetDefault("academics.resilience.breakerCooldown", defaultResilienceBreakerCooldown)
 }
 
 func parseEnv() error {
@@ -67,6 +240,14 @@ func parseEnv() error {
 		return err
 	}
 
+	if err := parseS3FromEnv(); err != nil {
+		return err
+	}
+
+	if err := parseAcademicsFromEnv(); err != nil {
+		return err
+	}
+
 	return parseAppFromEnv()
 }
 
@@ -84,6 +265,18 @@ func parseMongoFromEnv() error {
 	return viper.BindEnv("password")
 }
 
+func parseS3FromEnv() error {
+	if err := viper.BindEnv("s3AccessKeyID", "S3_ACCESS_KEY_ID"); err != nil {
+		return err
+	}
+
+	return viper.BindEnv("s3SecretAccessKey", "S3_SECRET_ACCESS_KEY")
+}
+
+func parseAcademicsFromEnv() error {
+	return viper.BindEnv("academicsWebhookSecret", "ACADEMICS_WEBHOOK_SECRET")
+}
+
 func parseAppFromEnv() error {
 	viper.SetEnvPrefix("app")
 
@@ -112,12 +305,58 @@ func setFromEnv(cfg *Config) {
 	cfg.Mongo.URI = viper.GetString("uri")
 	cfg.Mongo.Username = viper.GetString("username")
 	cfg.Mongo.Password = viper.GetString("password")
+	cfg.Materials.S3.AccessKeyID = viper.GetString("s3AccessKeyID")
+	cfg.Materials.S3.SecretAccessKey = viper.GetString("s3SecretAccessKey")
+	cfg.Academics.WebhookSecret = viper.GetString("academicsWebhookSecret")
 }
 
 func unmarshal(cfg *Config) error {
+	cfg.Database = viper.GetString("database")
+
 	if err := viper.UnmarshalKey("http", &cfg.HTTP); err != nil {
 		return err
 	}
 
+	if err := viper.UnmarshalKey("tasks", &cfg.Tasks); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("languages", &cfg.Languages); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("materials", &cfg.Materials); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("views", &cfg.Views); err != nil {
+		return err
+	}
+
+	if err := viper.UnmarshalKey("enrollment", &cfg.Enrollment); err != nil {
+		return err
+	}
+
+	if err := unmarshalAcademics(cfg); err != nil {
+		return err
+	}
+
 	return viper.UnmarshalKey("mongo", &cfg.Mongo)
 }
+
+// unmarshalAcademics reads academics from all settings, because
+// UnmarshalKey loses defaults of nested keys when config file sets
+// only some of them.
+func unmarshalAcademics(cfg *Config) error {
+	var settings struct {
+		Academics AcademicsConfig
+	}
+
+	if err := viper.Unmarshal(&settings); err != nil {
+		return err
+	}
+
+	cfg.Academics = settings.Academics
+
+	return nil
+}
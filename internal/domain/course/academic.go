@@ -89,11 +89,17 @@ func (a Academic) IsZero() bool {
 	return a == Academic{}
 }
 
+// Access is permission of teacher in course. TeacherAccess allows
+// to edit tasks, materials and members of course, ReviewAccess allows
+// to review and grade solutions, so to read task versions and views
+// of students, ViewAccess allows only to read course.
 type Access uint8
 
 const (
 	TeacherAccess Access = iota + 1
 	CreatorAccess
+	ReviewAccess
+	ViewAccess
 )
 
 func (a Access) String() string {
@@ -102,11 +108,61 @@ func (a Access) String() string {
 		return "`teacher` access"
 	case CreatorAccess:
 		return "`creator` access"
+	case ReviewAccess:
+		return "`review` access"
+	case ViewAccess:
+		return "`view` access"
 	}
 
 	return "%!Access(" + strconv.Itoa(int(a)) + ")"
 }
 
+// CollaboratorRole defines accesses of collaborator, creator of course has all accesses.
+type CollaboratorRole uint8
+
+const (
+	CoTeacherRole CollaboratorRole = iota + 1
+	AssistantRole
+	ObserverRole
+)
+
+func (r CollaboratorRole) String() string {
+	switch r {
+	case CoTeacherRole:
+		return "co-teacher"
+	case AssistantRole:
+		return "assistant"
+	case ObserverRole:
+		return "observer"
+	}
+
+	return "%!CollaboratorRole(" + strconv.Itoa(int(r)) + ")"
+}
+
+func (r CollaboratorRole) IsValid() bool {
+	switch r {
+	case CoTeacherRole, AssistantRole, ObserverRole:
+		return true
+	}
+
+	return false
+}
+
+// HasAccess reports whether collaborator with role has access,
+// co-teacher has every access except creator one.
+func (r CollaboratorRole) HasAccess(access Access) bool {
+	switch access {
+	case TeacherAccess:
+		return r == CoTeacherRole
+	case ReviewAccess:
+		return r == CoTeacherRole || r == AssistantRole
+	case ViewAccess:
+		return r.IsValid()
+	}
+
+	return false
+}
+
 type AcademicCantEditCourseError struct {
 	academicType AcademicType
 	access       Access
@@ -131,11 +187,11 @@ func IsAcademicCantEditCourseError(err error) bool {
 
 func (c *Course) canAcademicEditWithAccess(academic Academic, access Access) error {
 	if academic.Type() == TeacherType {
-		if access == TeacherAccess && c.hasTeacher(academic.ID()) {
+		if c.hasCreator(academic.ID()) {
 			return nil
 		}
 
-		if access == CreatorAccess && c.hasCreator(academic.ID()) {
+		if role, ok := c.collaborators[academic.ID()]; ok && role.HasAccess(access) {
 			return nil
 		}
 
@@ -145,6 +201,30 @@ func (c *Course) canAcademicEditWithAccess(academic Academic, access Access) err
 	return AcademicCantEditCourseError{academicType: StudentType}
 }
 
+// CanAcademicEditTasks returns AcademicCantEditCourseError
+// if academic can't add or edit tasks of course.
+func (c *Course) CanAcademicEditTasks(academic Academic) error {
+	return c.canAcademicEditWithAccess(academic, TeacherAccess)
+}
+
+// CanAcademicEditStudents returns AcademicCantEditCourseError if academic
+// can't add or remove students of course, their groups, teams and requests.
+func (c *Course) CanAcademicEditStudents(academic Academic) error {
+	return c.canAcademicEditWithAccess(academic, TeacherAccess)
+}
+
+// CanAcademicEditCollaborators returns AcademicCantEditCourseError
+// if academic can't add collaborators to course.
+func (c *Course) CanAcademicEditCollaborators(academic Academic) error {
+	return c.canAcademicEditWithAccess(academic, TeacherAccess)
+}
+
+// canAcademicChangeCollaborators returns AcademicCantEditCourseError
+// if academic can't remove collaborators or change their roles.
+func (c *Course) canAcademicChangeCollaborators(academic Academic) error {
+	return c.canAcademicEditWithAccess(academic, CreatorAccess)
+}
+
 func (a Academic) canCreateCourse() error {
 	if a.Type() == TeacherType {
 		return nil
@@ -2,6 +2,7 @@ package course_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -85,3 +86,411 @@ func TestAcademic_IsZero(t *testing.T) {
 		})
 	}
 }
+
+func TestCourse_CanAcademicEditTasks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Academic    course.Academic
+		ShouldBeErr bool
+	}{
+		{
+			Name:     "creator_can_edit_tasks",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		},
+		{
+			Name:     "collaborator_can_edit_tasks",
+			Academic: course.MustNewAcademic("collaborator-id", course.TeacherType),
+		},
+		{
+			Name:        "other_teacher_cant_edit_tasks",
+			Academic:    course.MustNewAcademic("other-teacher-id", course.TeacherType),
+			ShouldBeErr: true,
+		},
+		{
+			Name:        "student_cant_edit_tasks",
+			Academic:    course.MustNewAcademic("student-id", course.StudentType),
+			ShouldBeErr: true,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withCollaborators("collaborator-id"), withStudents("student-id"))
+
+			err := crs.CanAcademicEditTasks(c.Academic)
+
+			if c.ShouldBeErr {
+				require.Error(t, err)
+				require.True(t, course.IsAcademicCantEditCourseError(err))
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCollaboratorRole_HasAccess(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Role     course.CollaboratorRole
+		Accesses map[course.Access]bool
+	}{
+		{
+			Role: course.CoTeacherRole,
+			Accesses: map[course.Access]bool{
+				course.TeacherAccess: true,
+				course.ReviewAccess:  true,
+				course.ViewAccess:    true,
+			},
+		},
+		{
+			Role: course.AssistantRole,
+			Accesses: map[course.Access]bool{
+				course.ReviewAccess: true,
+				course.ViewAccess:   true,
+			},
+		},
+		{
+			Role: course.ObserverRole,
+			Accesses: map[course.Access]bool{
+				course.ViewAccess: true,
+			},
+		},
+	}
+
+	accesses := []course.Access{course.TeacherAccess, course.CreatorAccess, course.ReviewAccess, course.ViewAccess}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Role.String(), func(t *testing.T) {
+			t.Parallel()
+
+			for _, access := range accesses {
+				require.Equal(t, c.Accesses[access], c.Role.HasAccess(access), access.String())
+			}
+		})
+	}
+}
+
+func TestCourse_collaboratorRoleAccesses(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	newTitle := "New task title"
+
+	roles := []struct {
+		Name      string
+		Role      course.CollaboratorRole
+		CanEdit   bool
+		IsCreator bool
+	}{
+		{
+			Name:      "creator",
+			CanEdit:   true,
+			IsCreator: true,
+		},
+		{
+			Name:    "co_teacher",
+			Role:    course.CoTeacherRole,
+			CanEdit: true,
+		},
+		{
+			Name: "assistant",
+			Role: course.AssistantRole,
+		},
+		{
+			Name: "observer",
+			Role: course.ObserverRole,
+		},
+	}
+
+	operations := []struct {
+		Name        string
+		CreatorOnly bool
+		Do          func(crs *course.Course, academic course.Academic) error
+	}{
+		{
+			Name: "add_manual_checking_task",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				_, err := crs.AddManualCheckingTask(academic, course.ManualCheckingTaskCreationParams{Title: "Task"}, now)
+
+				return err
+			},
+		},
+		{
+			Name: "add_auto_code_checking_task",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				_, err := crs.AddAutoCodeCheckingTask(academic, course.AutoCodeCheckingTaskCreationParams{Title: "Task"}, now)
+
+				return err
+			},
+		},
+		{
+			Name: "add_testing_task",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				_, err := crs.AddTestingTask(academic, course.TestingTaskCreationParams{Title: "Task"}, now)
+
+				return err
+			},
+		},
+		{
+			Name: "rename_task",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RenameTask(academic, 1, newTitle, now)
+			},
+		},
+		{
+			Name: "edit_task",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.EditTask(academic, 1, course.TaskEditParams{Title: &newTitle}, now)
+			},
+		},
+		{
+			Name: "restore_task_version",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RestoreTaskVersion(academic, 1, crs.NewTaskVersions()[0], now)
+			},
+		},
+		{
+			Name: "attach_auxiliary_material",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AttachAuxiliaryMaterial(academic, course.MustNewAuxiliaryMaterial(
+					"other-material-id", "https://example.com/other", course.PresentationResource,
+				))
+			},
+		},
+		{
+			Name: "replace_auxiliary_material",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ReplaceAuxiliaryMaterial(academic, course.MustNewAuxiliaryMaterial(
+					"material-id", "https://example.com/new", course.PresentationResource,
+				))
+			},
+		},
+		{
+			Name: "remove_auxiliary_material",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveAuxiliaryMaterial(academic, "material-id")
+			},
+		},
+		{
+			Name: "reorder_auxiliary_materials",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ReorderAuxiliaryMaterials(academic, []string{"material-id"})
+			},
+		},
+		{
+			Name: "extend_course",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				_, err := crs.Extend(course.CreationParams{ID: "extended-course-id", Creator: academic}, now)
+
+				return err
+			},
+		},
+		{
+			Name: "add_students",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddStudents(academic, now, "student1-id")
+			},
+		},
+		{
+			Name: "remove_student",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveStudent(academic, "student2-id", "", now)
+			},
+		},
+		{
+			Name: "complete_student",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.CompleteStudent(academic, "student1-id")
+			},
+		},
+		{
+			Name: "add_group",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddGroup(academic, "other-group-id", []string{"student1-id"}, now)
+			},
+		},
+		{
+			Name: "remove_group",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveGroup(academic, "group-id", now)
+			},
+		},
+		{
+			Name: "sync_group",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.SyncGroup(academic, "group-id", []string{"group-student-id"}, now)
+			},
+		},
+		{
+			Name: "approve_enrollment_requests",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ApproveEnrollmentRequests(academic, []string{"request-id"}, now)
+			},
+		},
+		{
+			Name: "reject_enrollment_requests",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RejectEnrollmentRequests(academic, []string{"request-id"}, now)
+			},
+		},
+		{
+			Name: "add_student_invitation",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddInvitation(academic, course.MustNewInvitation(
+					"other-code", course.StudentType, now.Add(time.Hour), 1,
+				), now)
+			},
+		},
+		{
+			Name: "add_teacher_invitation",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddInvitation(academic, course.MustNewInvitation(
+					"other-code", course.TeacherType, now.Add(time.Hour), 1,
+				), now)
+			},
+		},
+		{
+			Name: "revoke_invitation",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RevokeInvitation(academic, "student-code")
+			},
+		},
+		{
+			Name: "change_capacity",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ChangeCapacity(academic, 10, now)
+			},
+		},
+		{
+			Name: "remove_from_waitlist",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveFromWaitlist(academic, "waiting-student-id")
+			},
+		},
+		{
+			Name: "change_team_settings",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ChangeTeamSettings(academic, course.MustNewTeamSettings(true, 3))
+			},
+		},
+		{
+			Name: "create_team",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.CreateTeam(academic, "other-team-id", "Other team", "student2-id")
+			},
+		},
+		{
+			Name: "rename_team",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RenameTeam(academic, "team-id", "New team name")
+			},
+		},
+		{
+			Name: "remove_team",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveTeam(academic, "team-id")
+			},
+		},
+		{
+			Name: "add_team_members",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddTeamMembers(academic, "team-id", "student2-id")
+			},
+		},
+		{
+			Name: "remove_team_member",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveTeamMember(academic, "team-id", "student1-id")
+			},
+		},
+		{
+			Name: "add_collaborators",
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.AddCollaborators(academic, "new-teacher-id")
+			},
+		},
+		{
+			Name:        "remove_collaborator",
+			CreatorOnly: true,
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.RemoveCollaborator(academic, "other-teacher-id")
+			},
+		},
+		{
+			Name:        "change_collaborator_role",
+			CreatorOnly: true,
+			Do: func(crs *course.Course, academic course.Academic) error {
+				return crs.ChangeCollaboratorRole(academic, "other-teacher-id", course.AssistantRole)
+			},
+		},
+	}
+
+	for i := range roles {
+		r := roles[i]
+		for j := range operations {
+			o := operations[j]
+			t.Run(r.Name+"_"+o.Name, func(t *testing.T) {
+				t.Parallel()
+
+				crs := newCourseForRoleAccesses(t, creator, now)
+
+				academic := creator
+				if !r.IsCreator {
+					academic = course.MustNewAcademic("collaborator-id", course.TeacherType)
+					require.NoError(t, crs.ChangeCollaboratorRole(creator, academic.ID(), r.Role))
+				}
+
+				err := o.Do(crs, academic)
+
+				if r.CanEdit && (r.IsCreator || !o.CreatorOnly) {
+					require.NoError(t, err)
+
+					return
+				}
+				require.Error(t, err)
+				require.True(t, course.IsAcademicCantEditCourseError(err), "got %v", err)
+			})
+		}
+	}
+}
+
+// newCourseForRoleAccesses returns course where every
+// mutating operation is allowed for its creator.
+func newCourseForRoleAccesses(t *testing.T, creator course.Academic, now time.Time) *course.Course {
+	t.Helper()
+
+	crs := newCourse(
+		t, creator,
+		withStudents("student1-id", "student2-id"),
+		withCollaborators("collaborator-id", "other-teacher-id"),
+	)
+
+	taskNumber := addManualCheckingTaskToCourse(t, creator, crs)
+	require.NoError(t, crs.RenameTask(creator, taskNumber, "Renamed task", now))
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, course.MustNewAuxiliaryMaterial(
+		"material-id", "https://example.com/slides", course.PresentationResource,
+	)))
+	require.NoError(t, crs.AddGroup(creator, "group-id", []string{"group-student-id"}, now))
+	require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(false, 3)))
+	require.NoError(t, crs.CreateTeam(creator, "team-id", "Team", "student1-id"))
+
+	require.NoError(t, crs.ChangeCapacity(creator, 3, now))
+	require.NoError(t, crs.AddInvitation(creator, course.MustNewInvitation(
+		"student-code", course.StudentType, now.Add(time.Hour), 10,
+	), now))
+	require.NoError(t, crs.RedeemInvitation(course.MustNewAcademic("waiting-student-id", course.StudentType), "student-code", now))
+	require.NoError(t, crs.RequestEnrollment(course.MustNewAcademic("requester-id", course.StudentType), "request-id", "", now))
+
+	return crs
+}
@@ -0,0 +1,412 @@
+package course
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ResourceType uint8
+
+const (
+	TrainingManualResource ResourceType = iota + 1
+	VideoResource
+	PresentationResource
+	OtherResource
+)
+
+func (t ResourceType) String() string {
+	switch t {
+	case TrainingManualResource:
+		return "training manual"
+	case VideoResource:
+		return "video"
+	case PresentationResource:
+		return "presentation"
+	case OtherResource:
+		return "other"
+	}
+
+	return "%!ResourceType(" + strconv.Itoa(int(t)) + ")"
+}
+
+func (t ResourceType) IsValid() bool {
+	switch t {
+	case TrainingManualResource, VideoResource, PresentationResource, OtherResource:
+		return true
+	}
+
+	return false
+}
+
+// requiresURL reports whether resource of the type should be
+// link to the web page, other resources may be arbitrary text.
+func (t ResourceType) requiresURL() bool {
+	return t != OtherResource
+}
+
+// AuxiliaryMaterial is additional material of the course,
+// e.g. link to training manual, lecture video or slides.
+// Material may be uploaded file, then its resource is file name.
+// Material may be attached to the task, otherwise it's course-level.
+// Students get material only after it's released, see Status.
+type AuxiliaryMaterial struct {
+	id           string
+	resource     string
+	resourceType ResourceType
+	file         MaterialFile
+	taskNumber   int
+	releaseTime  time.Time
+	hidden       bool
+}
+
+// MaterialFile describes uploaded file of auxiliary material,
+// content of the file is kept in blob storage under the key.
+type MaterialFile struct {
+	key         string
+	name        string
+	contentType string
+	size        int64
+}
+
+const (
+	resourceMaxLen         = 2048
+	materialFileNameMaxLen = 255
+)
+
+var (
+	ErrEmptyAuxiliaryMaterialID = errors.New("empty auxiliary material id")
+	ErrEmptyResource            = errors.New("empty auxiliary material resource")
+	ErrResourceTooLong          = errors.New("auxiliary material resource too long")
+	ErrInvalidResourceType      = errors.New("invalid auxiliary material resource type")
+	ErrResourceIsNotURL         = errors.New("auxiliary material resource isn't http or https url")
+	ErrCourseHasNoSuchMaterial  = errors.New("course has no such auxiliary material")
+	ErrCourseAlreadyHasMaterial = errors.New("course already has auxiliary material with such id")
+
+	ErrEmptyMaterialFileKey     = errors.New("empty material file key")
+	ErrEmptyMaterialFileName    = errors.New("empty material file name")
+	ErrMaterialFileNameTooLong  = errors.New("material file name too long")
+	ErrInvalidMaterialFileName  = errors.New("material file name contains path separators or control characters")
+	ErrEmptyMaterialFileContent = errors.New("empty material file content")
+)
+
+func IsInvalidAuxiliaryMaterialError(err error) bool {
+	return errors.Is(err, ErrEmptyAuxiliaryMaterialID) ||
+		errors.Is(err, ErrEmptyResource) ||
+		errors.Is(err, ErrResourceTooLong) ||
+		errors.Is(err, ErrInvalidResourceType) ||
+		errors.Is(err, ErrResourceIsNotURL) ||
+		errors.Is(err, ErrEmptyMaterialFileKey) ||
+		errors.Is(err, ErrEmptyMaterialFileName) ||
+		errors.Is(err, ErrMaterialFileNameTooLong) ||
+		errors.Is(err, ErrInvalidMaterialFileName) ||
+		errors.Is(err, ErrEmptyMaterialFileContent)
+}
+
+func NewAuxiliaryMaterial(id, resource string, resourceType ResourceType) (AuxiliaryMaterial, error) {
+	if id == "" {
+		return AuxiliaryMaterial{}, ErrEmptyAuxiliaryMaterialID
+	}
+
+	if resource == "" {
+		return AuxiliaryMaterial{}, ErrEmptyResource
+	}
+
+	if len(resource) > resourceMaxLen {
+		return AuxiliaryMaterial{}, ErrResourceTooLong
+	}
+
+	if !resourceType.IsValid() {
+		return AuxiliaryMaterial{}, ErrInvalidResourceType
+	}
+
+	if resourceType.requiresURL() && !isWebURL(resource) {
+		return AuxiliaryMaterial{}, ErrResourceIsNotURL
+	}
+
+	return AuxiliaryMaterial{
+		id:           id,
+		resource:     resource,
+		resourceType: resourceType,
+	}, nil
+}
+
+func MustNewAuxiliaryMaterial(id, resource string, resourceType ResourceType) AuxiliaryMaterial {
+	material, err := NewAuxiliaryMaterial(id, resource, resourceType)
+	if err != nil {
+		panic(err)
+	}
+
+	return material
+}
+
+// NewFileAuxiliaryMaterial creates material from uploaded file,
+// file materials of any resource type don't require URL.
+func NewFileAuxiliaryMaterial(id string, file MaterialFile, resourceType ResourceType) (AuxiliaryMaterial, error) {
+	if id == "" {
+		return AuxiliaryMaterial{}, ErrEmptyAuxiliaryMaterialID
+	}
+
+	if file.IsZero() {
+		return AuxiliaryMaterial{}, ErrEmptyMaterialFileKey
+	}
+
+	if !resourceType.IsValid() {
+		return AuxiliaryMaterial{}, ErrInvalidResourceType
+	}
+
+	return AuxiliaryMaterial{
+		id:           id,
+		resource:     file.name,
+		resourceType: resourceType,
+		file:         file,
+	}, nil
+}
+
+func MustNewFileAuxiliaryMaterial(id string, file MaterialFile, resourceType ResourceType) AuxiliaryMaterial {
+	material, err := NewFileAuxiliaryMaterial(id, file, resourceType)
+	if err != nil {
+		panic(err)
+	}
+
+	return material
+}
+
+func isWebURL(resource string) bool {
+	u, err := url.ParseRequestURI(resource)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (m AuxiliaryMaterial) ID() string {
+	return m.id
+}
+
+func (m AuxiliaryMaterial) Resource() string {
+	return m.resource
+}
+
+func (m AuxiliaryMaterial) ResourceType() ResourceType {
+	return m.resourceType
+}
+
+// TaskNumber returns number of task material is
+// attached to or zero if material is course-level.
+func (m AuxiliaryMaterial) TaskNumber() int {
+	return m.taskNumber
+}
+
+// ForTask returns copy of material attached to the task with
+// given number, zero number makes material course-level.
+func (m AuxiliaryMaterial) ForTask(taskNumber int) AuxiliaryMaterial {
+	m.taskNumber = taskNumber
+
+	return m
+}
+
+// File returns uploaded file of material and
+// false if material isn't file, e.g. it's link.
+func (m AuxiliaryMaterial) File() (MaterialFile, bool) {
+	return m.file, !m.file.IsZero()
+}
+
+func (m AuxiliaryMaterial) IsZero() bool {
+	return m == AuxiliaryMaterial{}
+}
+
+func NewMaterialFile(key, name, contentType string, size int64) (MaterialFile, error) {
+	if key == "" {
+		return MaterialFile{}, ErrEmptyMaterialFileKey
+	}
+
+	if name == "" {
+		return MaterialFile{}, ErrEmptyMaterialFileName
+	}
+
+	if len(name) > materialFileNameMaxLen {
+		return MaterialFile{}, ErrMaterialFileNameTooLong
+	}
+
+	if !isPlainFileName(name) {
+		return MaterialFile{}, ErrInvalidMaterialFileName
+	}
+
+	if size <= 0 {
+		return MaterialFile{}, ErrEmptyMaterialFileContent
+	}
+
+	return MaterialFile{
+		key:         key,
+		name:        name,
+		contentType: contentType,
+		size:        size,
+	}, nil
+}
+
+func MustNewMaterialFile(key, name, contentType string, size int64) MaterialFile {
+	file, err := NewMaterialFile(key, name, contentType, size)
+	if err != nil {
+		panic(err)
+	}
+
+	return file
+}
+
+func isPlainFileName(name string) bool {
+	if name == "." || name == ".." {
+		return false
+	}
+
+	return !strings.ContainsAny(name, "/\\") && strings.IndexFunc(name, func(r rune) bool {
+		return r < 0x20 || r == 0x7f
+	}) == -1
+}
+
+func (f MaterialFile) Key() string {
+	return f.key
+}
+
+func (f MaterialFile) Name() string {
+	return f.name
+}
+
+func (f MaterialFile) ContentType() string {
+	return f.contentType
+}
+
+func (f MaterialFile) Size() int64 {
+	return f.size
+}
+
+func (f MaterialFile) IsZero() bool {
+	return f == MaterialFile{}
+}
+
+func (c *Course) AuxiliaryMaterials() []AuxiliaryMaterial {
+	materialsCopy := make([]AuxiliaryMaterial, len(c.materials))
+	copy(materialsCopy, c.materials)
+
+	return materialsCopy
+}
+
+func (c *Course) AuxiliaryMaterial(materialID string) (AuxiliaryMaterial, error) {
+	i, err := c.materialIndex(materialID)
+	if err != nil {
+		return AuxiliaryMaterial{}, err
+	}
+
+	return c.materials[i], nil
+}
+
+func (c *Course) AttachAuxiliaryMaterial(academic Academic, material AuxiliaryMaterial) error {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
+		return err
+	}
+
+	if material.IsZero() {
+		return ErrEmptyAuxiliaryMaterialID
+	}
+
+	if _, err := c.materialIndex(material.ID()); err == nil {
+		return ErrCourseAlreadyHasMaterial
+	}
+
+	if err := c.checkMaterialTask(material); err != nil {
+		return err
+	}
+
+	c.materials = append(c.materials, material)
+
+	return nil
+}
+
+func (c *Course) ReplaceAuxiliaryMaterial(academic Academic, material AuxiliaryMaterial) error {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
+		return err
+	}
+
+	i, err := c.materialIndex(material.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkMaterialTask(material); err != nil {
+		return err
+	}
+
+	c.materials[i] = material
+
+	return nil
+}
+
+func (c *Course) RemoveAuxiliaryMaterial(academic Academic, materialID string) error {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
+		return err
+	}
+
+	i, err := c.materialIndex(materialID)
+	if err != nil {
+		return err
+	}
+
+	materials := make([]AuxiliaryMaterial, 0, len(c.materials)-1)
+	materials = append(materials, c.materials[:i]...)
+	c.materials = append(materials, c.materials[i+1:]...)
+
+	return nil
+}
+
+// TaskAuxiliaryMaterials returns materials attached to the task.
+func (c *Course) TaskAuxiliaryMaterials(taskNumber int) ([]AuxiliaryMaterial, error) {
+	if _, err := c.obtainTask(taskNumber); err != nil {
+		return nil, err
+	}
+
+	var materials []AuxiliaryMaterial
+	for _, m := range c.materials {
+		if m.taskNumber == taskNumber {
+			materials = append(materials, m)
+		}
+	}
+
+	return materials, nil
+}
+
+func (c *Course) checkMaterialTask(material AuxiliaryMaterial) error {
+	if material.taskNumber == 0 {
+		return nil
+	}
+
+	_, err := c.obtainTask(material.taskNumber)
+
+	return err
+}
+
+// renumberedMaterials returns copy of materials attached to tasks with
+// new numbers, materials of tasks missing in numbers become course-level.
+func (c *Course) renumberedMaterials(numbers map[int]int) []AuxiliaryMaterial {
+	materials := c.AuxiliaryMaterials()
+	for i, m := range materials {
+		if m.taskNumber != 0 {
+			materials[i].taskNumber = numbers[m.taskNumber]
+		}
+	}
+
+	return materials
+}
+
+func (c *Course) materialIndex(materialID string) (int, error) {
+	for i, m := range c.materials {
+		if m.id == materialID {
+			return i, nil
+		}
+	}
+
+	return 0, ErrCourseHasNoSuchMaterial
+}
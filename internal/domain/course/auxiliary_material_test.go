@@ -0,0 +1,356 @@
+package course_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestNewAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		ID           string
+		Resource     string
+		ResourceType course.ResourceType
+		ExpectedErr  error
+	}{
+		{
+			Name:         "valid_video",
+			ID:           "material-id",
+			Resource:     "https://video.example.com/lectures/1",
+			ResourceType: course.VideoResource,
+		},
+		{
+			Name:         "other_resource_may_be_text",
+			ID:           "material-id",
+			Resource:     "Knuth, The Art of Computer Programming, vol. 1",
+			ResourceType: course.OtherResource,
+		},
+		{
+			Name:         "empty_id",
+			Resource:     "https://example.com/manual.pdf",
+			ResourceType: course.TrainingManualResource,
+			ExpectedErr:  course.ErrEmptyAuxiliaryMaterialID,
+		},
+		{
+			Name:         "empty_resource",
+			ID:           "material-id",
+			ResourceType: course.OtherResource,
+			ExpectedErr:  course.ErrEmptyResource,
+		},
+		{
+			Name:         "resource_too_long",
+			ID:           "material-id",
+			Resource:     "https://example.com/" + strings.Repeat("x", 2048),
+			ResourceType: course.PresentationResource,
+			ExpectedErr:  course.ErrResourceTooLong,
+		},
+		{
+			Name:         "invalid_resource_type",
+			ID:           "material-id",
+			Resource:     "https://example.com/",
+			ResourceType: course.ResourceType(0),
+			ExpectedErr:  course.ErrInvalidResourceType,
+		},
+		{
+			Name:         "presentation_isnt_url",
+			ID:           "material-id",
+			Resource:     "slides of the first lecture",
+			ResourceType: course.PresentationResource,
+			ExpectedErr:  course.ErrResourceIsNotURL,
+		},
+		{
+			Name:         "video_with_not_web_scheme",
+			ID:           "material-id",
+			Resource:     "ftp://example.com/lecture.mp4",
+			ResourceType: course.VideoResource,
+			ExpectedErr:  course.ErrResourceIsNotURL,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			material, err := course.NewAuxiliaryMaterial(c.ID, c.Resource, c.ResourceType)
+
+			if c.ExpectedErr != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, c.ExpectedErr))
+				require.True(t, course.IsInvalidAuxiliaryMaterialError(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.ID, material.ID())
+			require.Equal(t, c.Resource, material.Resource())
+			require.Equal(t, c.ResourceType, material.ResourceType())
+		})
+	}
+}
+
+func TestNewMaterialFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Key         string
+		FileName    string
+		Size        int64
+		ExpectedErr error
+	}{
+		{
+			Name:     "valid_file",
+			Key:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			FileName: "Lecture 1. Sorting.pdf",
+			Size:     1024,
+		},
+		{
+			Name:        "empty_key",
+			FileName:    "slides.pdf",
+			Size:        1024,
+			ExpectedErr: course.ErrEmptyMaterialFileKey,
+		},
+		{
+			Name:        "empty_name",
+			Key:         "blob-key",
+			Size:        1024,
+			ExpectedErr: course.ErrEmptyMaterialFileName,
+		},
+		{
+			Name:        "name_too_long",
+			Key:         "blob-key",
+			FileName:    strings.Repeat("x", 252) + ".pdf",
+			Size:        1024,
+			ExpectedErr: course.ErrMaterialFileNameTooLong,
+		},
+		{
+			Name:        "name_with_path",
+			Key:         "blob-key",
+			FileName:    "../../etc/passwd",
+			Size:        1024,
+			ExpectedErr: course.ErrInvalidMaterialFileName,
+		},
+		{
+			Name:        "name_with_control_characters",
+			Key:         "blob-key",
+			FileName:    "slides\r\n.pdf",
+			Size:        1024,
+			ExpectedErr: course.ErrInvalidMaterialFileName,
+		},
+		{
+			Name:        "empty_content",
+			Key:         "blob-key",
+			FileName:    "slides.pdf",
+			ExpectedErr: course.ErrEmptyMaterialFileContent,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := course.NewMaterialFile(c.Key, c.FileName, "application/pdf", c.Size)
+
+			if c.ExpectedErr != nil {
+				require.True(t, errors.Is(err, c.ExpectedErr))
+				require.True(t, course.IsInvalidAuxiliaryMaterialError(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Key, file.Key())
+			require.Equal(t, c.FileName, file.Name())
+			require.Equal(t, "application/pdf", file.ContentType())
+			require.Equal(t, c.Size, file.Size())
+		})
+	}
+}
+
+func TestNewFileAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	file := course.MustNewMaterialFile("blob-key", "slides.pdf", "application/pdf", 2048)
+
+	material, err := course.NewFileAuxiliaryMaterial("material-id", file, course.PresentationResource)
+	require.NoError(t, err)
+	require.Equal(t, "slides.pdf", material.Resource())
+
+	materialFile, ok := material.File()
+	require.True(t, ok)
+	require.Equal(t, file, materialFile)
+
+	_, err = course.NewFileAuxiliaryMaterial("material-id", course.MaterialFile{}, course.PresentationResource)
+	require.True(t, errors.Is(err, course.ErrEmptyMaterialFileKey))
+
+	link := course.MustNewAuxiliaryMaterial("link-id", "https://example.com/slides", course.PresentationResource)
+	_, ok = link.File()
+	require.False(t, ok)
+}
+
+func TestCourse_AttachAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	material := course.MustNewAuxiliaryMaterial("material-id", "https://example.com/slides", course.PresentationResource)
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Prepare  func(academic course.Academic, crs *course.Course)
+		IsErr    func(err error) bool
+	}{
+		{
+			Name:     "attach_material",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		},
+		{
+			Name:     "collaborator_attaches_material",
+			Academic: course.MustNewAcademic("collaborator-id", course.TeacherType),
+		},
+		{
+			Name:     "student_cant_attach_material",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:     "material_already_attached",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Prepare: func(academic course.Academic, crs *course.Course) {
+				require.NoError(t, crs.AttachAuxiliaryMaterial(academic, material))
+			},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseAlreadyHasMaterial)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withCollaborators("collaborator-id"), withStudents("student-id"))
+			if c.Prepare != nil {
+				c.Prepare(creator, crs)
+			}
+
+			err := crs.AttachAuxiliaryMaterial(c.Academic, material)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []course.AuxiliaryMaterial{material}, crs.AuxiliaryMaterials())
+		})
+	}
+}
+
+func TestCourse_ReplaceAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	err := crs.AttachAuxiliaryMaterial(
+		creator,
+		course.MustNewAuxiliaryMaterial("material-id", "https://example.com/video", course.VideoResource),
+	)
+	require.NoError(t, err)
+
+	replacement := course.MustNewAuxiliaryMaterial("material-id", "Textbook, chapter 3", course.OtherResource)
+	err = crs.ReplaceAuxiliaryMaterial(creator, replacement)
+	require.NoError(t, err)
+	material, err := crs.AuxiliaryMaterial("material-id")
+	require.NoError(t, err)
+	require.Equal(t, replacement, material)
+
+	err = crs.ReplaceAuxiliaryMaterial(
+		creator,
+		course.MustNewAuxiliaryMaterial("other-id", "Textbook", course.OtherResource),
+	)
+	require.True(t, errors.Is(err, course.ErrCourseHasNoSuchMaterial))
+}
+
+func TestCourse_RemoveAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	first := course.MustNewAuxiliaryMaterial("first-id", "https://example.com/1", course.VideoResource)
+	second := course.MustNewAuxiliaryMaterial("second-id", "https://example.com/2", course.VideoResource)
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, first))
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, second))
+
+	err := crs.RemoveAuxiliaryMaterial(course.MustNewAcademic("student-id", course.StudentType), "first-id")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+
+	err = crs.RemoveAuxiliaryMaterial(creator, "first-id")
+	require.NoError(t, err)
+	require.Equal(t, []course.AuxiliaryMaterial{second}, crs.AuxiliaryMaterials())
+
+	err = crs.RemoveAuxiliaryMaterial(creator, "first-id")
+	require.True(t, errors.Is(err, course.ErrCourseHasNoSuchMaterial))
+}
+
+func TestCourse_AttachAuxiliaryMaterial_toTask(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	taskNumber := addManualCheckingTaskToCourse(t, creator, crs)
+	courseLevel := course.MustNewAuxiliaryMaterial("course-level-id", "https://example.com/book", course.OtherResource)
+	forTask := course.MustNewAuxiliaryMaterial("for-task-id", "https://example.com/video", course.VideoResource).
+		ForTask(taskNumber)
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, courseLevel))
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, forTask))
+
+	materials, err := crs.TaskAuxiliaryMaterials(taskNumber)
+	require.NoError(t, err)
+	require.Equal(t, []course.AuxiliaryMaterial{forTask}, materials)
+
+	_, err = crs.TaskAuxiliaryMaterials(taskNumber + 1)
+	require.True(t, errors.Is(err, course.ErrCourseHasNoSuchTask))
+
+	missingTask := course.MustNewAuxiliaryMaterial("missing-task-id", "https://example.com/1", course.VideoResource).
+		ForTask(taskNumber + 1)
+	err = crs.AttachAuxiliaryMaterial(creator, missingTask)
+	require.True(t, errors.Is(err, course.ErrCourseHasNoSuchTask))
+
+	err = crs.ReplaceAuxiliaryMaterial(creator, courseLevel.ForTask(taskNumber+1))
+	require.True(t, errors.Is(err, course.ErrCourseHasNoSuchTask))
+
+	require.NoError(t, crs.ReplaceAuxiliaryMaterial(creator, forTask.ForTask(0)))
+	materials, err = crs.TaskAuxiliaryMaterials(taskNumber)
+	require.NoError(t, err)
+	require.Empty(t, materials)
+}
+
+func TestCourse_Extend_keepsTaskMaterials(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	_ = addManualCheckingTaskToCourse(t, creator, crs)
+	taskNumber := addTestingTaskToCourse(t, creator, crs)
+	material := course.MustNewAuxiliaryMaterial("material-id", "https://example.com/video", course.VideoResource).
+		ForTask(taskNumber)
+	require.NoError(t, crs.AttachAuxiliaryMaterial(creator, material))
+
+	extended, err := crs.Extend(course.CreationParams{ID: "extended-course-id", Creator: creator}, time.Now())
+	require.NoError(t, err)
+
+	materials, err := extended.TaskAuxiliaryMaterials(taskNumber)
+	require.NoError(t, err)
+	require.Equal(t, []course.AuxiliaryMaterial{material}, materials)
+}
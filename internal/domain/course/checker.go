@@ -0,0 +1,175 @@
+package course
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type CheckerMode uint8
+
+const (
+	ExactChecker CheckerMode = iota + 1
+	TokensChecker
+	FloatChecker
+	UnorderedLinesChecker
+)
+
+func (m CheckerMode) String() string {
+	switch m {
+	case ExactChecker:
+		return "exact"
+	case TokensChecker:
+		return "tokens"
+	case FloatChecker:
+		return "float"
+	case UnorderedLinesChecker:
+		return "unordered lines"
+	}
+
+	return "%!CheckerMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+func (m CheckerMode) IsValid() bool {
+	switch m {
+	case ExactChecker, TokensChecker, FloatChecker, UnorderedLinesChecker:
+		return true
+	}
+
+	return false
+}
+
+// Checker compares expected output of auto code checking task
+// test with output of student solution.
+type Checker struct {
+	mode      CheckerMode
+	tolerance float64
+}
+
+var (
+	ErrInvalidCheckerMode       = errors.New("invalid checker mode")
+	ErrInvalidCheckerTolerance  = errors.New("invalid checker tolerance")
+	ErrCheckerToleranceNotFloat = errors.New("checker tolerance is only for float mode")
+)
+
+func IsInvalidCheckerError(err error) bool {
+	return errors.Is(err, ErrInvalidCheckerMode) ||
+		errors.Is(err, ErrInvalidCheckerTolerance) ||
+		errors.Is(err, ErrCheckerToleranceNotFloat)
+}
+
+// NewChecker creates checker with given mode, tolerance
+// is used only by FloatChecker and must be zero for other modes.
+func NewChecker(mode CheckerMode, tolerance float64) (Checker, error) {
+	if !mode.IsValid() {
+		return Checker{}, ErrInvalidCheckerMode
+	}
+
+	if tolerance < 0 || math.IsNaN(tolerance) || math.IsInf(tolerance, 0) {
+		return Checker{}, ErrInvalidCheckerTolerance
+	}
+
+	if mode != FloatChecker && tolerance != 0 {
+		return Checker{}, ErrCheckerToleranceNotFloat
+	}
+
+	return Checker{mode: mode, tolerance: tolerance}, nil
+}
+
+func MustNewChecker(mode CheckerMode, tolerance float64) Checker {
+	checker, err := NewChecker(mode, tolerance)
+	if err != nil {
+		panic(err)
+	}
+
+	return checker
+}
+
+// DefaultChecker compares outputs exactly.
+func DefaultChecker() Checker {
+	return Checker{mode: ExactChecker}
+}
+
+func (c Checker) Mode() CheckerMode {
+	return c.mode
+}
+
+func (c Checker) Tolerance() float64 {
+	return c.tolerance
+}
+
+func (c Checker) IsZero() bool {
+	return c == Checker{}
+}
+
+// Check reports whether actual output matches expected.
+// Zero checker compares outputs exactly.
+func (c Checker) Check(expected, actual string) bool {
+	switch c.mode {
+	case TokensChecker:
+		return equalStrings(strings.Fields(expected), strings.Fields(actual))
+	case FloatChecker:
+		return c.checkFloats(strings.Fields(expected), strings.Fields(actual))
+	case UnorderedLinesChecker:
+		return equalStrings(sortedLines(expected), sortedLines(actual))
+	case ExactChecker:
+	}
+
+	return expected == actual
+}
+
+func (c Checker) checkFloats(expectedTokens, actualTokens []string) bool {
+	if len(expectedTokens) != len(actualTokens) {
+		return false
+	}
+
+	for i := range expectedTokens {
+		if expectedTokens[i] == actualTokens[i] {
+			continue
+		}
+
+		expectedNumber, err := strconv.ParseFloat(expectedTokens[i], 64)
+		if err != nil {
+			return false
+		}
+
+		actualNumber, err := strconv.ParseFloat(actualTokens[i], 64)
+		if err != nil {
+			return false
+		}
+
+		if math.Abs(expectedNumber-actualNumber) > c.tolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedLines(output string) []string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+
+	sort.Strings(lines)
+
+	return lines
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
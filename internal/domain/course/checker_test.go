@@ -0,0 +1,166 @@
+package course_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestNewChecker(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Mode        course.CheckerMode
+		Tolerance   float64
+		ExpectedErr error
+	}{
+		{
+			Name: "valid_exact_checker",
+			Mode: course.ExactChecker,
+		},
+		{
+			Name:      "valid_float_checker",
+			Mode:      course.FloatChecker,
+			Tolerance: 1e-6,
+		},
+		{
+			Name:        "invalid_checker_mode",
+			Mode:        course.CheckerMode(0),
+			ExpectedErr: course.ErrInvalidCheckerMode,
+		},
+		{
+			Name:        "negative_tolerance",
+			Mode:        course.FloatChecker,
+			Tolerance:   -0.1,
+			ExpectedErr: course.ErrInvalidCheckerTolerance,
+		},
+		{
+			Name:        "nan_tolerance",
+			Mode:        course.FloatChecker,
+			Tolerance:   math.NaN(),
+			ExpectedErr: course.ErrInvalidCheckerTolerance,
+		},
+		{
+			Name:        "tolerance_for_not_float_checker",
+			Mode:        course.TokensChecker,
+			Tolerance:   0.5,
+			ExpectedErr: course.ErrCheckerToleranceNotFloat,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			checker, err := course.NewChecker(c.Mode, c.Tolerance)
+
+			if c.ExpectedErr != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, c.ExpectedErr))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Mode, checker.Mode())
+			require.Equal(t, c.Tolerance, checker.Tolerance())
+		})
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Checker       course.Checker
+		Expected      string
+		Actual        string
+		ShouldBeMatch bool
+	}{
+		{
+			Name:          "exact_match",
+			Checker:       course.MustNewChecker(course.ExactChecker, 0),
+			Expected:      "1 2\n3",
+			Actual:        "1 2\n3",
+			ShouldBeMatch: true,
+		},
+		{
+			Name:          "exact_mismatch_by_whitespace",
+			Checker:       course.MustNewChecker(course.ExactChecker, 0),
+			Expected:      "1 2\n3",
+			Actual:        "1  2\n3\n",
+			ShouldBeMatch: false,
+		},
+		{
+			Name:          "zero_checker_is_exact",
+			Checker:       course.Checker{},
+			Expected:      "42",
+			Actual:        "42 ",
+			ShouldBeMatch: false,
+		},
+		{
+			Name:          "tokens_match_ignoring_whitespace",
+			Checker:       course.MustNewChecker(course.TokensChecker, 0),
+			Expected:      "1 2\n3",
+			Actual:        "  1\t2 3\n\n",
+			ShouldBeMatch: true,
+		},
+		{
+			Name:          "tokens_mismatch",
+			Checker:       course.MustNewChecker(course.TokensChecker, 0),
+			Expected:      "1 2 3",
+			Actual:        "1 2",
+			ShouldBeMatch: false,
+		},
+		{
+			Name:          "floats_match_within_tolerance",
+			Checker:       course.MustNewChecker(course.FloatChecker, 1e-3),
+			Expected:      "3.1415 answer",
+			Actual:        "3.1412 answer",
+			ShouldBeMatch: true,
+		},
+		{
+			Name:          "floats_mismatch_out_of_tolerance",
+			Checker:       course.MustNewChecker(course.FloatChecker, 1e-3),
+			Expected:      "3.1415",
+			Actual:        "3.14",
+			ShouldBeMatch: false,
+		},
+		{
+			Name:          "floats_mismatch_not_number",
+			Checker:       course.MustNewChecker(course.FloatChecker, 1e-3),
+			Expected:      "3.1415",
+			Actual:        "pi",
+			ShouldBeMatch: false,
+		},
+		{
+			Name:          "unordered_lines_match",
+			Checker:       course.MustNewChecker(course.UnorderedLinesChecker, 0),
+			Expected:      "a\nb\nc\n",
+			Actual:        "c\na \nb",
+			ShouldBeMatch: true,
+		},
+		{
+			Name:          "unordered_lines_mismatch",
+			Checker:       course.MustNewChecker(course.UnorderedLinesChecker, 0),
+			Expected:      "a\nb\nb",
+			Actual:        "a\na\nb",
+			ShouldBeMatch: false,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, c.ShouldBeMatch, c.Checker.Check(c.Expected, c.Actual))
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package course
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 type Course struct {
 	id      string
@@ -9,11 +13,28 @@ type Course struct {
 	started bool
 
 	creatorID     string
-	collaborators map[string]bool
+	collaborators map[string]CollaboratorRole
 	students      map[string]bool
+	enrollments   map[string][]StudentEnrollment
+
+	capacity   int
+	waitlist   []WaitlistEntry
+	promotions []WaitlistPromotion
+
+	groups        map[string]bool
+	studentGroups map[string]string
+
+	invitations        map[string]Invitation
+	enrollmentRequests map[string]EnrollmentRequest
+
+	teams        map[string]Team
+	teamSettings TeamSettings
 
 	tasks          map[int]*Task
 	nextTaskNumber int
+	taskVersions   []TaskVersion
+
+	materials []AuxiliaryMaterial
 }
 
 type CreationParams struct {
@@ -62,20 +83,24 @@ func NewCourse(params CreationParams) (*Course, error) {
 	}
 
 	crs := &Course{
-		id:             params.ID,
-		creatorID:      params.Creator.ID(),
-		title:          params.Title,
-		period:         params.Period,
-		started:        params.Started,
-		collaborators:  make(map[string]bool, len(params.Collaborators)),
-		students:       make(map[string]bool, len(params.Students)),
-		tasks:          make(map[int]*Task),
-		nextTaskNumber: 1,
+		id:                 params.ID,
+		creatorID:          params.Creator.ID(),
+		title:              params.Title,
+		period:             params.Period,
+		started:            params.Started,
+		collaborators:      make(map[string]CollaboratorRole, len(params.Collaborators)),
+		students:           make(map[string]bool, len(params.Students)),
+		enrollments:        make(map[string][]StudentEnrollment),
+		groups:             make(map[string]bool),
+		studentGroups:      make(map[string]string),
+		invitations:        make(map[string]Invitation),
+		enrollmentRequests: make(map[string]EnrollmentRequest),
+		teams:              make(map[string]Team),
+		tasks:              make(map[int]*Task),
+		nextTaskNumber:     1,
 	}
 
-	for _, c := range params.Collaborators {
-		crs.collaborators[c] = true
-	}
+	crs.putCollaborators(params.Collaborators)
 
 	for _, s := range params.Students {
 		crs.students[s] = true
@@ -84,7 +109,11 @@ func NewCourse(params CreationParams) (*Course, error) {
 	return crs, nil
 }
 
-func (c *Course) Extend(params CreationParams) (*Course, error) {
+// Extend returns new course with tasks, materials, collaborators and students
+// of course. Students are enrolled to new course at now, their enrollment
+// history stays in course. Version history of tasks stays in course too,
+// tasks of new course start from first version committed by creator at now.
+func (c *Course) Extend(params CreationParams, now time.Time) (*Course, error) {
 	if params.ID == "" {
 		return nil, ErrEmptyCourseID
 	}
@@ -97,7 +126,7 @@ func (c *Course) Extend(params CreationParams) (*Course, error) {
 		return nil, err
 	}
 
-	if err := c.canAcademicEditWithAccess(params.Creator, TeacherAccess); err != nil {
+	if err := c.CanAcademicEditTasks(params.Creator); err != nil {
 		return nil, err
 	}
 
@@ -112,23 +141,39 @@ func (c *Course) Extend(params CreationParams) (*Course, error) {
 	}
 
 	crs := &Course{
-		id:             params.ID,
-		creatorID:      params.Creator.ID(),
-		title:          extendedCourseTitle,
-		period:         extendedCoursePeriod,
-		started:        params.Started,
-		collaborators:  unmarshalIDs(append(c.Collaborators(), params.Collaborators...)),
-		students:       unmarshalIDs(append(c.Students(), params.Students...)),
-		tasks:          make(map[int]*Task, len(c.tasks)),
-		nextTaskNumber: len(c.tasks) + 1,
+		id:                 params.ID,
+		creatorID:          params.Creator.ID(),
+		title:              extendedCourseTitle,
+		period:             extendedCoursePeriod,
+		started:            params.Started,
+		collaborators:      unmarshalCollaborators(append(c.Collaborators(), params.Collaborators...), c.collaborators),
+		students:           make(map[string]bool, len(c.students)+len(params.Students)),
+		enrollments:        make(map[string][]StudentEnrollment),
+		groups:             unmarshalIDs(c.Groups()),
+		studentGroups:      c.StudentGroups(),
+		capacity:           c.capacity,
+		invitations:        make(map[string]Invitation),
+		enrollmentRequests: make(map[string]EnrollmentRequest),
+		teams:              make(map[string]Team),
+		teamSettings:       c.teamSettings,
+		tasks:              make(map[int]*Task, len(c.tasks)),
+		nextTaskNumber:     len(c.tasks) + 1,
 	}
 
+	crs.putStudents(append(c.Students(), params.Students...), now)
+
+	numbers := make(map[int]int, len(c.tasks))
+
 	for i, t := range c.tasksCopy() {
 		number := i + 1
+		numbers[t.number] = number
 		crs.tasks[number] = t
 		crs.tasks[number].number = number
+		crs.commitTaskVersion(crs.tasks[number], params.Creator.ID(), now)
 	}
 
+	crs.materials = c.renumberedMaterials(numbers)
+
 	return crs, nil
 }
 
@@ -162,14 +207,70 @@ func (c *Course) CreatorID() string {
 }
 
 type UnmarshallingParams struct {
-	ID            string
-	Title         string
-	Period        Period
-	Started       bool
-	CreatorID     string
-	Collaborators []string
-	Students      []string
-	Tasks         []UnmarshallingTaskParams
+	ID                string
+	Title             string
+	Period            Period
+	Started           bool
+	CreatorID         string
+	Collaborators     []string
+	CollaboratorRoles map[string]CollaboratorRole
+	Students          []string
+	Enrollments       []UnmarshallingStudentEnrollmentParams
+	Groups            []string
+	StudentGroups     map[string]string
+	Capacity          int
+	Waitlist          []UnmarshallingWaitlistEntryParams
+	Promotions        []UnmarshallingWaitlistPromotionParams
+	Invitations       []UnmarshallingInvitationParams
+	Requests          []UnmarshallingEnrollmentRequestParams
+	Teams             []UnmarshallingTeamParams
+	TeamSelfFormation bool
+	TeamMaxSize       int
+	Tasks             []UnmarshallingTaskParams
+	Materials         []AuxiliaryMaterial
+}
+
+type UnmarshallingInvitationParams struct {
+	Code      string
+	Role      AcademicType
+	ExpiresAt time.Time
+	MaxUses   int
+	Uses      int
+}
+
+type UnmarshallingStudentEnrollmentParams struct {
+	StudentID   string
+	Status      StudentStatus
+	EnrolledAt  time.Time
+	WithdrawnAt time.Time
+	Reason      string
+}
+
+type UnmarshallingWaitlistEntryParams struct {
+	StudentID    string
+	WaitlistedAt time.Time
+}
+
+type UnmarshallingWaitlistPromotionParams struct {
+	StudentID    string
+	WaitlistedAt time.Time
+	PromotedAt   time.Time
+}
+
+type UnmarshallingTeamParams struct {
+	ID      string
+	Name    string
+	Members []string
+}
+
+type UnmarshallingEnrollmentRequestParams struct {
+	ID         string
+	StudentID  string
+	Message    string
+	Status     EnrollmentRequestStatus
+	CreatedAt  time.Time
+	ReviewerID string
+	ReviewedAt time.Time
 }
 
 type UnmarshallingTaskParams struct {
@@ -177,9 +278,21 @@ type UnmarshallingTaskParams struct {
 	Title       string
 	Description string
 	TaskType    TaskType
+	TeamWork    bool
 	Deadline    Deadline
 	TestPoints  []TestPoint
 	TestData    []TestData
+	Checker     Checker
+	Languages   []string
+	Limits      ExecutionLimits
+	Version     int
+}
+
+type UnmarshallingTaskVersionParams struct {
+	Number    int
+	AuthorID  string
+	CreatedAt time.Time
+	Task      UnmarshallingTaskParams
 }
 
 // UnmarshalFromDatabase unmarshalls Course from the database.
@@ -188,15 +301,29 @@ type UnmarshallingTaskParams struct {
 func UnmarshalFromDatabase(params UnmarshallingParams) *Course {
 	tasks, lastNumber := unmarshalTasks(params.Tasks)
 	crs := &Course{
-		id:             params.ID,
-		title:          params.Title,
-		period:         params.Period,
-		started:        params.Started,
-		creatorID:      params.CreatorID,
-		collaborators:  unmarshalIDs(params.Collaborators),
-		students:       unmarshalIDs(params.Students),
+		id:                 params.ID,
+		title:              params.Title,
+		period:             params.Period,
+		started:            params.Started,
+		creatorID:          params.CreatorID,
+		collaborators:      unmarshalCollaborators(params.Collaborators, params.CollaboratorRoles),
+		students:           unmarshalIDs(params.Students),
+		enrollments:        unmarshalStudentEnrollments(params.Enrollments),
+		groups:             unmarshalIDs(params.Groups),
+		studentGroups:      unmarshalStudentGroups(params.StudentGroups),
+		capacity:           params.Capacity,
+		waitlist:           unmarshalWaitlist(params.Waitlist),
+		promotions:         unmarshalWaitlistPromotions(params.Promotions),
+		invitations:        unmarshalInvitations(params.Invitations),
+		enrollmentRequests: unmarshalEnrollmentRequests(params.Requests),
+		teams:              unmarshalTeams(params.Teams),
+		teamSettings: TeamSettings{
+			selfFormation: params.TeamSelfFormation,
+			maxSize:       params.TeamMaxSize,
+		},
 		tasks:          tasks,
 		nextTaskNumber: lastNumber + 1,
+		materials:      params.Materials,
 	}
 
 	return crs
@@ -211,22 +338,21 @@ func unmarshalIDs(ids []string) map[string]bool {
 	return unmarshalled
 }
 
+func unmarshalStudentGroups(studentGroups map[string]string) map[string]string {
+	unmarshalled := make(map[string]string, len(studentGroups))
+	for s, g := range studentGroups {
+		unmarshalled[s] = g
+	}
+
+	return unmarshalled
+}
+
 func unmarshalTasks(taskParams []UnmarshallingTaskParams) (map[int]*Task, int) {
 	tasks := make(map[int]*Task, len(taskParams))
 	lastNumber := 0
 
 	for _, tp := range taskParams {
-		tasks[tp.Number] = &Task{
-			number:      tp.Number,
-			title:       tp.Title,
-			description: tp.Description,
-			taskType:    tp.TaskType,
-			optional: taskOptional{
-				deadline:   tp.Deadline,
-				testData:   tp.TestData,
-				testPoints: tp.TestPoints,
-			},
-		}
+		tasks[tp.Number] = unmarshalTask(tp)
 
 		if tp.Number > lastNumber {
 			lastNumber = tp.Number
@@ -235,3 +361,40 @@ func unmarshalTasks(taskParams []UnmarshallingTaskParams) (map[int]*Task, int) {
 
 	return tasks, lastNumber
 }
+
+func unmarshalTask(params UnmarshallingTaskParams) *Task {
+	task := &Task{
+		number:      params.Number,
+		title:       params.Title,
+		description: params.Description,
+		taskType:    params.TaskType,
+		teamWork:    params.TeamWork,
+		optional: taskOptional{
+			deadline:   params.Deadline,
+			testData:   params.TestData,
+			testPoints: params.TestPoints,
+			checker:    params.Checker,
+			languages:  params.Languages,
+			limits:     params.Limits,
+		},
+		version: params.Version,
+	}
+
+	// Tasks created before version history was kept have first version.
+	if task.version == 0 {
+		task.version = 1
+	}
+
+	return task
+}
+
+// UnmarshalTaskVersionFromDatabase unmarshalls TaskVersion from the database.
+// It should be used only for unmarshalling from the database!
+func UnmarshalTaskVersionFromDatabase(params UnmarshallingTaskVersionParams) TaskVersion {
+	return TaskVersion{
+		number:    params.Number,
+		authorID:  params.AuthorID,
+		createdAt: params.CreatedAt,
+		task:      *unmarshalTask(params.Task).content(),
+	}
+}
@@ -2,6 +2,7 @@ package course_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -197,7 +198,7 @@ func TestCourse_Extend(t *testing.T) {
 			_ = addAutoCodeCheckingTaskToCourse(t, creator, originCourse)
 			_ = addTestingTaskToCourse(t, creator, originCourse)
 
-			extendedCourse, err := originCourse.Extend(c.Params)
+			extendedCourse, err := originCourse.Extend(c.Params, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
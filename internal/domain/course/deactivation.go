@@ -0,0 +1,131 @@
+package course
+
+import (
+	"sort"
+	"time"
+)
+
+// DeactivationReason is withdrawal reason of student
+// who was deactivated in academics directory.
+const DeactivationReason = "academic deactivated"
+
+// Deactivation describes changes of course made when academic was deactivated.
+type Deactivation struct {
+	studentWithdrawn    bool
+	waitlistLeft        bool
+	rejectedRequests    int
+	collaboratorRemoved bool
+	creatorDeactivated  bool
+	newCreatorID        string
+}
+
+// StudentWithdrawn reports whether active student was withdrawn.
+func (d Deactivation) StudentWithdrawn() bool {
+	return d.studentWithdrawn
+}
+
+func (d Deactivation) WaitlistLeft() bool {
+	return d.waitlistLeft
+}
+
+// RejectedRequests returns number of pending enrollment requests rejected.
+func (d Deactivation) RejectedRequests() int {
+	return d.rejectedRequests
+}
+
+func (d Deactivation) CollaboratorRemoved() bool {
+	return d.collaboratorRemoved
+}
+
+// NewCreatorID returns ID of co-teacher who became creator
+// instead of deactivated one, it's empty if creator wasn't replaced.
+func (d Deactivation) NewCreatorID() string {
+	return d.newCreatorID
+}
+
+// CreatorOrphaned reports whether deactivated creator stays in course,
+// because course has no co-teacher to become creator instead.
+func (d Deactivation) CreatorOrphaned() bool {
+	return d.creatorDeactivated && d.newCreatorID == ""
+}
+
+// Changed reports whether course was changed or needs attention.
+func (d Deactivation) Changed() bool {
+	return d != Deactivation{}
+}
+
+// RemoveDeactivatedAcademic removes academic deactivated in academics directory from
+// course. Active student is withdrawn keeping enrollment record, completed student
+// stays as record of completion. Student leaves waitlist and pending enrollment
+// requests of student are rejected without reviewer. Collaborator is removed.
+// Deactivated creator is replaced by co-teacher with the least ID, course without
+// co-teachers keeps creator and is reported orphaned. Removal is idempotent.
+func (c *Course) RemoveDeactivatedAcademic(academicID string, now time.Time) (Deactivation, error) {
+	if academicID == "" {
+		return Deactivation{}, ErrEmptyAcademicID
+	}
+
+	var d Deactivation
+
+	if c.hasStudent(academicID) && c.studentEnrollment(academicID).status == ActiveStudent {
+		c.withdrawStudent(academicID, DeactivationReason, now)
+		c.promoteWaitlisted(now)
+		d.studentWithdrawn = true
+	}
+
+	if c.IsWaitlisted(academicID) {
+		c.dropFromWaitlist(academicID)
+		d.waitlistLeft = true
+	}
+
+	d.rejectedRequests = c.rejectStudentEnrollmentRequests(academicID, now)
+
+	if _, ok := c.collaborators[academicID]; ok {
+		delete(c.collaborators, academicID)
+		d.collaboratorRemoved = true
+	}
+
+	if c.hasCreator(academicID) {
+		d.creatorDeactivated = true
+		d.newCreatorID = c.replaceCreator()
+	}
+
+	return d, nil
+}
+
+func (c *Course) rejectStudentEnrollmentRequests(studentID string, now time.Time) int {
+	rejected := 0
+
+	for id, request := range c.enrollmentRequests {
+		if request.studentID == studentID && request.status == PendingRequest {
+			request.status = RejectedRequest
+			request.reviewedAt = now.UTC()
+			c.enrollmentRequests[id] = request
+			rejected++
+		}
+	}
+
+	return rejected
+}
+
+// replaceCreator makes co-teacher with the least ID creator of course,
+// returns ID of new creator or empty string if there is no co-teacher.
+func (c *Course) replaceCreator() string {
+	coTeachers := make([]string, 0, len(c.collaborators))
+
+	for id, role := range c.collaborators {
+		if role == CoTeacherRole {
+			coTeachers = append(coTeachers, id)
+		}
+	}
+
+	if len(coTeachers) == 0 {
+		return ""
+	}
+
+	sort.Strings(coTeachers)
+	c.creatorID = coTeachers[0]
+	delete(c.collaborators, c.creatorID)
+
+	return c.creatorID
+}
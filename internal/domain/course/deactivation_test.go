@@ -0,0 +1,163 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_RemoveDeactivatedAcademic_withdraws_student(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id", "other-student-id"))
+	err := crs.CreateTeam(creator, "team-id", "Team", "student-id", "other-student-id")
+	require.NoError(t, err)
+
+	deactivatedAt := time.Date(2021, time.October, 1, 9, 0, 0, 0, time.UTC)
+	d, err := crs.RemoveDeactivatedAcademic("student-id", deactivatedAt)
+	require.NoError(t, err)
+
+	require.True(t, d.StudentWithdrawn())
+	require.False(t, d.CollaboratorRemoved())
+	require.True(t, d.Changed())
+	require.Equal(t, []string{"other-student-id"}, crs.Students())
+
+	enrollment, ok := crs.StudentEnrollment("student-id")
+	require.True(t, ok)
+	require.Equal(t, course.WithdrawnStudent, enrollment.Status())
+	require.Equal(t, course.DeactivationReason, enrollment.Reason())
+	require.Equal(t, deactivatedAt, enrollment.WithdrawnAt())
+
+	team, ok := crs.StudentTeam("other-student-id")
+	require.True(t, ok)
+	require.Equal(t, []string{"other-student-id"}, team.Members())
+
+	d, err = crs.RemoveDeactivatedAcademic("student-id", deactivatedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, d.Changed(), "second removal shouldn't change course")
+}
+
+func TestCourse_RemoveDeactivatedAcademic_keeps_completed_student(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	require.NoError(t, crs.CompleteStudent(creator, "student-id"))
+
+	d, err := crs.RemoveDeactivatedAcademic("student-id", time.Now())
+	require.NoError(t, err)
+
+	require.False(t, d.Changed())
+	require.Equal(t, []string{"student-id"}, crs.Students())
+}
+
+func TestCourse_RemoveDeactivatedAcademic_clears_waitlist_and_requests(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	waitlisted := waitlistStudent(t, creator, crs, "waitlisted-id")
+
+	err := crs.RequestEnrollment(waitlisted, "request-id", "", time.Now())
+	require.NoError(t, err)
+
+	d, err := crs.RemoveDeactivatedAcademic("waitlisted-id", time.Now())
+	require.NoError(t, err)
+
+	require.True(t, d.WaitlistLeft())
+	require.Equal(t, 1, d.RejectedRequests())
+	require.False(t, d.StudentWithdrawn())
+	require.Empty(t, crs.Waitlist())
+
+	requests := crs.EnrollmentRequests()
+	require.Len(t, requests, 1)
+	require.Equal(t, course.RejectedRequest, requests[0].Status())
+	require.Empty(t, requests[0].ReviewerID())
+}
+
+func TestCourse_RemoveDeactivatedAcademic_promotes_waitlisted(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	waitlistStudent(t, creator, crs, "waitlisted-id")
+
+	_, err := crs.RemoveDeactivatedAcademic("student-id", time.Now())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"waitlisted-id"}, crs.Students())
+	require.Empty(t, crs.Waitlist())
+}
+
+func waitlistStudent(t *testing.T, creator course.Academic, crs *course.Course, studentID string) course.Academic {
+	t.Helper()
+
+	student := course.MustNewAcademic(studentID, course.StudentType)
+	require.NoError(t, crs.ChangeCapacity(creator, 1, time.Now()))
+	require.NoError(t, crs.AddInvitation(creator, course.MustNewInvitation(
+		"K7QX2M4PZA", course.StudentType, time.Now().Add(time.Hour), 10,
+	), time.Now()))
+	require.NoError(t, crs.RedeemInvitation(student, "K7QX2M4PZA", time.Now()))
+	require.True(t, crs.IsWaitlisted(studentID))
+
+	return student
+}
+
+func TestCourse_RemoveDeactivatedAcademic_removes_collaborator(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withCollaborators("collaborator-id"))
+
+	d, err := crs.RemoveDeactivatedAcademic("collaborator-id", time.Now())
+	require.NoError(t, err)
+
+	require.True(t, d.CollaboratorRemoved())
+	require.Empty(t, crs.Collaborators())
+	require.Equal(t, "creator-id", crs.CreatorID())
+}
+
+func TestCourse_RemoveDeactivatedAcademic_replaces_creator(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withCollaborators("b-teacher-id", "a-assistant-id", "c-teacher-id"))
+	require.NoError(t, crs.ChangeCollaboratorRole(creator, "a-assistant-id", course.AssistantRole))
+
+	d, err := crs.RemoveDeactivatedAcademic("creator-id", time.Now())
+	require.NoError(t, err)
+
+	require.Equal(t, "b-teacher-id", d.NewCreatorID())
+	require.False(t, d.CreatorOrphaned())
+	require.Equal(t, "b-teacher-id", crs.CreatorID())
+	require.ElementsMatch(t, []string{"a-assistant-id", "c-teacher-id"}, crs.Collaborators())
+}
+
+func TestCourse_RemoveDeactivatedAcademic_orphans_course_without_co_teachers(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withCollaborators("observer-id"))
+	require.NoError(t, crs.ChangeCollaboratorRole(creator, "observer-id", course.ObserverRole))
+
+	d, err := crs.RemoveDeactivatedAcademic("creator-id", time.Now())
+	require.NoError(t, err)
+
+	require.True(t, d.CreatorOrphaned())
+	require.Empty(t, d.NewCreatorID())
+	require.Equal(t, "creator-id", crs.CreatorID())
+	require.Equal(t, []string{"observer-id"}, crs.Collaborators())
+}
+
+func TestCourse_RemoveDeactivatedAcademic_with_empty_id(t *testing.T) {
+	t.Parallel()
+
+	crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
+
+	_, err := crs.RemoveDeactivatedAcademic("", time.Now())
+	require.ErrorIs(t, err, course.ErrEmptyAcademicID)
+}
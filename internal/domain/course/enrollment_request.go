@@ -0,0 +1,255 @@
+package course
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type EnrollmentRequestStatus uint8
+
+const (
+	PendingRequest EnrollmentRequestStatus = iota + 1
+	ApprovedRequest
+	RejectedRequest
+)
+
+func (s EnrollmentRequestStatus) String() string {
+	switch s {
+	case PendingRequest:
+		return "pending"
+	case ApprovedRequest:
+		return "approved"
+	case RejectedRequest:
+		return "rejected"
+	}
+
+	return "%!EnrollmentRequestStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+func (s EnrollmentRequestStatus) IsValid() bool {
+	switch s {
+	case PendingRequest, ApprovedRequest, RejectedRequest:
+		return true
+	}
+
+	return false
+}
+
+// EnrollmentRequest is request of student to join course,
+// student is added to course only after teacher approves it.
+type EnrollmentRequest struct {
+	id         string
+	studentID  string
+	message    string
+	status     EnrollmentRequestStatus
+	createdAt  time.Time
+	reviewerID string
+	reviewedAt time.Time
+}
+
+const enrollmentMessageMaxLen = 1000
+
+var (
+	ErrEmptyEnrollmentRequestID         = errors.New("empty enrollment request id")
+	ErrEnrollmentMessageTooLong         = errors.New("enrollment request message too long")
+	ErrNotStudentCantRequestEnrollment  = errors.New("not student can't request enrollment")
+	ErrEnrollmentAlreadyRequested       = errors.New("student already has pending enrollment request")
+	ErrCourseHasNoSuchEnrollmentRequest = errors.New("course has no such enrollment request")
+	ErrEnrollmentRequestAlreadyReviewed = errors.New("enrollment request is already reviewed")
+)
+
+func IsInvalidEnrollmentRequestError(err error) bool {
+	return errors.Is(err, ErrEmptyEnrollmentRequestID) ||
+		errors.Is(err, ErrEnrollmentMessageTooLong)
+}
+
+func (r EnrollmentRequest) ID() string {
+	return r.id
+}
+
+func (r EnrollmentRequest) StudentID() string {
+	return r.studentID
+}
+
+// Message returns optional message of student to teachers.
+func (r EnrollmentRequest) Message() string {
+	return r.message
+}
+
+func (r EnrollmentRequest) Status() EnrollmentRequestStatus {
+	return r.status
+}
+
+func (r EnrollmentRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ReviewerID returns ID of teacher who approved or
+// rejected request, empty if request is pending.
+func (r EnrollmentRequest) ReviewerID() string {
+	return r.reviewerID
+}
+
+func (r EnrollmentRequest) ReviewedAt() time.Time {
+	return r.reviewedAt
+}
+
+// EnrollmentRequests returns all requests of course from the oldest.
+func (c *Course) EnrollmentRequests() []EnrollmentRequest {
+	requests := make([]EnrollmentRequest, 0, len(c.enrollmentRequests))
+	for _, r := range c.enrollmentRequests {
+		requests = append(requests, r)
+	}
+
+	sort.Slice(requests, func(i, j int) bool {
+		if !requests[i].createdAt.Equal(requests[j].createdAt) {
+			return requests[i].createdAt.Before(requests[j].createdAt)
+		}
+
+		return requests[i].id < requests[j].id
+	})
+
+	return requests
+}
+
+// RequestEnrollment adds pending request of student who isn't in course yet,
+// student can't have more than one pending request.
+func (c *Course) RequestEnrollment(academic Academic, requestID, message string, now time.Time) error {
+	if academic.Type() != StudentType {
+		return ErrNotStudentCantRequestEnrollment
+	}
+
+	if requestID == "" {
+		return ErrEmptyEnrollmentRequestID
+	}
+
+	if len(message) > enrollmentMessageMaxLen {
+		return ErrEnrollmentMessageTooLong
+	}
+
+	if c.hasStudent(academic.ID()) {
+		return ErrAcademicAlreadyInCourse
+	}
+
+	for _, r := range c.enrollmentRequests {
+		if r.studentID == academic.ID() && r.status == PendingRequest {
+			return ErrEnrollmentAlreadyRequested
+		}
+	}
+
+	c.enrollmentRequests[requestID] = EnrollmentRequest{
+		id:        requestID,
+		studentID: academic.ID(),
+		message:   message,
+		status:    PendingRequest,
+		createdAt: now.UTC(),
+	}
+
+	return nil
+}
+
+// ApproveEnrollmentRequests adds students of all requests to course by
+// AddStudents, so approval follows the same rules as adding of students.
+// Students who don't fit into full course are put to waitlist in order of
+// requests like students who redeem invitation, ErrStudentAlreadyWaitlisted
+// is returned if one of them already waits. Requests are approved all
+// or none, so every request should exist and be pending.
+func (c *Course) ApproveEnrollmentRequests(academic Academic, requestIDs []string, now time.Time) error {
+	studentIDs, err := c.pendingEnrollmentRequestsStudents(academic, requestIDs)
+	if err != nil {
+		return err
+	}
+
+	seatedIDs, waitingIDs := c.splitByFreeSeats(studentIDs)
+	for _, sid := range waitingIDs {
+		if c.IsWaitlisted(sid) {
+			return ErrStudentAlreadyWaitlisted
+		}
+	}
+
+	if err := c.AddStudents(academic, now, seatedIDs...); err != nil {
+		return err
+	}
+
+	for _, sid := range waitingIDs {
+		if err := c.waitlistStudent(sid, now); err != nil {
+			return err
+		}
+	}
+
+	c.reviewEnrollmentRequests(academic, requestIDs, ApprovedRequest, now)
+
+	return nil
+}
+
+// RejectEnrollmentRequests rejects all requests or none like ApproveEnrollmentRequests.
+func (c *Course) RejectEnrollmentRequests(academic Academic, requestIDs []string, now time.Time) error {
+	if _, err := c.pendingEnrollmentRequestsStudents(academic, requestIDs); err != nil {
+		return err
+	}
+
+	c.reviewEnrollmentRequests(academic, requestIDs, RejectedRequest, now)
+
+	return nil
+}
+
+// pendingEnrollmentRequestsStudents checks academic can review all
+// requests and returns students of requests.
+func (c *Course) pendingEnrollmentRequestsStudents(academic Academic, requestIDs []string) ([]string, error) {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return nil, err
+	}
+
+	for _, id := range requestIDs {
+		request, ok := c.enrollmentRequests[id]
+		if !ok {
+			return nil, errors.Wrapf(ErrCourseHasNoSuchEnrollmentRequest, "request #%s", id)
+		}
+
+		if request.status != PendingRequest {
+			return nil, errors.Wrapf(ErrEnrollmentRequestAlreadyReviewed, "request #%s", id)
+		}
+	}
+
+	studentIDs := make([]string, 0, len(requestIDs))
+	for _, id := range requestIDs {
+		studentIDs = append(studentIDs, c.enrollmentRequests[id].studentID)
+	}
+
+	return studentIDs, nil
+}
+
+func (c *Course) reviewEnrollmentRequests(
+	academic Academic,
+	requestIDs []string,
+	status EnrollmentRequestStatus,
+	now time.Time,
+) {
+	for _, id := range requestIDs {
+		request := c.enrollmentRequests[id]
+		request.status = status
+		request.reviewerID = academic.ID()
+		request.reviewedAt = now.UTC()
+		c.enrollmentRequests[id] = request
+	}
+}
+
+func unmarshalEnrollmentRequests(params []UnmarshallingEnrollmentRequestParams) map[string]EnrollmentRequest {
+	requests := make(map[string]EnrollmentRequest, len(params))
+	for _, p := range params {
+		requests[p.ID] = EnrollmentRequest{
+			id:         p.ID,
+			studentID:  p.StudentID,
+			message:    p.Message,
+			status:     p.Status,
+			createdAt:  p.CreatedAt,
+			reviewerID: p.ReviewerID,
+			reviewedAt: p.ReviewedAt,
+		}
+	}
+
+	return requests
+}
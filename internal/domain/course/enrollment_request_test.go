@@ -0,0 +1,287 @@
+package course_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_RequestEnrollment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Message  string
+		Err      error
+	}{
+		{
+			Name:     "student_requests_enrollment",
+			Academic: course.MustNewAcademic("student1-id", course.StudentType),
+			Message:  "I'd like to attend elective course",
+		},
+		{
+			Name:     "teacher_cant_request_enrollment",
+			Academic: course.MustNewAcademic("teacher-id", course.TeacherType),
+			Err:      course.ErrNotStudentCantRequestEnrollment,
+		},
+		{
+			Name:     "student_already_in_course",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			Err:      course.ErrAcademicAlreadyInCourse,
+		},
+		{
+			Name:     "student_already_requested_enrollment",
+			Academic: course.MustNewAcademic("student2-id", course.StudentType),
+			Err:      course.ErrEnrollmentAlreadyRequested,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"))
+			require.NoError(t, crs.RequestEnrollment(
+				course.MustNewAcademic("student2-id", course.StudentType),
+				"request2-id", "", now.Add(-time.Hour),
+			))
+
+			err := crs.RequestEnrollment(c.Academic, "request-id", c.Message, now)
+			if c.Err != nil {
+				require.ErrorIs(t, err, c.Err)
+				require.Len(t, crs.EnrollmentRequests(), 1)
+
+				return
+			}
+			require.NoError(t, err)
+
+			requests := crs.EnrollmentRequests()
+			require.Len(t, requests, 2)
+			require.Equal(t, "request-id", requests[1].ID())
+			require.Equal(t, c.Academic.ID(), requests[1].StudentID())
+			require.Equal(t, c.Message, requests[1].Message())
+			require.Equal(t, course.PendingRequest, requests[1].Status())
+			require.Equal(t, now, requests[1].CreatedAt())
+			require.NotContains(t, crs.Students(), c.Academic.ID())
+		})
+	}
+}
+
+func TestCourse_ApproveEnrollmentRequests(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name       string
+		Academic   course.Academic
+		RequestIDs []string
+		Capacity   int
+		IsErr      func(err error) bool
+	}{
+		{
+			Name:       "collaborator_approves_requests",
+			Academic:   course.MustNewAcademic("collaborator-id", course.TeacherType),
+			RequestIDs: []string{"request1-id", "request2-id"},
+		},
+		{
+			Name:       "student_cant_approve_requests",
+			Academic:   course.MustNewAcademic("student-id", course.StudentType),
+			RequestIDs: []string{"request1-id"},
+			IsErr:      course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:       "no_such_request",
+			Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+			RequestIDs: []string{"request1-id", "other-request-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchEnrollmentRequest)
+			},
+		},
+		{
+			Name:       "request_already_reviewed",
+			Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+			RequestIDs: []string{"request1-id", "request3-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrEnrollmentRequestAlreadyReviewed)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := newCourseWithEnrollmentRequests(t, now)
+			require.NoError(t, crs.ChangeCapacity(course.MustNewAcademic("creator-id", course.TeacherType), c.Capacity, now))
+
+			err := crs.ApproveEnrollmentRequests(c.Academic, c.RequestIDs, now)
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, []string{"student-id"}, crs.Students())
+				require.Equal(t, course.PendingRequest, crs.EnrollmentRequests()[0].Status())
+
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"student-id", "student1-id", "student2-id"}, crs.Students())
+
+			for _, r := range crs.EnrollmentRequests()[:2] {
+				require.Equal(t, course.ApprovedRequest, r.Status())
+				require.Equal(t, c.Academic.ID(), r.ReviewerID())
+				require.Equal(t, now, r.ReviewedAt())
+			}
+		})
+	}
+}
+
+func TestCourse_ApproveEnrollmentRequests_sameRulesAsAddStudents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+
+	academics := []course.Academic{
+		creator,
+		course.MustNewAcademic("collaborator-id", course.TeacherType),
+		course.MustNewAcademic("assistant-id", course.TeacherType),
+		course.MustNewAcademic("observer-id", course.TeacherType),
+		course.MustNewAcademic("stranger-id", course.TeacherType),
+		course.MustNewAcademic("student-id", course.StudentType),
+	}
+
+	newCourse := func(t *testing.T, capacity int) *course.Course {
+		t.Helper()
+
+		crs := newCourseWithEnrollmentRequests(t, now)
+		require.NoError(t, crs.AddCollaborators(creator, "assistant-id", "observer-id"))
+		require.NoError(t, crs.ChangeCollaboratorRole(creator, "assistant-id", course.AssistantRole))
+		require.NoError(t, crs.ChangeCollaboratorRole(creator, "observer-id", course.ObserverRole))
+		require.NoError(t, crs.ChangeCapacity(creator, capacity, now))
+
+		return crs
+	}
+
+	for _, capacity := range []int{0, 3} {
+		for _, academic := range academics {
+			capacity, academic := capacity, academic
+			t.Run(fmt.Sprintf("%s_capacity_%d", academic.ID(), capacity), func(t *testing.T) {
+				t.Parallel()
+
+				added := newCourse(t, capacity)
+				addErr := added.AddStudents(academic, now, "student1-id", "student2-id")
+
+				approved := newCourse(t, capacity)
+				approveErr := approved.ApproveEnrollmentRequests(academic, []string{"request1-id", "request2-id"}, now)
+
+				if addErr != nil {
+					require.Error(t, approveErr)
+					require.Equal(t, addErr.Error(), approveErr.Error())
+				} else {
+					require.NoError(t, approveErr)
+				}
+
+				require.ElementsMatch(t, added.Students(), approved.Students())
+			})
+		}
+	}
+}
+
+func TestCourse_ApproveEnrollmentRequests_waitlistsWhenCourseIsFull(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+
+	crs := newCourseWithEnrollmentRequests(t, now)
+	require.NoError(t, crs.ChangeCapacity(creator, 2, now))
+
+	err := crs.ApproveEnrollmentRequests(creator, []string{"request1-id", "request2-id"}, now)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"student-id", "student1-id"}, crs.Students())
+	require.Len(t, crs.Waitlist(), 1)
+	require.Equal(t, "student2-id", crs.Waitlist()[0].StudentID())
+	require.Equal(t, now, crs.Waitlist()[0].WaitlistedAt())
+
+	for _, r := range crs.EnrollmentRequests()[:2] {
+		require.Equal(t, course.ApprovedRequest, r.Status())
+	}
+
+	require.NoError(t, crs.RemoveStudent(creator, "student1-id", "", now))
+	require.ElementsMatch(t, []string{"student-id", "student2-id"}, crs.Students(), "waitlisted student should get freed seat")
+	require.Empty(t, crs.Waitlist())
+}
+
+func TestCourse_ApproveEnrollmentRequests_studentAlreadyWaitlisted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+
+	crs := newCourseWithEnrollmentRequests(t, now)
+	require.NoError(t, crs.ChangeCapacity(creator, 1, now))
+	require.NoError(t, crs.AddInvitation(creator, course.MustNewInvitation(
+		"K7QX2M4PZA", course.StudentType, now.Add(time.Hour), 10,
+	), now))
+	require.NoError(t, crs.RedeemInvitation(course.MustNewAcademic("student2-id", course.StudentType), "K7QX2M4PZA", now))
+
+	err := crs.ApproveEnrollmentRequests(creator, []string{"request1-id", "request2-id"}, now.Add(time.Minute))
+	require.ErrorIs(t, err, course.ErrStudentAlreadyWaitlisted)
+	require.Equal(t, []string{"student-id"}, crs.Students())
+	require.Len(t, crs.Waitlist(), 1)
+	require.Equal(t, "student2-id", crs.Waitlist()[0].StudentID())
+	require.Equal(t, now, crs.Waitlist()[0].WaitlistedAt())
+
+	for _, r := range crs.EnrollmentRequests()[:2] {
+		require.Equal(t, course.PendingRequest, r.Status())
+	}
+}
+
+func TestCourse_RejectEnrollmentRequests(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	crs := newCourseWithEnrollmentRequests(t, now)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+
+	err := crs.RejectEnrollmentRequests(creator, []string{"request1-id"}, now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"student-id"}, crs.Students())
+	require.Equal(t, course.RejectedRequest, crs.EnrollmentRequests()[0].Status())
+
+	err = crs.ApproveEnrollmentRequests(creator, []string{"request1-id"}, now)
+	require.ErrorIs(t, err, course.ErrEnrollmentRequestAlreadyReviewed)
+
+	err = crs.RequestEnrollment(course.MustNewAcademic("student1-id", course.StudentType), "request4-id", "", now)
+	require.NoError(t, err, "student should be able to request again after rejection")
+}
+
+func newCourseWithEnrollmentRequests(t *testing.T, now time.Time) *course.Course {
+	t.Helper()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"), withCollaborators("collaborator-id"))
+
+	for i, studentID := range []string{"student1-id", "student2-id", "student3-id"} {
+		requestID := fmt.Sprintf("request%d-id", i+1)
+		createdAt := now.Add(time.Duration(i-3) * time.Hour)
+		err := crs.RequestEnrollment(course.MustNewAcademic(studentID, course.StudentType), requestID, "", createdAt)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, crs.RejectEnrollmentRequests(creator, []string{"request3-id"}, now))
+
+	return crs
+}
@@ -0,0 +1,131 @@
+package course
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExecutionLimits restricts resources of student solution
+// of auto code checking task.
+type ExecutionLimits struct {
+	timeLimit     time.Duration
+	memoryLimit   int64
+	maxSourceSize int
+}
+
+const (
+	minTimeLimit     = 100 * time.Millisecond
+	maxTimeLimit     = 60 * time.Second
+	minMemoryLimit   = 1 << 20
+	maxMemoryLimit   = 2 << 30
+	maxMaxSourceSize = 1 << 20
+
+	defaultTimeLimit     = time.Second
+	defaultMemoryLimit   = 256 << 20
+	defaultMaxSourceSize = 64 << 10
+)
+
+var (
+	ErrInvalidTimeLimit     = errors.New("invalid time limit")
+	ErrInvalidMemoryLimit   = errors.New("invalid memory limit")
+	ErrInvalidMaxSourceSize = errors.New("invalid max source size")
+)
+
+func IsInvalidExecutionLimitsError(err error) bool {
+	return errors.Is(err, ErrInvalidTimeLimit) ||
+		errors.Is(err, ErrInvalidMemoryLimit) ||
+		errors.Is(err, ErrInvalidMaxSourceSize)
+}
+
+// NewExecutionLimits creates limits, memory limit and
+// max source size are in bytes.
+func NewExecutionLimits(timeLimit time.Duration, memoryLimit int64, maxSourceSize int) (ExecutionLimits, error) {
+	if timeLimit < minTimeLimit || timeLimit > maxTimeLimit {
+		return ExecutionLimits{}, ErrInvalidTimeLimit
+	}
+
+	if memoryLimit < minMemoryLimit || memoryLimit > maxMemoryLimit {
+		return ExecutionLimits{}, ErrInvalidMemoryLimit
+	}
+
+	if maxSourceSize <= 0 || maxSourceSize > maxMaxSourceSize {
+		return ExecutionLimits{}, ErrInvalidMaxSourceSize
+	}
+
+	return ExecutionLimits{
+		timeLimit:     timeLimit,
+		memoryLimit:   memoryLimit,
+		maxSourceSize: maxSourceSize,
+	}, nil
+}
+
+func MustNewExecutionLimits(timeLimit time.Duration, memoryLimit int64, maxSourceSize int) ExecutionLimits {
+	limits, err := NewExecutionLimits(timeLimit, memoryLimit, maxSourceSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return limits
+}
+
+// DefaultExecutionLimits are one second, 256 MiB of memory and 64 KiB of source.
+func DefaultExecutionLimits() ExecutionLimits {
+	return ExecutionLimits{
+		timeLimit:     defaultTimeLimit,
+		memoryLimit:   defaultMemoryLimit,
+		maxSourceSize: defaultMaxSourceSize,
+	}
+}
+
+func (l ExecutionLimits) TimeLimit() time.Duration {
+	return l.timeLimit
+}
+
+func (l ExecutionLimits) MemoryLimit() int64 {
+	return l.memoryLimit
+}
+
+func (l ExecutionLimits) MaxSourceSize() int {
+	return l.maxSourceSize
+}
+
+func (l ExecutionLimits) IsZero() bool {
+	return l == ExecutionLimits{}
+}
+
+const languagesMaxNumber = 50
+
+var (
+	ErrEmptyLanguage     = errors.New("empty language")
+	ErrDuplicateLanguage = errors.New("duplicate language")
+	ErrTooManyLanguages  = errors.New("too many languages")
+)
+
+func IsInvalidLanguagesError(err error) bool {
+	return errors.Is(err, ErrEmptyLanguage) ||
+		errors.Is(err, ErrDuplicateLanguage) ||
+		errors.Is(err, ErrTooManyLanguages)
+}
+
+func validateLanguages(languages []string) error {
+	if len(languages) > languagesMaxNumber {
+		return ErrTooManyLanguages
+	}
+
+	seen := make(map[string]bool, len(languages))
+
+	for _, l := range languages {
+		if l == "" {
+			return ErrEmptyLanguage
+		}
+
+		if seen[l] {
+			return ErrDuplicateLanguage
+		}
+
+		seen[l] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestNewExecutionLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		TimeLimit     time.Duration
+		MemoryLimit   int64
+		MaxSourceSize int
+		ExpectedErr   error
+	}{
+		{
+			Name:          "valid_limits",
+			TimeLimit:     2 * time.Second,
+			MemoryLimit:   128 << 20,
+			MaxSourceSize: 32 << 10,
+		},
+		{
+			Name:          "too_small_time_limit",
+			TimeLimit:     time.Millisecond,
+			MemoryLimit:   128 << 20,
+			MaxSourceSize: 32 << 10,
+			ExpectedErr:   course.ErrInvalidTimeLimit,
+		},
+		{
+			Name:          "too_large_time_limit",
+			TimeLimit:     time.Hour,
+			MemoryLimit:   128 << 20,
+			MaxSourceSize: 32 << 10,
+			ExpectedErr:   course.ErrInvalidTimeLimit,
+		},
+		{
+			Name:          "too_small_memory_limit",
+			TimeLimit:     time.Second,
+			MemoryLimit:   1024,
+			MaxSourceSize: 32 << 10,
+			ExpectedErr:   course.ErrInvalidMemoryLimit,
+		},
+		{
+			Name:          "too_large_memory_limit",
+			TimeLimit:     time.Second,
+			MemoryLimit:   16 << 30,
+			MaxSourceSize: 32 << 10,
+			ExpectedErr:   course.ErrInvalidMemoryLimit,
+		},
+		{
+			Name:        "zero_max_source_size",
+			TimeLimit:   time.Second,
+			MemoryLimit: 128 << 20,
+			ExpectedErr: course.ErrInvalidMaxSourceSize,
+		},
+		{
+			Name:          "too_large_max_source_size",
+			TimeLimit:     time.Second,
+			MemoryLimit:   128 << 20,
+			MaxSourceSize: 2 << 20,
+			ExpectedErr:   course.ErrInvalidMaxSourceSize,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			limits, err := course.NewExecutionLimits(c.TimeLimit, c.MemoryLimit, c.MaxSourceSize)
+
+			if c.ExpectedErr != nil {
+				require.True(t, errors.Is(err, c.ExpectedErr))
+				require.True(t, course.IsInvalidExecutionLimitsError(err))
+				require.True(t, limits.IsZero())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.TimeLimit, limits.TimeLimit())
+			require.Equal(t, c.MemoryLimit, limits.MemoryLimit())
+			require.Equal(t, c.MaxSourceSize, limits.MaxSourceSize())
+		})
+	}
+}
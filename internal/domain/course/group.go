@@ -0,0 +1,134 @@
+package course
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrCourseHasNoSuchGroup = errors.New("course has no such group")
+
+// Groups returns IDs of academic groups added to course.
+func (c *Course) Groups() []string {
+	groups := make([]string, 0, len(c.groups))
+	for g := range c.groups {
+		groups = append(groups, g)
+	}
+
+	sort.Strings(groups)
+
+	return groups
+}
+
+// StudentGroup returns group that student came from,
+// false if student was added individually.
+func (c *Course) StudentGroup(studentID string) (string, bool) {
+	groupID, ok := c.studentGroups[studentID]
+
+	return groupID, ok
+}
+
+// StudentGroups returns group that each student came from.
+func (c *Course) StudentGroups() map[string]string {
+	studentGroups := make(map[string]string, len(c.studentGroups))
+	for s, g := range c.studentGroups {
+		studentGroups[s] = g
+	}
+
+	return studentGroups
+}
+
+// AddGroup adds students of group to course. Group is remembered as origin
+// only of students who aren't in course yet, so students added individually
+// or by another group are kept when group is removed. Group isn't added
+// if course hasn't enough free seats for all its new students.
+func (c *Course) AddGroup(academic Academic, groupID string, studentIDs []string, now time.Time) error {
+	if err := c.CanAddStudents(academic, studentIDs...); err != nil {
+		return err
+	}
+
+	c.groups[groupID] = true
+	c.putGroupStudents(groupID, studentIDs, now)
+
+	return nil
+}
+
+// RemoveGroup removes group and withdraws students who came from
+// it, freed seats go to students of waitlist.
+func (c *Course) RemoveGroup(academic Academic, groupID string, now time.Time) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if !c.groups[groupID] {
+		return ErrCourseHasNoSuchGroup
+	}
+
+	delete(c.groups, groupID)
+
+	for s, g := range c.studentGroups {
+		if g == groupID {
+			c.withdrawStudent(s, "", now)
+		}
+	}
+
+	c.promoteWaitlisted(now)
+
+	return nil
+}
+
+// SyncGroup adds students who joined group after it was added to course
+// and withdraws students who came from group but left it. Seats freed by
+// left students are taken by joined students first and then by waitlist.
+func (c *Course) SyncGroup(academic Academic, groupID string, studentIDs []string, now time.Time) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if !c.groups[groupID] {
+		return ErrCourseHasNoSuchGroup
+	}
+
+	members := unmarshalIDs(studentIDs)
+
+	var leftStudents []string
+
+	for s, g := range c.studentGroups {
+		if g == groupID && !members[s] {
+			leftStudents = append(leftStudents, s)
+		}
+	}
+
+	joinedStudents := 0
+
+	for sid := range members {
+		if !c.hasStudent(sid) {
+			joinedStudents++
+		}
+	}
+
+	if c.capacity > 0 && len(c.students)-len(leftStudents)+joinedStudents > c.capacity {
+		return CourseIsFullError{capacity: c.capacity}
+	}
+
+	for _, s := range leftStudents {
+		c.withdrawStudent(s, "", now)
+	}
+
+	c.putGroupStudents(groupID, studentIDs, now)
+	c.promoteWaitlisted(now)
+
+	return nil
+}
+
+func (c *Course) putGroupStudents(groupID string, studentIDs []string, now time.Time) {
+	for _, sid := range studentIDs {
+		if c.hasStudent(sid) {
+			continue
+		}
+
+		c.enrollStudent(sid, now)
+		c.studentGroups[sid] = groupID
+	}
+}
@@ -0,0 +1,114 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_AddGroup(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		IsErr    func(err error) bool
+	}{
+		{
+			Name:     "creator_can_add_group",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		},
+		{
+			Name:     "collaborator_can_add_group",
+			Academic: course.MustNewAcademic("collaborator-id", course.TeacherType),
+		},
+		{
+			Name:     "student_cant_add_group",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"), withCollaborators("collaborator-id"))
+
+			err := crs.AddGroup(c.Academic, "group-id", []string{"student-id", "student1-id"}, time.Now())
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []string{"group-id"}, crs.Groups())
+			require.ElementsMatch(t, []string{"student-id", "student1-id"}, crs.Students())
+
+			groupID, ok := crs.StudentGroup("student1-id")
+			require.True(t, ok)
+			require.Equal(t, "group-id", groupID)
+
+			_, ok = crs.StudentGroup("student-id")
+			require.False(t, ok, "individually added student shouldn't belong to group")
+		})
+	}
+}
+
+func TestCourse_RemoveGroup(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	require.NoError(t, crs.AddGroup(creator, "group1-id", []string{"student-id", "student1-id"}, time.Now()))
+	require.NoError(t, crs.AddGroup(creator, "group2-id", []string{"student1-id", "student2-id"}, time.Now()))
+
+	err := crs.RemoveGroup(creator, "group1-id", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []string{"group2-id"}, crs.Groups())
+	require.ElementsMatch(t, []string{"student-id", "student2-id"}, crs.Students())
+
+	err = crs.RemoveGroup(creator, "group1-id", time.Now())
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchGroup)
+}
+
+func TestCourse_SyncGroup(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+	require.NoError(t, crs.AddGroup(
+		creator, "group-id", []string{"student-id", "student1-id", "student2-id"}, time.Now(),
+	))
+
+	err := crs.SyncGroup(creator, "group-id", []string{"student2-id", "student3-id"}, time.Now())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"student-id", "student2-id", "student3-id"}, crs.Students())
+
+	groupID, ok := crs.StudentGroup("student3-id")
+	require.True(t, ok)
+	require.Equal(t, "group-id", groupID)
+
+	err = crs.SyncGroup(creator, "another-group-id", nil, time.Now())
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchGroup)
+}
+
+func TestCourse_RemoveStudent_fromGroup(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	require.NoError(t, crs.AddGroup(creator, "group-id", []string{"student-id"}, time.Now()))
+
+	err := crs.RemoveStudent(creator, "student-id", "", time.Now())
+	require.NoError(t, err)
+
+	_, ok := crs.StudentGroup("student-id")
+	require.False(t, ok)
+}
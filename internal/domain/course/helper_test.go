@@ -13,7 +13,7 @@ type CourseOption func(academic course.Academic, crs *course.Course)
 
 func withStudents(students ...string) CourseOption {
 	return func(academic course.Academic, crs *course.Course) {
-		err := crs.AddStudents(academic, students...)
+		err := crs.AddStudents(academic, time.Now(), students...)
 		if err != nil {
 			panic(err)
 		}
@@ -56,7 +56,7 @@ func addManualCheckingTaskToCourse(t *testing.T, academic course.Academic, crs *
 			time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC),
 			time.Date(2025, time.September, 15, 0, 0, 0, 0, time.UTC),
 		),
-	})
+	}, time.Now())
 	require.NoError(t, err)
 
 	return taskNumber
@@ -72,8 +72,8 @@ func addAutoCodeCheckingTaskToCourse(t *testing.T, academic course.Academic, crs
 			time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
 			time.Date(2025, time.October, 17, 0, 0, 0, 0, time.UTC),
 		),
-		TestData: []course.TestData{course.MustNewTestData("1", "Print: 1")},
-	})
+		TestData: []course.TestData{course.MustNewTestData("1", "Print: 1", course.HiddenTestData, "")},
+	}, time.Now())
 	require.NoError(t, err)
 
 	return taskNumber
@@ -86,7 +86,7 @@ func addTestingTaskToCourse(t *testing.T, academic course.Academic, crs *course.
 		Title:       "Testing task title",
 		Description: "Testing task description",
 		TestPoints:  []course.TestPoint{course.MustNewTestPoint("Yes/no question", []string{"Yes", "No"}, []int{1})},
-	})
+	}, time.Now())
 	require.NoError(t, err)
 
 	return taskNumber
@@ -119,6 +119,7 @@ func requireExtendedCourse(
 	require.ElementsMatch(t, append(originCourse.Students(), params.Students...), extendedCourse.Students())
 	require.ElementsMatch(t, append(originCourse.Collaborators(), params.Collaborators...), extendedCourse.Collaborators())
 	requireExtendedTasks(t, originCourse, extendedCourse)
+	require.Equal(t, originCourse.AuxiliaryMaterials(), extendedCourse.AuxiliaryMaterials())
 }
 
 func requireExtendedTasks(t *testing.T, originCourse, extendedCourse *course.Course) {
@@ -143,6 +144,21 @@ func requireExtendedTasks(t *testing.T, originCourse, extendedCourse *course.Cou
 
 		require.Equal(t, originTestData, extendedTestData)
 
+		originChecker, _ := taskFromOrigin.Checker()
+		extendedChecker, _ := taskFromExtended.Checker()
+
+		require.Equal(t, originChecker, extendedChecker)
+
+		originLanguages, _ := taskFromOrigin.Languages()
+		extendedLanguages, _ := taskFromExtended.Languages()
+
+		require.Equal(t, originLanguages, extendedLanguages)
+
+		originLimits, _ := taskFromOrigin.ExecutionLimits()
+		extendedLimits, _ := taskFromExtended.ExecutionLimits()
+
+		require.Equal(t, originLimits, extendedLimits)
+
 		originTestPoints, _ := taskFromOrigin.TestPoints()
 		extendedTestPoints, _ := taskFromExtended.TestPoints()
 
@@ -0,0 +1,245 @@
+package course
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Invitation is code that academic redeems to join course by himself,
+// student joins as student and teacher joins as collaborator.
+// Invitation can't be redeemed after expiry or more than max uses times.
+type Invitation struct {
+	code      string
+	role      AcademicType
+	expiresAt time.Time
+	maxUses   int
+	uses      int
+}
+
+const invitationCodeMaxLen = 64
+
+var (
+	ErrEmptyInvitationCode        = errors.New("empty invitation code")
+	ErrInvitationCodeTooLong      = errors.New("invitation code too long")
+	ErrInvalidInvitationRole      = errors.New("invalid invitation role")
+	ErrZeroInvitationExpiry       = errors.New("zero invitation expiry")
+	ErrInvalidInvitationMaxUses   = errors.New("invitation max uses isn't positive")
+	ErrCourseHasNoSuchInvitation  = errors.New("course has no such invitation")
+	ErrCourseAlreadyHasInvitation = errors.New("course already has invitation with such code")
+
+	ErrInvitationExpired        = errors.New("invitation expired")
+	ErrInvitationUsedUp         = errors.New("invitation has no uses left")
+	ErrInvitationForAnotherRole = errors.New("invitation is for academic of another role")
+	ErrAcademicAlreadyInCourse  = errors.New("academic already participates in course")
+)
+
+func IsInvalidInvitationError(err error) bool {
+	return errors.Is(err, ErrEmptyInvitationCode) ||
+		errors.Is(err, ErrInvitationCodeTooLong) ||
+		errors.Is(err, ErrInvalidInvitationRole) ||
+		errors.Is(err, ErrZeroInvitationExpiry) ||
+		errors.Is(err, ErrInvalidInvitationMaxUses) ||
+		errors.Is(err, ErrInvitationExpired)
+}
+
+// IsInvitationNotRedeemableError reports whether invitation
+// exists but academic can't join course with it.
+func IsInvitationNotRedeemableError(err error) bool {
+	return errors.Is(err, ErrInvitationExpired) ||
+		errors.Is(err, ErrInvitationUsedUp) ||
+		errors.Is(err, ErrInvitationForAnotherRole) ||
+		errors.Is(err, ErrAcademicAlreadyInCourse)
+}
+
+func NewInvitation(code string, role AcademicType, expiresAt time.Time, maxUses int) (Invitation, error) {
+	if code == "" {
+		return Invitation{}, ErrEmptyInvitationCode
+	}
+
+	if len(code) > invitationCodeMaxLen {
+		return Invitation{}, ErrInvitationCodeTooLong
+	}
+
+	if !role.IsValid() {
+		return Invitation{}, ErrInvalidInvitationRole
+	}
+
+	if expiresAt.IsZero() {
+		return Invitation{}, ErrZeroInvitationExpiry
+	}
+
+	if maxUses <= 0 {
+		return Invitation{}, ErrInvalidInvitationMaxUses
+	}
+
+	return Invitation{
+		code:      code,
+		role:      role,
+		expiresAt: expiresAt.UTC(),
+		maxUses:   maxUses,
+	}, nil
+}
+
+func MustNewInvitation(code string, role AcademicType, expiresAt time.Time, maxUses int) Invitation {
+	invitation, err := NewInvitation(code, role, expiresAt, maxUses)
+	if err != nil {
+		panic(err)
+	}
+
+	return invitation
+}
+
+func (i Invitation) Code() string {
+	return i.code
+}
+
+// Role returns type of academic who can redeem invitation.
+func (i Invitation) Role() AcademicType {
+	return i.role
+}
+
+func (i Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i Invitation) MaxUses() int {
+	return i.maxUses
+}
+
+// Uses returns how many times invitation was redeemed.
+func (i Invitation) Uses() int {
+	return i.uses
+}
+
+func (i Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.expiresAt)
+}
+
+func (i Invitation) IsZero() bool {
+	return i == Invitation{}
+}
+
+// Invitations returns invitations of course sorted by expiry.
+func (c *Course) Invitations() []Invitation {
+	invitations := make([]Invitation, 0, len(c.invitations))
+	for _, i := range c.invitations {
+		invitations = append(invitations, i)
+	}
+
+	sort.Slice(invitations, func(i, j int) bool {
+		if !invitations[i].expiresAt.Equal(invitations[j].expiresAt) {
+			return invitations[i].expiresAt.Before(invitations[j].expiresAt)
+		}
+
+		return invitations[i].code < invitations[j].code
+	})
+
+	return invitations
+}
+
+// AddInvitation adds invitation that isn't expired at the moment.
+func (c *Course) AddInvitation(academic Academic, invitation Invitation, now time.Time) error {
+	if err := c.canAcademicInvite(academic, invitation.role); err != nil {
+		return err
+	}
+
+	if invitation.IsZero() {
+		return ErrEmptyInvitationCode
+	}
+
+	if invitation.IsExpired(now) {
+		return ErrInvitationExpired
+	}
+
+	if _, ok := c.invitations[invitation.code]; ok {
+		return ErrCourseAlreadyHasInvitation
+	}
+
+	c.invitations[invitation.code] = invitation
+
+	return nil
+}
+
+func (c *Course) RevokeInvitation(academic Academic, code string) error {
+	invitation, ok := c.invitations[code]
+	if err := c.canAcademicInvite(academic, invitation.role); err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrCourseHasNoSuchInvitation
+	}
+
+	delete(c.invitations, code)
+
+	return nil
+}
+
+// RedeemInvitation adds academic to course as student or collaborator
+// according to invitation role and spends one use of invitation.
+// Student who redeems invitation to full course is put to waitlist.
+func (c *Course) RedeemInvitation(academic Academic, code string, now time.Time) error {
+	invitation, ok := c.invitations[code]
+	if !ok {
+		return ErrCourseHasNoSuchInvitation
+	}
+
+	if academic.Type() != invitation.role {
+		return ErrInvitationForAnotherRole
+	}
+
+	if invitation.IsExpired(now) {
+		return ErrInvitationExpired
+	}
+
+	if invitation.uses >= invitation.maxUses {
+		return ErrInvitationUsedUp
+	}
+
+	if c.hasTeacher(academic.ID()) || c.hasStudent(academic.ID()) {
+		return ErrAcademicAlreadyInCourse
+	}
+
+	switch {
+	case invitation.role == TeacherType:
+		c.putCollaborators([]string{academic.ID()})
+	case c.isFull():
+		if err := c.waitlistStudent(academic.ID(), now); err != nil {
+			return err
+		}
+	default:
+		c.putStudents([]string{academic.ID()}, now)
+	}
+
+	invitation.uses++
+	c.invitations[code] = invitation
+
+	return nil
+}
+
+// canAcademicInvite checks academic can add members of invitation role,
+// role of unknown invitation is zero and is checked like student role.
+func (c *Course) canAcademicInvite(academic Academic, role AcademicType) error {
+	if role == TeacherType {
+		return c.CanAcademicEditCollaborators(academic)
+	}
+
+	return c.CanAcademicEditStudents(academic)
+}
+
+func unmarshalInvitations(params []UnmarshallingInvitationParams) map[string]Invitation {
+	invitations := make(map[string]Invitation, len(params))
+	for _, p := range params {
+		invitations[p.Code] = Invitation{
+			code:      p.Code,
+			role:      p.Role,
+			expiresAt: p.ExpiresAt,
+			maxUses:   p.MaxUses,
+			uses:      p.Uses,
+		}
+	}
+
+	return invitations
+}
@@ -0,0 +1,294 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestNewInvitation(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name      string
+		Code      string
+		Role      course.AcademicType
+		ExpiresAt time.Time
+		MaxUses   int
+		Err       error
+	}{
+		{
+			Name:      "valid_invitation",
+			Code:      "K7QX2M4PZA",
+			Role:      course.StudentType,
+			ExpiresAt: expiresAt,
+			MaxUses:   30,
+		},
+		{
+			Name:      "empty_code",
+			Role:      course.StudentType,
+			ExpiresAt: expiresAt,
+			MaxUses:   30,
+			Err:       course.ErrEmptyInvitationCode,
+		},
+		{
+			Name:      "invalid_role",
+			Code:      "K7QX2M4PZA",
+			ExpiresAt: expiresAt,
+			MaxUses:   30,
+			Err:       course.ErrInvalidInvitationRole,
+		},
+		{
+			Name:    "zero_expiry",
+			Code:    "K7QX2M4PZA",
+			Role:    course.TeacherType,
+			MaxUses: 1,
+			Err:     course.ErrZeroInvitationExpiry,
+		},
+		{
+			Name:      "zero_max_uses",
+			Code:      "K7QX2M4PZA",
+			Role:      course.TeacherType,
+			ExpiresAt: expiresAt,
+			Err:       course.ErrInvalidInvitationMaxUses,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			invitation, err := course.NewInvitation(c.Code, c.Role, c.ExpiresAt, c.MaxUses)
+			if c.Err != nil {
+				require.ErrorIs(t, err, c.Err)
+				require.True(t, course.IsInvalidInvitationError(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Code, invitation.Code())
+			require.Equal(t, c.Role, invitation.Role())
+			require.Equal(t, c.ExpiresAt, invitation.ExpiresAt())
+			require.Equal(t, c.MaxUses, invitation.MaxUses())
+			require.Zero(t, invitation.Uses())
+		})
+	}
+}
+
+func TestCourse_AddInvitation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name       string
+		Academic   course.Academic
+		Invitation course.Invitation
+		IsErr      func(err error) bool
+	}{
+		{
+			Name:       "creator_can_add_invitation",
+			Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+			Invitation: course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 10),
+		},
+		{
+			Name:       "collaborator_can_add_invitation",
+			Academic:   course.MustNewAcademic("collaborator-id", course.TeacherType),
+			Invitation: course.MustNewInvitation("code", course.TeacherType, now.Add(time.Hour), 1),
+		},
+		{
+			Name:       "student_cant_add_invitation",
+			Academic:   course.MustNewAcademic("student-id", course.StudentType),
+			Invitation: course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 10),
+			IsErr:      course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:       "expired_invitation",
+			Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+			Invitation: course.MustNewInvitation("code", course.StudentType, now, 10),
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrInvitationExpired)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"), withCollaborators("collaborator-id"))
+
+			err := crs.AddInvitation(c.Academic, c.Invitation, now)
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Empty(t, crs.Invitations())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []course.Invitation{c.Invitation}, crs.Invitations())
+
+			err = crs.AddInvitation(c.Academic, c.Invitation, now)
+			require.ErrorIs(t, err, course.ErrCourseAlreadyHasInvitation)
+		})
+	}
+}
+
+func TestCourse_RevokeInvitation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	student := course.MustNewAcademic("student-id", course.StudentType)
+	crs := newCourse(t, creator, withStudents(student.ID()))
+	require.NoError(t, crs.AddInvitation(
+		creator,
+		course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 10),
+		now,
+	))
+
+	err := crs.RevokeInvitation(student, "code")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+
+	err = crs.RevokeInvitation(creator, "code")
+	require.NoError(t, err)
+	require.Empty(t, crs.Invitations())
+
+	err = crs.RevokeInvitation(creator, "code")
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchInvitation)
+
+	err = crs.RedeemInvitation(course.MustNewAcademic("student1-id", course.StudentType), "code", now)
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchInvitation)
+}
+
+func TestCourse_RedeemInvitation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Code     string
+		Now      time.Time
+		Err      error
+	}{
+		{
+			Name:     "student_joins_as_student",
+			Academic: course.MustNewAcademic("student1-id", course.StudentType),
+			Code:     "student-code",
+			Now:      now,
+		},
+		{
+			Name:     "teacher_joins_as_collaborator",
+			Academic: course.MustNewAcademic("teacher-id", course.TeacherType),
+			Code:     "teacher-code",
+			Now:      now,
+		},
+		{
+			Name:     "no_such_invitation",
+			Academic: course.MustNewAcademic("student1-id", course.StudentType),
+			Code:     "unknown-code",
+			Now:      now,
+			Err:      course.ErrCourseHasNoSuchInvitation,
+		},
+		{
+			Name:     "teacher_cant_redeem_student_invitation",
+			Academic: course.MustNewAcademic("teacher-id", course.TeacherType),
+			Code:     "student-code",
+			Now:      now,
+			Err:      course.ErrInvitationForAnotherRole,
+		},
+		{
+			Name:     "expired_invitation",
+			Academic: course.MustNewAcademic("student1-id", course.StudentType),
+			Code:     "student-code",
+			Now:      now.Add(time.Hour),
+			Err:      course.ErrInvitationExpired,
+		},
+		{
+			Name:     "student_already_in_course",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			Code:     "student-code",
+			Now:      now,
+			Err:      course.ErrAcademicAlreadyInCourse,
+		},
+		{
+			Name:     "creator_already_in_course",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Code:     "teacher-code",
+			Now:      now,
+			Err:      course.ErrAcademicAlreadyInCourse,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"))
+			require.NoError(t, crs.AddInvitation(
+				creator,
+				course.MustNewInvitation("student-code", course.StudentType, now.Add(time.Hour), 10),
+				now,
+			))
+			require.NoError(t, crs.AddInvitation(
+				creator,
+				course.MustNewInvitation("teacher-code", course.TeacherType, now.Add(time.Hour), 10),
+				now,
+			))
+
+			err := crs.RedeemInvitation(c.Academic, c.Code, c.Now)
+			if c.Err != nil {
+				require.ErrorIs(t, err, c.Err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			if c.Academic.Type() == course.StudentType {
+				require.Contains(t, crs.Students(), c.Academic.ID())
+			} else {
+				require.Contains(t, crs.Collaborators(), c.Academic.ID())
+			}
+
+			for _, invitation := range crs.Invitations() {
+				if invitation.Code() == c.Code {
+					require.Equal(t, 1, invitation.Uses())
+				}
+			}
+		})
+	}
+}
+
+func TestCourse_RedeemInvitation_usedUp(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	require.NoError(t, crs.AddInvitation(
+		creator,
+		course.MustNewInvitation("code", course.StudentType, now.Add(time.Hour), 2),
+		now,
+	))
+
+	require.NoError(t, crs.RedeemInvitation(course.MustNewAcademic("student1-id", course.StudentType), "code", now))
+	require.NoError(t, crs.RedeemInvitation(course.MustNewAcademic("student2-id", course.StudentType), "code", now))
+
+	err := crs.RedeemInvitation(course.MustNewAcademic("student3-id", course.StudentType), "code", now)
+	require.ErrorIs(t, err, course.ErrInvitationUsedUp)
+	require.ElementsMatch(t, []string{"student1-id", "student2-id"}, crs.Students())
+}
@@ -0,0 +1,99 @@
+package course
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MaterialStatus tells whether students can get auxiliary material.
+type MaterialStatus uint8
+
+const (
+	ReleasedMaterial MaterialStatus = iota + 1
+	ScheduledMaterial
+	HiddenMaterial
+)
+
+func (s MaterialStatus) String() string {
+	switch s {
+	case ReleasedMaterial:
+		return "released"
+	case ScheduledMaterial:
+		return "scheduled"
+	case HiddenMaterial:
+		return "hidden"
+	}
+
+	return "%!MaterialStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+var ErrInvalidMaterialsOrder = errors.New("materials order should contain every material of course exactly once")
+
+// WithRelease returns copy of material that is released to students
+// at release time, zero time releases material immediately.
+// Hidden material isn't released until it's unhidden.
+func (m AuxiliaryMaterial) WithRelease(releaseTime time.Time, hidden bool) AuxiliaryMaterial {
+	if !releaseTime.IsZero() {
+		releaseTime = releaseTime.UTC()
+	}
+
+	m.releaseTime = releaseTime
+	m.hidden = hidden
+
+	return m
+}
+
+func (m AuxiliaryMaterial) ReleaseTime() time.Time {
+	return m.releaseTime
+}
+
+func (m AuxiliaryMaterial) Hidden() bool {
+	return m.hidden
+}
+
+func (m AuxiliaryMaterial) Status(now time.Time) MaterialStatus {
+	if m.hidden {
+		return HiddenMaterial
+	}
+
+	if now.Before(m.releaseTime) {
+		return ScheduledMaterial
+	}
+
+	return ReleasedMaterial
+}
+
+func (m AuxiliaryMaterial) IsReleased(now time.Time) bool {
+	return m.Status(now) == ReleasedMaterial
+}
+
+// ReorderAuxiliaryMaterials sorts materials in order of given IDs,
+// that should contain ID of every material of course exactly once.
+func (c *Course) ReorderAuxiliaryMaterials(academic Academic, materialIDs []string) error {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
+		return err
+	}
+
+	if len(materialIDs) != len(c.materials) {
+		return ErrInvalidMaterialsOrder
+	}
+
+	materials := make([]AuxiliaryMaterial, 0, len(c.materials))
+	seen := make(map[string]bool, len(materialIDs))
+
+	for _, id := range materialIDs {
+		i, err := c.materialIndex(id)
+		if err != nil || seen[id] {
+			return ErrInvalidMaterialsOrder
+		}
+
+		seen[id] = true
+		materials = append(materials, c.materials[i])
+	}
+
+	c.materials = materials
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestAuxiliaryMaterial_Status(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2043, time.September, 8, 10, 0, 0, 0, time.UTC)
+	material := course.MustNewAuxiliaryMaterial("material-id", "https://example.com/slides", course.PresentationResource)
+
+	testCases := []struct {
+		Name        string
+		ReleaseTime time.Time
+		Hidden      bool
+		Status      course.MaterialStatus
+	}{
+		{
+			Name:   "released_without_release_time",
+			Status: course.ReleasedMaterial,
+		},
+		{
+			Name:        "released_at_release_time",
+			ReleaseTime: now,
+			Status:      course.ReleasedMaterial,
+		},
+		{
+			Name:        "scheduled_before_release_time",
+			ReleaseTime: now.Add(time.Hour),
+			Status:      course.ScheduledMaterial,
+		},
+		{
+			Name:        "hidden_after_release_time",
+			ReleaseTime: now.Add(-time.Hour),
+			Hidden:      true,
+			Status:      course.HiddenMaterial,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			m := material.WithRelease(c.ReleaseTime, c.Hidden)
+
+			require.Equal(t, c.Status, m.Status(now))
+			require.Equal(t, c.Status == course.ReleasedMaterial, m.IsReleased(now))
+			require.Equal(t, c.Hidden, m.Hidden())
+		})
+	}
+}
+
+func TestCourse_ReorderAuxiliaryMaterials(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	first := course.MustNewAuxiliaryMaterial("first-id", "https://example.com/1", course.VideoResource)
+	second := course.MustNewAuxiliaryMaterial("second-id", "https://example.com/2", course.VideoResource)
+	third := course.MustNewAuxiliaryMaterial("third-id", "https://example.com/3", course.VideoResource)
+
+	testCases := []struct {
+		Name        string
+		Academic    course.Academic
+		MaterialIDs []string
+		Expected    []course.AuxiliaryMaterial
+		IsErr       func(err error) bool
+	}{
+		{
+			Name:        "reorder_materials",
+			Academic:    creator,
+			MaterialIDs: []string{"third-id", "first-id", "second-id"},
+			Expected:    []course.AuxiliaryMaterial{third, first, second},
+		},
+		{
+			Name:        "student_cant_reorder_materials",
+			Academic:    course.MustNewAcademic("student-id", course.StudentType),
+			MaterialIDs: []string{"third-id", "first-id", "second-id"},
+			IsErr:       course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:        "material_missing_in_order",
+			Academic:    creator,
+			MaterialIDs: []string{"third-id", "first-id"},
+			IsErr:       isInvalidMaterialsOrderError,
+		},
+		{
+			Name:        "material_repeated_in_order",
+			Academic:    creator,
+			MaterialIDs: []string{"third-id", "first-id", "first-id"},
+			IsErr:       isInvalidMaterialsOrderError,
+		},
+		{
+			Name:        "unknown_material_in_order",
+			Academic:    creator,
+			MaterialIDs: []string{"third-id", "first-id", "other-id"},
+			IsErr:       isInvalidMaterialsOrderError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := newCourse(t, creator, withStudents("student-id"))
+			require.NoError(t, crs.AttachAuxiliaryMaterial(creator, first))
+			require.NoError(t, crs.AttachAuxiliaryMaterial(creator, second))
+			require.NoError(t, crs.AttachAuxiliaryMaterial(creator, third))
+
+			err := crs.ReorderAuxiliaryMaterials(c.Academic, c.MaterialIDs)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, []course.AuxiliaryMaterial{first, second, third}, crs.AuxiliaryMaterials())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Expected, crs.AuxiliaryMaterials())
+		})
+	}
+}
+
+func isInvalidMaterialsOrderError(err error) bool {
+	return errors.Is(err, course.ErrInvalidMaterialsOrder)
+}
@@ -1,6 +1,10 @@
 package course
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 func (c *Course) Students() []string {
 	students := make([]string, 0, len(c.students))
@@ -11,26 +15,33 @@ func (c *Course) Students() []string {
 	return students
 }
 
-func (c *Course) AddStudents(academic Academic, studentIDs ...string) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+// AddStudents adds all students or none of them, returns CourseIsFullError
+// if course hasn't enough free seats. Added students leave waitlist and
+// withdrawn students are enrolled again.
+func (c *Course) AddStudents(academic Academic, now time.Time, studentIDs ...string) error {
+	if err := c.CanAddStudents(academic, studentIDs...); err != nil {
 		return err
 	}
 
-	c.putStudents(studentIDs)
+	c.putStudents(studentIDs, now)
 
 	return nil
 }
 
-func (c *Course) putStudents(studentIDs []string) {
+func (c *Course) putStudents(studentIDs []string, now time.Time) {
 	for _, sid := range studentIDs {
-		c.students[sid] = true
+		if !c.hasStudent(sid) {
+			c.enrollStudent(sid, now)
+		}
 	}
 }
 
 var ErrCourseHasNoSuchStudent = errors.New("course has no such student")
 
-func (c *Course) RemoveStudent(academic Academic, studentID string) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+// RemoveStudent withdraws student from course for given reason keeping
+// enrollment record, freed seat goes to the first student of waitlist.
+func (c *Course) RemoveStudent(academic Academic, studentID, reason string, now time.Time) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
 		return err
 	}
 
@@ -38,7 +49,12 @@ func (c *Course) RemoveStudent(academic Academic, studentID string) error {
 		return ErrCourseHasNoSuchStudent
 	}
 
-	delete(c.students, studentID)
+	if err := validateWithdrawalReason(reason); err != nil {
+		return err
+	}
+
+	c.withdrawStudent(studentID, reason, now)
+	c.promoteWaitlisted(now)
 
 	return nil
 }
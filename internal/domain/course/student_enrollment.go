@@ -0,0 +1,264 @@
+package course
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type StudentStatus uint8
+
+const (
+	ActiveStudent StudentStatus = iota + 1
+	WithdrawnStudent
+	CompletedStudent
+)
+
+func (s StudentStatus) String() string {
+	switch s {
+	case ActiveStudent:
+		return "active"
+	case WithdrawnStudent:
+		return "withdrawn"
+	case CompletedStudent:
+		return "completed"
+	}
+
+	return "%!StudentStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+func (s StudentStatus) IsValid() bool {
+	switch s {
+	case ActiveStudent, WithdrawnStudent, CompletedStudent:
+		return true
+	}
+
+	return false
+}
+
+// StudentEnrollment is record of period of student participation in course.
+// Withdrawn student isn't student of course anymore, but record is kept and
+// enrolling again starts new period, so course keeps all periods of student.
+// Students enrolled before records were kept have zero enrolled at time.
+type StudentEnrollment struct {
+	studentID   string
+	status      StudentStatus
+	enrolledAt  time.Time
+	withdrawnAt time.Time
+	reason      string
+}
+
+const withdrawalReasonMaxLen = 500
+
+var (
+	ErrWithdrawalReasonTooLong = errors.New("withdrawal reason too long")
+	ErrStudentAlreadyCompleted = errors.New("student has already completed course")
+)
+
+func (e StudentEnrollment) StudentID() string {
+	return e.studentID
+}
+
+func (e StudentEnrollment) Status() StudentStatus {
+	return e.status
+}
+
+func (e StudentEnrollment) EnrolledAt() time.Time {
+	return e.enrolledAt
+}
+
+// WithdrawnAt returns zero time if student isn't withdrawn.
+func (e StudentEnrollment) WithdrawnAt() time.Time {
+	return e.withdrawnAt
+}
+
+// Reason returns reason of withdrawal, it may be empty.
+func (e StudentEnrollment) Reason() string {
+	return e.reason
+}
+
+// StudentEnrollments returns the last enrollment of each student sorted by enrolled
+// at time and then by student ID, withdrawn students are returned only if includeWithdrawn.
+func (c *Course) StudentEnrollments(includeWithdrawn bool) []StudentEnrollment {
+	enrollments := make([]StudentEnrollment, 0, len(c.enrollments))
+	for sid := range c.students {
+		enrollments = append(enrollments, c.studentEnrollment(sid))
+	}
+
+	if includeWithdrawn {
+		for sid, periods := range c.enrollments {
+			if !c.hasStudent(sid) {
+				enrollments = append(enrollments, periods[len(periods)-1])
+			}
+		}
+	}
+
+	sortStudentEnrollments(enrollments)
+
+	return enrollments
+}
+
+// AllStudentEnrollments returns every enrollment period of every student
+// sorted like StudentEnrollments, it's all course knows about its students.
+func (c *Course) AllStudentEnrollments() []StudentEnrollment {
+	enrollments := make([]StudentEnrollment, 0, len(c.enrollments))
+	for sid := range c.students {
+		if _, ok := c.enrollments[sid]; !ok {
+			enrollments = append(enrollments, c.studentEnrollment(sid))
+		}
+	}
+
+	for _, periods := range c.enrollments {
+		enrollments = append(enrollments, periods...)
+	}
+
+	sortStudentEnrollments(enrollments)
+
+	return enrollments
+}
+
+// StudentEnrollment returns the last enrollment of current or withdrawn student.
+func (c *Course) StudentEnrollment(studentID string) (StudentEnrollment, bool) {
+	if c.hasStudent(studentID) {
+		return c.studentEnrollment(studentID), true
+	}
+
+	periods, ok := c.enrollments[studentID]
+	if !ok {
+		return StudentEnrollment{}, false
+	}
+
+	return periods[len(periods)-1], true
+}
+
+// StudentEnrollmentHistory returns all enrollment periods of student from the oldest.
+func (c *Course) StudentEnrollmentHistory(studentID string) []StudentEnrollment {
+	if periods, ok := c.enrollments[studentID]; ok {
+		return append([]StudentEnrollment(nil), periods...)
+	}
+
+	if c.hasStudent(studentID) {
+		return []StudentEnrollment{c.studentEnrollment(studentID)}
+	}
+
+	return nil
+}
+
+// CompleteStudent marks that student completed course,
+// completed student stays in course.
+func (c *Course) CompleteStudent(academic Academic, studentID string) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if !c.hasStudent(studentID) {
+		return ErrCourseHasNoSuchStudent
+	}
+
+	enrollment := c.studentEnrollment(studentID)
+	if enrollment.status == CompletedStudent {
+		return ErrStudentAlreadyCompleted
+	}
+
+	enrollment.status = CompletedStudent
+	c.putStudentEnrollment(enrollment)
+
+	return nil
+}
+
+// studentEnrollment returns the last enrollment of current
+// student, student without record is active since unknown time.
+func (c *Course) studentEnrollment(studentID string) StudentEnrollment {
+	if periods, ok := c.enrollments[studentID]; ok {
+		return periods[len(periods)-1]
+	}
+
+	return StudentEnrollment{studentID: studentID, status: ActiveStudent}
+}
+
+// putStudentEnrollment replaces the last enrollment period of student,
+// student without record gets it as the first period.
+func (c *Course) putStudentEnrollment(enrollment StudentEnrollment) {
+	periods := c.enrollments[enrollment.studentID]
+	if len(periods) == 0 {
+		c.enrollments[enrollment.studentID] = []StudentEnrollment{enrollment}
+
+		return
+	}
+
+	updated := make([]StudentEnrollment, len(periods))
+	copy(updated, periods)
+	updated[len(updated)-1] = enrollment
+	c.enrollments[enrollment.studentID] = updated
+}
+
+// enrollStudent adds student to course, withdrawn student is
+// enrolled again with new period after kept withdrawn ones.
+func (c *Course) enrollStudent(studentID string, now time.Time) {
+	periods := c.enrollments[studentID]
+	c.students[studentID] = true
+	c.enrollments[studentID] = append(periods[:len(periods):len(periods)], StudentEnrollment{
+		studentID:  studentID,
+		status:     ActiveStudent,
+		enrolledAt: now.UTC(),
+	})
+	c.dropFromWaitlist(studentID)
+}
+
+// withdrawStudent removes student from course with all traces
+// of membership, origin group and team, but keeps record.
+func (c *Course) withdrawStudent(studentID, reason string, now time.Time) {
+	enrollment := c.studentEnrollment(studentID)
+	enrollment.status = WithdrawnStudent
+	enrollment.withdrawnAt = now.UTC()
+	enrollment.reason = reason
+	c.putStudentEnrollment(enrollment)
+
+	delete(c.students, studentID)
+	delete(c.studentGroups, studentID)
+
+	for _, t := range c.teams {
+		delete(t.members, studentID)
+	}
+}
+
+func validateWithdrawalReason(reason string) error {
+	if len(reason) > withdrawalReasonMaxLen {
+		return ErrWithdrawalReasonTooLong
+	}
+
+	return nil
+}
+
+func sortStudentEnrollments(enrollments []StudentEnrollment) {
+	sort.SliceStable(enrollments, func(i, j int) bool {
+		if !enrollments[i].enrolledAt.Equal(enrollments[j].enrolledAt) {
+			return enrollments[i].enrolledAt.Before(enrollments[j].enrolledAt)
+		}
+
+		return enrollments[i].studentID < enrollments[j].studentID
+	})
+}
+
+// unmarshalStudentEnrollments groups periods by students, periods of
+// student are kept from the oldest like they are added by enrolling.
+func unmarshalStudentEnrollments(params []UnmarshallingStudentEnrollmentParams) map[string][]StudentEnrollment {
+	enrollments := make(map[string][]StudentEnrollment, len(params))
+	for _, p := range params {
+		enrollments[p.StudentID] = append(enrollments[p.StudentID], StudentEnrollment{
+			studentID:   p.StudentID,
+			status:      p.Status,
+			enrolledAt:  p.EnrolledAt,
+			withdrawnAt: p.WithdrawnAt,
+			reason:      p.Reason,
+		})
+	}
+
+	for _, periods := range enrollments {
+		sortStudentEnrollments(periods)
+	}
+
+	return enrollments
+}
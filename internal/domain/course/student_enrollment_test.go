@@ -0,0 +1,180 @@
+package course_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_RemoveStudent_keeps_enrollment(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	enrolledAt := time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC)
+	withdrawnAt := enrolledAt.AddDate(0, 1, 0)
+
+	err := crs.AddStudents(creator, enrolledAt, "student-id")
+	require.NoError(t, err)
+
+	err = crs.RemoveStudent(creator, "student-id", "academic leave", withdrawnAt)
+	require.NoError(t, err)
+
+	require.Empty(t, crs.Students())
+	require.Empty(t, crs.StudentEnrollments(false))
+
+	enrollment, ok := crs.StudentEnrollment("student-id")
+	require.True(t, ok)
+	require.Equal(t, course.WithdrawnStudent, enrollment.Status())
+	require.Equal(t, enrolledAt, enrollment.EnrolledAt())
+	require.Equal(t, withdrawnAt, enrollment.WithdrawnAt())
+	require.Equal(t, "academic leave", enrollment.Reason())
+	require.Len(t, crs.StudentEnrollments(true), 1)
+}
+
+func TestCourse_RemoveStudent_with_too_long_reason(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student-id"))
+
+	err := crs.RemoveStudent(creator, "student-id", strings.Repeat("r", 501), time.Now())
+	require.ErrorIs(t, err, course.ErrWithdrawalReasonTooLong)
+	require.Equal(t, []string{"student-id"}, crs.Students())
+}
+
+func TestCourse_AddStudents_enrolls_withdrawn_student_again(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	enrolledAt := time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC)
+	withdrawnAt := enrolledAt.AddDate(0, 1, 0)
+	reenrolledAt := withdrawnAt.AddDate(0, 1, 0)
+
+	require.NoError(t, crs.AddStudents(creator, enrolledAt, "student-id"))
+	require.NoError(t, crs.RemoveStudent(creator, "student-id", "moved", withdrawnAt))
+
+	err := crs.AddStudents(creator, reenrolledAt.In(time.FixedZone("MSK", 3*60*60)), "student-id")
+	require.NoError(t, err)
+
+	enrollment, ok := crs.StudentEnrollment("student-id")
+	require.True(t, ok)
+	require.Equal(t, course.ActiveStudent, enrollment.Status())
+	require.Equal(t, reenrolledAt, enrollment.EnrolledAt())
+	require.True(t, enrollment.WithdrawnAt().IsZero())
+	require.Empty(t, enrollment.Reason())
+	require.Len(t, crs.StudentEnrollments(true), 1)
+
+	history := crs.StudentEnrollmentHistory("student-id")
+	require.Len(t, history, 2)
+	require.Equal(t, course.WithdrawnStudent, history[0].Status())
+	require.Equal(t, enrolledAt, history[0].EnrolledAt())
+	require.Equal(t, withdrawnAt, history[0].WithdrawnAt())
+	require.Equal(t, "moved", history[0].Reason())
+	require.Equal(t, enrollment, history[1])
+	require.Equal(t, history, crs.AllStudentEnrollments())
+}
+
+func TestCourse_Extend_enrollsStudents(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator)
+	enrolledAt := time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC)
+	extendedAt := time.Date(2022, time.February, 7, 9, 0, 0, 0, time.UTC)
+
+	require.NoError(t, crs.AddStudents(creator, enrolledAt, "student1-id", "student2-id"))
+	require.NoError(t, crs.RemoveStudent(creator, "student2-id", "moved", enrolledAt.AddDate(0, 1, 0)))
+
+	extended, err := crs.Extend(course.CreationParams{
+		ID:       "extended-course-id",
+		Creator:  creator,
+		Students: []string{"student3-id"},
+	}, extendedAt)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"student1-id", "student3-id"}, extended.Students())
+
+	enrollments := extended.StudentEnrollments(true)
+	require.Len(t, enrollments, 2)
+
+	for _, e := range enrollments {
+		require.Equal(t, course.ActiveStudent, e.Status())
+		require.Equal(t, extendedAt, e.EnrolledAt())
+	}
+
+	require.Len(t, crs.StudentEnrollments(true), 2, "origin course should keep enrollments")
+}
+
+func TestCourse_CompleteStudent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		Academic  course.Academic
+		StudentID string
+		Complete  bool
+		IsErr     func(err error) bool
+	}{
+		{
+			Name:      "teacher_can_complete_student",
+			Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+			StudentID: "student-id",
+		},
+		{
+			Name:      "student_cant_complete_student",
+			Academic:  course.MustNewAcademic("student-id", course.StudentType),
+			StudentID: "student-id",
+			IsErr:     course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:      "cant_complete_not_course_student",
+			Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+			StudentID: "another-student-id",
+			IsErr: func(err error) bool {
+				return err == course.ErrCourseHasNoSuchStudent
+			},
+		},
+		{
+			Name:      "cant_complete_student_twice",
+			Academic:  course.MustNewAcademic("creator-id", course.TeacherType),
+			StudentID: "student-id",
+			Complete:  true,
+			IsErr: func(err error) bool {
+				return err == course.ErrStudentAlreadyCompleted
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"))
+
+			if c.Complete {
+				require.NoError(t, crs.CompleteStudent(creator, c.StudentID))
+			}
+
+			err := crs.CompleteStudent(c.Academic, c.StudentID)
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+
+			enrollment, ok := crs.StudentEnrollment(c.StudentID)
+			require.True(t, ok)
+			require.Equal(t, course.CompletedStudent, enrollment.Status())
+			require.Equal(t, []string{"student-id"}, crs.Students())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package course_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -43,7 +44,7 @@ func TestCourse_AddStudents(t *testing.T) {
 
 			creator := course.MustNewAcademic("creator-id", course.TeacherType)
 			crs := newCourse(t, creator, withStudents("student-id"), withCollaborators("collaborator-id"))
-			err := crs.AddStudents(c.Academic, "student1-id", "student2-id")
+			err := crs.AddStudents(c.Academic, time.Now(), "student1-id", "student2-id")
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
@@ -95,7 +96,7 @@ func TestCourse_RemoveStudents(t *testing.T) {
 				withStudents("student-id", "student-to-remove-id"),
 				withCollaborators("collaborator-id"),
 			)
-			err := crs.RemoveStudent(c.Academic, "student-to-remove-id")
+			err := crs.RemoveStudent(c.Academic, "student-to-remove-id", "", time.Now())
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
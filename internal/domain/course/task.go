@@ -3,6 +3,7 @@ package course
 import (
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -41,6 +42,9 @@ type taskOptional struct {
 	deadline   Deadline
 	testPoints []TestPoint
 	testData   []TestData
+	checker    Checker
+	languages  []string
+	limits     ExecutionLimits
 }
 
 type Task struct {
@@ -48,7 +52,10 @@ type Task struct {
 	title       string
 	description string
 	taskType    TaskType
+	teamWork    bool
 	optional    taskOptional
+
+	version int
 }
 
 func (t *Task) Number() int {
@@ -67,6 +74,12 @@ func (t *Task) Type() TaskType {
 	return t.taskType
 }
 
+// TeamWork reports whether task is done by teams,
+// so solution of one member counts for whole team.
+func (t *Task) TeamWork() bool {
+	return t.teamWork
+}
+
 func (t *Task) Deadline() (Deadline, bool) {
 	if t.taskType == ManualCheckingType ||
 		t.taskType == AutoCodeCheckingType {
@@ -84,6 +97,52 @@ func (t *Task) TestData() ([]TestData, bool) {
 	return nil, false
 }
 
+func (t *Task) Checker() (Checker, bool) {
+	if t.taskType == AutoCodeCheckingType {
+		return t.checker(), true
+	}
+
+	return Checker{}, false
+}
+
+// Languages returns IDs of languages allowed for solutions,
+// empty languages mean that any supported language is allowed.
+func (t *Task) Languages() ([]string, bool) {
+	if t.taskType == AutoCodeCheckingType {
+		return t.languages(), true
+	}
+
+	return nil, false
+}
+
+func (t *Task) ExecutionLimits() (ExecutionLimits, bool) {
+	if t.taskType == AutoCodeCheckingType {
+		return t.limits(), true
+	}
+
+	return ExecutionLimits{}, false
+}
+
+// AllowsLanguage reports whether solution of auto code
+// checking task can be written in language with given ID.
+func (t *Task) AllowsLanguage(language string) bool {
+	if t.taskType != AutoCodeCheckingType {
+		return false
+	}
+
+	if len(t.optional.languages) == 0 {
+		return true
+	}
+
+	for _, l := range t.optional.languages {
+		if l == language {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (t *Task) TestPoints() ([]TestPoint, bool) {
 	if t.taskType == TestingType {
 		return t.testPoints(), true
@@ -103,6 +162,29 @@ func (t *Task) testData() []TestData {
 	return testDataCopy
 }
 
+func (t *Task) checker() Checker {
+	if t.optional.checker.IsZero() {
+		return DefaultChecker()
+	}
+
+	return t.optional.checker
+}
+
+func (t *Task) languages() []string {
+	languagesCopy := make([]string, len(t.optional.languages))
+	copy(languagesCopy, t.optional.languages)
+
+	return languagesCopy
+}
+
+func (t *Task) limits() ExecutionLimits {
+	if t.optional.limits.IsZero() {
+		return DefaultExecutionLimits()
+	}
+
+	return t.optional.limits
+}
+
 func (t *Task) testPoints() []TestPoint {
 	testPointsCopy := make([]TestPoint, len(t.optional.testPoints))
 	copy(testPointsCopy, t.optional.testPoints)
@@ -111,17 +193,23 @@ func (t *Task) testPoints() []TestPoint {
 }
 
 const (
-	taskTitleMaxLen       = 200
-	taskDescriptionMaxLen = 1000
+	taskTitleMaxLen = 200
+
+	// TaskDescriptionMaxLen is hard limit of Markdown task description,
+	// applications may apply stricter configurable limit.
+	TaskDescriptionMaxLen = 100000
 )
 
 var (
-	ErrTaskHasNoDeadline      = errors.New("task has no deadline")
-	ErrTaskHasNoTestPoints    = errors.New("task has no test points")
-	ErrTaskHasNoTestData      = errors.New("task has no test data")
-	ErrTaskTitleTooLong       = errors.New("task title too long")
-	ErrTaskDescriptionTooLong = errors.New("task description too long")
-	ErrCourseHasNoSuchTask    = errors.New("course has no such task")
+	ErrTaskHasNoDeadline        = errors.New("task has no deadline")
+	ErrTaskHasNoTestPoints      = errors.New("task has no test points")
+	ErrTaskHasNoTestData        = errors.New("task has no test data")
+	ErrTaskHasNoChecker         = errors.New("task has no checker")
+	ErrTaskHasNoLanguages       = errors.New("task has no languages")
+	ErrTaskHasNoExecutionLimits = errors.New("task has no execution limits")
+	ErrTaskTitleTooLong         = errors.New("task title too long")
+	ErrTaskDescriptionTooLong   = errors.New("task description too long")
+	ErrCourseHasNoSuchTask      = errors.New("course has no such task")
 )
 
 func IsInvalidTaskParametersError(err error) bool {
@@ -129,6 +217,17 @@ func IsInvalidTaskParametersError(err error) bool {
 		errors.Is(err, ErrTaskDescriptionTooLong)
 }
 
+// IsTaskPropertyMismatchError reports whether
+// task type doesn't have replaced property.
+func IsTaskPropertyMismatchError(err error) bool {
+	return errors.Is(err, ErrTaskHasNoDeadline) ||
+		errors.Is(err, ErrTaskHasNoTestPoints) ||
+		errors.Is(err, ErrTaskHasNoTestData) ||
+		errors.Is(err, ErrTaskHasNoChecker) ||
+		errors.Is(err, ErrTaskHasNoLanguages) ||
+		errors.Is(err, ErrTaskHasNoExecutionLimits)
+}
+
 func (t *Task) rename(title string) error {
 	if len(title) > taskTitleMaxLen {
 		return ErrTaskTitleTooLong
@@ -140,7 +239,7 @@ func (t *Task) rename(title string) error {
 }
 
 func (t *Task) replaceDescription(description string) error {
-	if len(description) > taskDescriptionMaxLen {
+	if len(description) > TaskDescriptionMaxLen {
 		return ErrTaskDescriptionTooLong
 	}
 
@@ -179,16 +278,64 @@ func (t *Task) replaceTestData(testData []TestData) error {
 	return nil
 }
 
+func (t *Task) replaceChecker(checker Checker) error {
+	if t.taskType != AutoCodeCheckingType {
+		return ErrTaskHasNoChecker
+	}
+
+	if checker.IsZero() {
+		checker = DefaultChecker()
+	}
+
+	t.optional.checker = checker
+
+	return nil
+}
+
+func (t *Task) replaceLanguages(languages []string) error {
+	if t.taskType != AutoCodeCheckingType {
+		return ErrTaskHasNoLanguages
+	}
+
+	if err := validateLanguages(languages); err != nil {
+		return err
+	}
+
+	languagesCopy := make([]string, len(languages))
+	copy(languagesCopy, languages)
+	t.optional.languages = languagesCopy
+
+	return nil
+}
+
+func (t *Task) replaceLimits(limits ExecutionLimits) error {
+	if t.taskType != AutoCodeCheckingType {
+		return ErrTaskHasNoExecutionLimits
+	}
+
+	if limits.IsZero() {
+		limits = DefaultExecutionLimits()
+	}
+
+	t.optional.limits = limits
+
+	return nil
+}
+
 func (t *Task) copy() *Task {
 	return &Task{
 		number:      t.Number(),
 		title:       t.Title(),
 		description: t.Description(),
 		taskType:    t.Type(),
+		teamWork:    t.TeamWork(),
 		optional: taskOptional{
 			deadline:   Deadline{},
 			testPoints: t.testPoints(),
 			testData:   t.testData(),
+			checker:    t.optional.checker,
+			languages:  t.languages(),
+			limits:     t.optional.limits,
 		},
 	}
 }
@@ -214,15 +361,25 @@ func (c *Course) Tasks() []Task {
 type ManualCheckingTaskCreationParams struct {
 	Title       string
 	Description string
+	TeamWork    bool
 	Deadline    Deadline
 }
 
-func (c *Course) AddManualCheckingTask(academic Academic, params ManualCheckingTaskCreationParams) (int, error) {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+func (c *Course) AddManualCheckingTask(
+	academic Academic,
+	params ManualCheckingTaskCreationParams,
+	now time.Time,
+) (int, error) {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
 		return 0, err
 	}
 
-	task, err := c.newTask(params.Title, params.Description, ManualCheckingType, taskOptional{deadline: params.Deadline})
+	task, err := c.newTask(academic.ID(), now, taskCommon{
+		title:       params.Title,
+		description: params.Description,
+		taskType:    ManualCheckingType,
+		teamWork:    params.TeamWork,
+	}, taskOptional{deadline: params.Deadline})
 	if err != nil {
 		return 0, err
 	}
@@ -233,21 +390,54 @@ func (c *Course) AddManualCheckingTask(academic Academic, params ManualCheckingT
 type AutoCodeCheckingTaskCreationParams struct {
 	Title       string
 	Description string
+	TeamWork    bool
 	Deadline    Deadline
 	TestData    []TestData
+	Checker     Checker
+	Languages   []string
+	Limits      ExecutionLimits
 }
 
-func (c *Course) AddAutoCodeCheckingTask(academic Academic, params AutoCodeCheckingTaskCreationParams) (int, error) {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+func (c *Course) AddAutoCodeCheckingTask(
+	academic Academic,
+	params AutoCodeCheckingTaskCreationParams,
+	now time.Time,
+) (int, error) {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
 		return 0, err
 	}
 
 	testDataCopy := make([]TestData, len(params.TestData))
 	copy(testDataCopy, params.TestData)
 
-	task, err := c.newTask(params.Title, params.Description, AutoCodeCheckingType, taskOptional{
-		deadline: params.Deadline,
-		testData: testDataCopy,
+	checker := params.Checker
+	if checker.IsZero() {
+		checker = DefaultChecker()
+	}
+
+	if err := validateLanguages(params.Languages); err != nil {
+		return 0, err
+	}
+
+	languagesCopy := make([]string, len(params.Languages))
+	copy(languagesCopy, params.Languages)
+
+	limits := params.Limits
+	if limits.IsZero() {
+		limits = DefaultExecutionLimits()
+	}
+
+	task, err := c.newTask(academic.ID(), now, taskCommon{
+		title:       params.Title,
+		description: params.Description,
+		taskType:    AutoCodeCheckingType,
+		teamWork:    params.TeamWork,
+	}, taskOptional{
+		deadline:  params.Deadline,
+		testData:  testDataCopy,
+		checker:   checker,
+		languages: languagesCopy,
+		limits:    limits,
 	})
 	if err != nil {
 		return 0, err
@@ -259,18 +449,28 @@ func (c *Course) AddAutoCodeCheckingTask(academic Academic, params AutoCodeCheck
 type TestingTaskCreationParams struct {
 	Title       string
 	Description string
+	TeamWork    bool
 	TestPoints  []TestPoint
 }
 
-func (c *Course) AddTestingTask(academic Academic, params TestingTaskCreationParams) (int, error) {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+func (c *Course) AddTestingTask(
+	academic Academic,
+	params TestingTaskCreationParams,
+	now time.Time,
+) (int, error) {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
 		return 0, err
 	}
 
 	testPointsCopy := make([]TestPoint, len(params.TestPoints))
 	copy(testPointsCopy, params.TestPoints)
 
-	task, err := c.newTask(params.Title, params.Description, TestingType, taskOptional{testPoints: testPointsCopy})
+	task, err := c.newTask(academic.ID(), now, taskCommon{
+		title:       params.Title,
+		description: params.Description,
+		taskType:    TestingType,
+		teamWork:    params.TeamWork,
+	}, taskOptional{testPoints: testPointsCopy})
 	if err != nil {
 		return 0, err
 	}
@@ -278,89 +478,71 @@ func (c *Course) AddTestingTask(academic Academic, params TestingTaskCreationPar
 	return task.number, nil
 }
 
-func (c *Course) RenameTask(academic Academic, taskNumber int, title string) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
-		return err
-	}
-
-	task, err := c.obtainTask(taskNumber)
-	if err != nil {
-		return err
-	}
-
-	return task.rename(title)
+func (c *Course) RenameTask(academic Academic, taskNumber int, title string, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.rename(title)
+	})
 }
 
-func (c *Course) ReplaceTaskDescription(academic Academic, taskNumber int, description string) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
-		return err
-	}
-
-	task, err := c.obtainTask(taskNumber)
-	if err != nil {
-		return err
-	}
-
-	return task.replaceDescription(description)
+func (c *Course) ReplaceTaskDescription(academic Academic, taskNumber int, description string, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.replaceDescription(description)
+	})
 }
 
-func (c *Course) ReplaceTaskDeadline(academic Academic, taskNumber int, deadline Deadline) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
-		return err
-	}
-
-	task, err := c.obtainTask(taskNumber)
-	if err != nil {
-		return err
-	}
-
-	return task.replaceDeadline(deadline)
+func (c *Course) ReplaceTaskDeadline(academic Academic, taskNumber int, deadline Deadline, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.replaceDeadline(deadline)
+	})
 }
 
-func (c *Course) ReplaceTaskTestPoints(academic Academic, taskNumber int, testPoints []TestPoint) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
-		return err
-	}
-
-	task, err := c.obtainTask(taskNumber)
-	if err != nil {
-		return err
-	}
-
-	return task.replaceTestPoints(testPoints)
+func (c *Course) ReplaceTaskTestPoints(academic Academic, taskNumber int, testPoints []TestPoint, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.replaceTestPoints(testPoints)
+	})
 }
 
-func (c *Course) ReplaceTaskTestData(academic Academic, taskNumber int, testData []TestData) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
-		return err
-	}
-
-	task, err := c.obtainTask(taskNumber)
-	if err != nil {
-		return err
-	}
+func (c *Course) ReplaceTaskTestData(academic Academic, taskNumber int, testData []TestData, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.replaceTestData(testData)
+	})
+}
 
-	return task.replaceTestData(testData)
+func (c *Course) ReplaceTaskChecker(academic Academic, taskNumber int, checker Checker, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		return task.replaceChecker(checker)
+	})
 }
 
 func (c *Course) TasksNumber() int {
 	return len(c.tasks)
 }
 
-func (c *Course) newTask(title string, description string, taskType TaskType, optional taskOptional) (*Task, error) {
+// taskCommon is content of task that doesn't depend on task type.
+type taskCommon struct {
+	title       string
+	description string
+	taskType    TaskType
+	teamWork    bool
+}
+
+func (c *Course) newTask(authorID string, now time.Time, common taskCommon, optional taskOptional) (*Task, error) {
 	task := &Task{
 		number:   c.nextTaskNumber,
-		taskType: taskType,
+		taskType: common.taskType,
+		teamWork: common.teamWork,
 		optional: optional,
 	}
-	if err := task.rename(title); err != nil {
+	if err := task.rename(common.title); err != nil {
 		return nil, err
 	}
 
-	if err := task.replaceDescription(description); err != nil {
+	if err := task.replaceDescription(common.description); err != nil {
 		return nil, err
 	}
 
+	c.commitTaskVersion(task, authorID, now)
+
 	c.tasks[c.nextTaskNumber] = task
 	c.nextTaskNumber++
 
@@ -53,7 +53,7 @@ func TestCourse_AddManualCheckingTask(t *testing.T) {
 			Name:     "task_description_too_long",
 			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
 			Params: course.ManualCheckingTaskCreationParams{
-				Description: strings.Repeat("x", 1001),
+				Description: strings.Repeat("x", 100001),
 			},
 			IsErr: func(err error) bool {
 				return errors.Is(err, course.ErrTaskDescriptionTooLong)
@@ -69,7 +69,7 @@ func TestCourse_AddManualCheckingTask(t *testing.T) {
 			creator := course.MustNewAcademic("creator-id", course.TeacherType)
 			crs := newCourse(t, creator)
 
-			number, err := crs.AddManualCheckingTask(c.Academic, c.Params)
+			number, err := crs.AddManualCheckingTask(c.Academic, c.Params, time.Now())
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
@@ -102,7 +102,10 @@ func TestCourse_AddAutoCodeCheckingTask(t *testing.T) {
 			time.Date(2023, time.November, 10, 0, 0, 0, 0, time.UTC),
 			time.Date(2023, time.November, 20, 0, 0, 0, 0, time.UTC),
 		),
-		TestData: []course.TestData{course.MustNewTestData("1 3", "4"), course.MustNewTestData("2 2", "4")},
+		TestData: []course.TestData{
+			course.MustNewTestData("1 3", "4", course.SampleTestData, ""),
+			course.MustNewTestData("2 2", "4", course.HiddenTestData, ""),
+		},
 	}
 	testCases := []struct {
 		Name     string
@@ -135,12 +138,31 @@ func TestCourse_AddAutoCodeCheckingTask(t *testing.T) {
 			Name:     "task_description_too_long",
 			Academic: course.MustNewAcademic("collaborator-id", course.TeacherType),
 			Params: course.AutoCodeCheckingTaskCreationParams{
-				Description: strings.Repeat("x", 1001),
+				Description: strings.Repeat("x", 100001),
 			},
 			IsErr: func(err error) bool {
 				return errors.Is(err, course.ErrTaskDescriptionTooLong)
 			},
 		},
+		{
+			Name:     "add_task_with_languages_and_limits",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params: course.AutoCodeCheckingTaskCreationParams{
+				Title:     "Sort array",
+				TestData:  []course.TestData{course.MustNewTestData("3 1 2", "1 2 3", course.SampleTestData, "")},
+				Languages: []string{"go", "cpp"},
+				Limits:    course.MustNewExecutionLimits(2*time.Second, 64<<20, 16<<10),
+			},
+		},
+		{
+			Name:     "duplicate_language",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params: course.AutoCodeCheckingTaskCreationParams{
+				Title:     "Sort array",
+				Languages: []string{"go", "go"},
+			},
+			IsErr: course.IsInvalidLanguagesError,
+		},
 	}
 
 	for i := range testCases {
@@ -151,7 +173,7 @@ func TestCourse_AddAutoCodeCheckingTask(t *testing.T) {
 			creator := course.MustNewAcademic("creator-id", course.TeacherType)
 			crs := newCourse(t, creator, withCollaborators("collaborator-id"))
 
-			number, err := crs.AddAutoCodeCheckingTask(c.Academic, c.Params)
+			number, err := crs.AddAutoCodeCheckingTask(c.Academic, c.Params, time.Now())
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
@@ -170,8 +192,18 @@ func TestCourse_AddAutoCodeCheckingTask(t *testing.T) {
 			)
 			deadline, _ := task.Deadline()
 			testData, _ := task.TestData()
+			checker, _ := task.Checker()
+			languages, _ := task.Languages()
+			limits, _ := task.ExecutionLimits()
 			require.Equal(t, c.Params.Deadline, deadline)
 			require.Equal(t, c.Params.TestData, testData)
+			require.Equal(t, course.DefaultChecker(), checker)
+			require.ElementsMatch(t, c.Params.Languages, languages)
+			expectedLimits := c.Params.Limits
+			if expectedLimits.IsZero() {
+				expectedLimits = course.DefaultExecutionLimits()
+			}
+			require.Equal(t, expectedLimits, limits)
 		})
 	}
 }
@@ -215,7 +247,7 @@ func TestCourse_AddTestingTask(t *testing.T) {
 			Name:     "task_description_too_long",
 			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
 			Params: course.TestingTaskCreationParams{
-				Description: strings.Repeat("x", 1001),
+				Description: strings.Repeat("x", 100001),
 			},
 			IsErr: func(err error) bool {
 				return errors.Is(err, course.ErrTaskDescriptionTooLong)
@@ -230,7 +262,7 @@ func TestCourse_AddTestingTask(t *testing.T) {
 
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 
-			number, err := crs.AddTestingTask(c.Academic, c.Params)
+			number, err := crs.AddTestingTask(c.Academic, c.Params, time.Now())
 			if c.IsErr != nil {
 				require.Error(t, err)
 				require.True(t, c.IsErr(err))
@@ -262,6 +294,7 @@ func TestCourse_RenameTask(t *testing.T) {
 			course.ManualCheckingTaskCreationParams{
 				Title: "Classes in TypeScript",
 			},
+			time.Now(),
 		)
 		require.NoError(t, err)
 
@@ -317,7 +350,7 @@ func TestCourse_RenameTask(t *testing.T) {
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 			taskNumber := c.PrepareTask(crs)
 
-			err := crs.RenameTask(c.Academic, taskNumber, c.NewTitle)
+			err := crs.RenameTask(c.Academic, taskNumber, c.NewTitle, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
@@ -342,6 +375,7 @@ func TestCourse_ReplaceTaskDescription(t *testing.T) {
 			course.ManualCheckingTaskCreationParams{
 				Description: "Write your binary search",
 			},
+			time.Now(),
 		)
 		require.NoError(t, err)
 
@@ -370,7 +404,7 @@ func TestCourse_ReplaceTaskDescription(t *testing.T) {
 		{
 			Name:           "task_description_too_long",
 			Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
-			NewDescription: strings.Repeat("x", 1001),
+			NewDescription: strings.Repeat("x", 100001),
 			PrepareTask:    addTask,
 			IsErr: func(err error) bool {
 				return errors.Is(err, course.ErrTaskDescriptionTooLong)
@@ -397,7 +431,7 @@ func TestCourse_ReplaceTaskDescription(t *testing.T) {
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 			taskNumber := c.PrepareTask(crs)
 
-			err := crs.ReplaceTaskDescription(c.Academic, taskNumber, c.NewDescription)
+			err := crs.ReplaceTaskDescription(c.Academic, taskNumber, c.NewDescription, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
@@ -427,9 +461,9 @@ func TestCourse_ReplaceTaskDeadline(t *testing.T) {
 				time.Date(2023, time.March, 1, 0, 0, 0, 9, time.UTC),
 				time.Date(2023, time.March, 12, 0, 0, 0, 0, time.UTC),
 			),
-		})
+		}, time.Now())
 		require.NoError(t, err)
-		testingTaskNumber, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{})
+		testingTaskNumber, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{}, time.Now())
 		require.NoError(t, err)
 
 		return manualTaskNumber, testingTaskNumber
@@ -496,7 +530,7 @@ func TestCourse_ReplaceTaskDeadline(t *testing.T) {
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 			taskNumber := c.PrepareTask(crs)
 
-			err := crs.ReplaceTaskDeadline(c.Academic, taskNumber, c.NewDeadline)
+			err := crs.ReplaceTaskDeadline(c.Academic, taskNumber, c.NewDeadline, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
@@ -519,16 +553,19 @@ func TestCourse_ReplaceTaskTestData(t *testing.T) {
 
 	addTasks := func(crs *course.Course) (int, int) {
 		creator := course.MustNewAcademic("creator-id", course.TeacherType)
-		manualTaskNumber, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{})
+		manualTaskNumber, err := crs.AddManualCheckingTask(creator, course.ManualCheckingTaskCreationParams{}, time.Now())
 		require.NoError(t, err)
 		autoCodeTaskNumber, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{
-			TestData: []course.TestData{course.MustNewTestData("1 1 2 3", "7")},
-		})
+			TestData: []course.TestData{course.MustNewTestData("1 1 2 3", "7", course.HiddenTestData, "")},
+		}, time.Now())
 		require.NoError(t, err)
 
 		return autoCodeTaskNumber, manualTaskNumber
 	}
-	newTestData := []course.TestData{course.MustNewTestData("1 1 2 3", "7"), course.MustNewTestData("1 1", "2")}
+	newTestData := []course.TestData{
+		course.MustNewTestData("1 1 2 3", "7", course.SampleTestData, "Sum of numbers"),
+		course.MustNewTestData("1 1", "2", course.HiddenTestData, ""),
+	}
 	testCases := []struct {
 		Name        string
 		Academic    course.Academic
@@ -591,7 +628,7 @@ func TestCourse_ReplaceTaskTestData(t *testing.T) {
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 			taskNumber := c.PrepareTask(crs)
 
-			err := crs.ReplaceTaskTestData(c.Academic, taskNumber, c.NewTestData)
+			err := crs.ReplaceTaskTestData(c.Academic, taskNumber, c.NewTestData, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
@@ -614,11 +651,11 @@ func TestCourse_ReplaceTaskTestPoints(t *testing.T) {
 
 	addTasks := func(crs *course.Course) (int, int) {
 		creator := course.MustNewAcademic("creator-id", course.TeacherType)
-		autoCodeTaskNumber, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{})
+		autoCodeTaskNumber, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{}, time.Now())
 		require.NoError(t, err)
 		testingTaskNumber, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{
 			TestPoints: []course.TestPoint{course.MustNewTestPoint("Spring is DI container", []string{"Yes", "No"}, []int{0})},
-		})
+		}, time.Now())
 		require.NoError(t, err)
 
 		return testingTaskNumber, autoCodeTaskNumber
@@ -686,7 +723,7 @@ func TestCourse_ReplaceTaskTestPoints(t *testing.T) {
 			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
 			taskNumber := c.PrepareTask(crs)
 
-			err := crs.ReplaceTaskTestPoints(c.Academic, taskNumber, c.NewTestPoints)
+			err := crs.ReplaceTaskTestPoints(c.Academic, taskNumber, c.NewTestPoints, time.Now())
 
 			if c.IsErr != nil {
 				require.Error(t, err)
@@ -703,3 +740,132 @@ func TestCourse_ReplaceTaskTestPoints(t *testing.T) {
 		})
 	}
 }
+
+func TestCourse_ReplaceTaskChecker(t *testing.T) {
+	t.Parallel()
+
+	addTasks := func(crs *course.Course) (int, int) {
+		creator := course.MustNewAcademic("creator-id", course.TeacherType)
+		autoCodeTaskNumber, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{}, time.Now())
+		require.NoError(t, err)
+		testingTaskNumber, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{}, time.Now())
+		require.NoError(t, err)
+
+		return autoCodeTaskNumber, testingTaskNumber
+	}
+	newChecker := course.MustNewChecker(course.FloatChecker, 1e-6)
+	testCases := []struct {
+		Name        string
+		Academic    course.Academic
+		NewChecker  course.Checker
+		PrepareTask func(crs *course.Course) int
+		IsErr       func(err error) bool
+	}{
+		{
+			Name:       "replace_task_checker_with_new_checker",
+			Academic:   course.MustNewAcademic("creator-id", course.TeacherType),
+			NewChecker: newChecker,
+			PrepareTask: func(crs *course.Course) int {
+				autoCodeTaskNumber, _ := addTasks(crs)
+
+				return autoCodeTaskNumber
+			},
+		},
+		{
+			Name:     "task_has_no_checker",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			PrepareTask: func(crs *course.Course) int {
+				_, testingTaskNumber := addTasks(crs)
+
+				return testingTaskNumber
+			},
+			NewChecker: newChecker,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskHasNoChecker)
+			},
+		},
+		{
+			Name:       "academic_cant_replace_checker",
+			Academic:   course.MustNewAcademic("other-teacher-id", course.TeacherType),
+			NewChecker: newChecker,
+			PrepareTask: func(crs *course.Course) int {
+				autoCodeTaskNumber, _ := addTasks(crs)
+
+				return autoCodeTaskNumber
+			},
+			IsErr: course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			crs := newCourse(t, course.MustNewAcademic("creator-id", course.TeacherType))
+			taskNumber := c.PrepareTask(crs)
+
+			err := crs.ReplaceTaskChecker(c.Academic, taskNumber, c.NewChecker, time.Now())
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			task, err := crs.Task(taskNumber)
+			require.NoError(t, err)
+			checker, ok := task.Checker()
+			require.True(t, ok)
+			require.Equal(t, c.NewChecker, checker)
+		})
+	}
+}
+
+func TestTask_AllowsLanguage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		Languages []string
+		Language  string
+		Allowed   bool
+	}{
+		{
+			Name:     "any_language_allowed_when_languages_empty",
+			Language: "python3",
+			Allowed:  true,
+		},
+		{
+			Name:      "listed_language_allowed",
+			Languages: []string{"go", "python3"},
+			Language:  "python3",
+			Allowed:   true,
+		},
+		{
+			Name:      "not_listed_language_not_allowed",
+			Languages: []string{"go"},
+			Language:  "python3",
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator)
+			number, err := crs.AddAutoCodeCheckingTask(creator, course.AutoCodeCheckingTaskCreationParams{
+				Title:     "Hello, world",
+				Languages: c.Languages,
+			}, time.Now())
+			require.NoError(t, err)
+			task, err := crs.Task(number)
+			require.NoError(t, err)
+
+			require.Equal(t, c.Allowed, task.AllowsLanguage(c.Language))
+		})
+	}
+}
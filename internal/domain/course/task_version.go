@@ -0,0 +1,199 @@
+package course
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TaskVersion is immutable snapshot of task content
+// committed by academic after task creation or editing.
+type TaskVersion struct {
+	number    int
+	authorID  string
+	createdAt time.Time
+	task      Task
+}
+
+var ErrTaskHasNoSuchVersion = errors.New("task has no such version")
+
+func (v TaskVersion) Number() int {
+	return v.number
+}
+
+func (v TaskVersion) AuthorID() string {
+	return v.authorID
+}
+
+func (v TaskVersion) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+// Task returns task content as it was in the version.
+func (v TaskVersion) Task() Task {
+	task := v.task.content()
+	task.version = v.number
+
+	return *task
+}
+
+// Version returns number of the current task version, it should
+// be recorded by anything graded against task content.
+func (t *Task) Version() int {
+	return t.version
+}
+
+// NewTaskVersions returns task versions committed since course was
+// created or unmarshalled in order of their commit. Course doesn't keep
+// version history, repository should append new versions to it.
+func (c *Course) NewTaskVersions() []TaskVersion {
+	versionsCopy := make([]TaskVersion, len(c.taskVersions))
+	copy(versionsCopy, c.taskVersions)
+
+	return versionsCopy
+}
+
+func (c *Course) commitTaskVersion(task *Task, authorID string, now time.Time) {
+	task.version++
+
+	c.taskVersions = append(c.taskVersions[:len(c.taskVersions):len(c.taskVersions)], TaskVersion{
+		number:    task.version,
+		authorID:  authorID,
+		createdAt: now.UTC(),
+		task:      *task.content(),
+	})
+}
+
+// content returns deep copy of task without its version.
+func (t *Task) content() *Task {
+	return &Task{
+		number:      t.number,
+		title:       t.title,
+		description: t.description,
+		taskType:    t.taskType,
+		teamWork:    t.teamWork,
+		optional: taskOptional{
+			deadline:   t.optional.deadline,
+			testPoints: t.testPoints(),
+			testData:   t.testData(),
+			checker:    t.optional.checker,
+			languages:  t.languages(),
+			limits:     t.optional.limits,
+		},
+	}
+}
+
+// TaskEditParams describes task editing, nil values
+// leave corresponding parts of the task unchanged.
+type TaskEditParams struct {
+	Title       *string
+	Description *string
+	TeamWork    *bool
+	Deadline    *Deadline
+	TestPoints  []TestPoint
+	TestData    []TestData
+	Checker     *Checker
+	Languages   []string
+	Limits      *ExecutionLimits
+}
+
+// EditTask applies all given changes to the task at once
+// and commits them as single new task version.
+func (c *Course) EditTask(academic Academic, taskNumber int, params TaskEditParams, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		if params.Title != nil {
+			if err := task.rename(*params.Title); err != nil {
+				return err
+			}
+		}
+
+		if params.Description != nil {
+			if err := task.replaceDescription(*params.Description); err != nil {
+				return err
+			}
+		}
+
+		if params.TeamWork != nil {
+			task.teamWork = *params.TeamWork
+		}
+
+		if params.Deadline != nil {
+			if err := task.replaceDeadline(*params.Deadline); err != nil {
+				return err
+			}
+		}
+
+		if params.TestPoints != nil {
+			if err := task.replaceTestPoints(params.TestPoints); err != nil {
+				return err
+			}
+		}
+
+		if params.TestData != nil {
+			if err := task.replaceTestData(params.TestData); err != nil {
+				return err
+			}
+		}
+
+		if params.Checker != nil {
+			if err := task.replaceChecker(*params.Checker); err != nil {
+				return err
+			}
+		}
+
+		if params.Languages != nil {
+			if err := task.replaceLanguages(params.Languages); err != nil {
+				return err
+			}
+		}
+
+		if params.Limits != nil {
+			if err := task.replaceLimits(*params.Limits); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// RestoreTaskVersion brings content of given version of the task
+// back to the task, restoring is committed as new task version.
+func (c *Course) RestoreTaskVersion(academic Academic, taskNumber int, version TaskVersion, now time.Time) error {
+	return c.editTask(academic, taskNumber, now, func(task *Task) error {
+		if version.task.number != taskNumber || version.number > task.version {
+			return ErrTaskHasNoSuchVersion
+		}
+
+		restored := version.task.content()
+		task.title = restored.title
+		task.description = restored.description
+		task.teamWork = restored.teamWork
+		task.optional = restored.optional
+
+		return nil
+	})
+}
+
+func (c *Course) editTask(academic Academic, taskNumber int, now time.Time, edit func(task *Task) error) error {
+	if err := c.CanAcademicEditTasks(academic); err != nil {
+		return err
+	}
+
+	task, err := c.obtainTask(taskNumber)
+	if err != nil {
+		return err
+	}
+
+	edited := task.content()
+	edited.version = task.version
+
+	if err := edit(edited); err != nil {
+		return err
+	}
+
+	c.commitTaskVersion(edited, academic.ID(), now)
+	c.tasks[taskNumber] = edited
+
+	return nil
+}
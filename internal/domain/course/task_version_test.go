@@ -0,0 +1,235 @@
+package course_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_EditTask(t *testing.T) {
+	t.Parallel()
+
+	var (
+		title       = "Arrays in Go"
+		description = "Write function that reverses **array**"
+		tooLongText = strings.Repeat("x", 201)
+		limits      = course.MustNewExecutionLimits(2*time.Second, 128<<20, 32<<10)
+	)
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Params   course.TaskEditParams
+		AddTask  func(t *testing.T, academic course.Academic, crs *course.Course) int
+		IsErr    func(err error) bool
+	}{
+		{
+			Name:     "edit_title_and_description",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params:   course.TaskEditParams{Title: &title, Description: &description},
+			AddTask:  addManualCheckingTaskToCourse,
+		},
+		{
+			Name:     "edit_auto_code_checking_task_languages_and_limits",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params:   course.TaskEditParams{Languages: []string{"go"}, Limits: &limits},
+			AddTask:  addAutoCodeCheckingTaskToCourse,
+		},
+		{
+			Name:     "academic_cant_edit_task",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			Params:   course.TaskEditParams{Title: &title},
+			AddTask:  addManualCheckingTaskToCourse,
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:     "invalid_change_discards_all_changes",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params:   course.TaskEditParams{Description: &description, Title: &tooLongText},
+			AddTask:  addManualCheckingTaskToCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskTitleTooLong)
+			},
+		},
+		{
+			Name:     "testing_task_has_no_languages",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Params:   course.TaskEditParams{Languages: []string{"go"}},
+			AddTask:  addTestingTaskToCourse,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskHasNoLanguages)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator)
+			taskNumber := c.AddTask(t, creator, crs)
+			before, err := crs.Task(taskNumber)
+			require.NoError(t, err)
+
+			editedAt := time.Date(2021, time.October, 4, 12, 0, 0, 0, time.UTC)
+			err = crs.EditTask(c.Academic, taskNumber, c.Params, editedAt)
+
+			after, taskErr := crs.Task(taskNumber)
+			require.NoError(t, taskErr)
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, before, after)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, before.Version()+1, after.Version())
+			require.Len(t, crs.NewTaskVersions(), 2)
+
+			version := findNewTaskVersion(t, crs, taskNumber, after.Version())
+			require.Equal(t, c.Academic.ID(), version.AuthorID())
+			require.Equal(t, editedAt, version.CreatedAt())
+			requireTaskContentEquals(t, after, version.Task())
+		})
+	}
+}
+
+func TestCourse_RestoreTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name               string
+		Academic           course.Academic
+		VersionOfOtherTask bool
+		IsErr              func(err error) bool
+	}{
+		{
+			Name:     "restore_first_version",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		},
+		{
+			Name:     "academic_cant_restore_version",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:               "task_has_no_version_of_other_task",
+			Academic:           course.MustNewAcademic("creator-id", course.TeacherType),
+			VersionOfOtherTask: true,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTaskHasNoSuchVersion)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator)
+			taskNumber := addTestingTaskToCourse(t, creator, crs)
+			otherTaskNumber := addManualCheckingTaskToCourse(t, creator, crs)
+			original, err := crs.Task(taskNumber)
+			require.NoError(t, err)
+			err = crs.ReplaceTaskTestPoints(creator, taskNumber, []course.TestPoint{
+				course.MustNewTestPoint("Is Go compiled?", []string{"Yes", "No"}, []int{0}),
+			}, time.Now())
+			require.NoError(t, err)
+
+			version := findNewTaskVersion(t, crs, taskNumber, 1)
+			if c.VersionOfOtherTask {
+				version = findNewTaskVersion(t, crs, otherTaskNumber, 1)
+			}
+
+			err = crs.RestoreTaskVersion(c.Academic, taskNumber, version, time.Now())
+
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+
+				return
+			}
+			require.NoError(t, err)
+			restored, err := crs.Task(taskNumber)
+			require.NoError(t, err)
+			require.Equal(t, 3, restored.Version())
+			requireTaskContentEquals(t, original, restored)
+		})
+	}
+}
+
+func TestCourse_Extend_restartsTaskVersions(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	originCourse := newCourse(t, creator)
+	taskNumber := addManualCheckingTaskToCourse(t, creator, originCourse)
+	require.NoError(t, originCourse.RenameTask(creator, taskNumber, "Renamed task", time.Now()))
+
+	extendedAt := time.Date(2022, time.February, 7, 9, 0, 0, 0, time.UTC)
+	extendedCourse, err := originCourse.Extend(course.CreationParams{ID: "extended-course-id", Creator: creator}, extendedAt)
+	require.NoError(t, err)
+
+	task, err := extendedCourse.Task(taskNumber)
+	require.NoError(t, err)
+	require.Equal(t, 1, task.Version())
+
+	versions := extendedCourse.NewTaskVersions()
+	require.Len(t, versions, 1)
+	require.Equal(t, 1, versions[0].Number())
+	require.Equal(t, creator.ID(), versions[0].AuthorID())
+	require.Equal(t, extendedAt, versions[0].CreatedAt())
+	requireTaskContentEquals(t, task, versions[0].Task())
+	require.Len(t, originCourse.NewTaskVersions(), 2)
+}
+
+func findNewTaskVersion(t *testing.T, crs *course.Course, taskNumber, number int) course.TaskVersion {
+	t.Helper()
+
+	for _, v := range crs.NewTaskVersions() {
+		if task := v.Task(); task.Number() == taskNumber && v.Number() == number {
+			return v
+		}
+	}
+
+	require.FailNow(t, "task version not found", "version %d of task No %d", number, taskNumber)
+
+	return course.TaskVersion{}
+}
+
+func requireTaskContentEquals(t *testing.T, expected, actual course.Task) {
+	t.Helper()
+
+	requireGeneralTaskParamsEquals(t, actual, expected.Number(), expected.Type(), expected.Title(), expected.Description())
+
+	expectedDeadline, _ := expected.Deadline()
+	actualDeadline, _ := actual.Deadline()
+	require.Equal(t, expectedDeadline, actualDeadline)
+
+	expectedTestPoints, _ := expected.TestPoints()
+	actualTestPoints, _ := actual.TestPoints()
+	require.Equal(t, expectedTestPoints, actualTestPoints)
+
+	expectedTestData, _ := expected.TestData()
+	actualTestData, _ := actual.TestData()
+	require.Equal(t, expectedTestData, actualTestData)
+
+	expectedLanguages, _ := expected.Languages()
+	actualLanguages, _ := actual.Languages()
+	require.Equal(t, expectedLanguages, actualLanguages)
+
+	expectedLimits, _ := expected.ExecutionLimits()
+	actualLimits, _ := actual.ExecutionLimits()
+	require.Equal(t, expectedLimits, actualLimits)
+}
@@ -11,8 +11,20 @@ func (c *Course) Collaborators() []string {
 	return collaborators
 }
 
+// CollaboratorRoles returns copy of roles of collaborators by their IDs.
+func (c *Course) CollaboratorRoles() map[string]CollaboratorRole {
+	roles := make(map[string]CollaboratorRole, len(c.collaborators))
+	for id, role := range c.collaborators {
+		roles[id] = role
+	}
+
+	return roles
+}
+
+// AddCollaborators adds collaborators as co-teachers,
+// collaborators who are already in course keep their roles.
 func (c *Course) AddCollaborators(academic Academic, teacherIDs ...string) error {
-	if err := c.canAcademicEditWithAccess(academic, TeacherAccess); err != nil {
+	if err := c.CanAcademicEditCollaborators(academic); err != nil {
 		return err
 	}
 
@@ -23,14 +35,19 @@ func (c *Course) AddCollaborators(academic Academic, teacherIDs ...string) error
 
 func (c *Course) putCollaborators(teacherIDs []string) {
 	for _, tid := range teacherIDs {
-		c.collaborators[tid] = true
+		if _, ok := c.collaborators[tid]; !ok {
+			c.collaborators[tid] = CoTeacherRole
+		}
 	}
 }
 
-var ErrCourseHasNoSuchCollaborator = errors.New("course has no such collaborator")
+var (
+	ErrCourseHasNoSuchCollaborator = errors.New("course has no such collaborator")
+	ErrInvalidCollaboratorRole     = errors.New("invalid collaborator role")
+)
 
 func (c *Course) RemoveCollaborator(academic Academic, teacherID string) error {
-	if err := c.canAcademicEditWithAccess(academic, CreatorAccess); err != nil {
+	if err := c.canAcademicChangeCollaborators(academic); err != nil {
 		return err
 	}
 
@@ -43,8 +60,28 @@ func (c *Course) RemoveCollaborator(academic Academic, teacherID string) error {
 	return nil
 }
 
+func (c *Course) ChangeCollaboratorRole(academic Academic, teacherID string, role CollaboratorRole) error {
+	if err := c.canAcademicChangeCollaborators(academic); err != nil {
+		return err
+	}
+
+	if !role.IsValid() {
+		return ErrInvalidCollaboratorRole
+	}
+
+	if _, ok := c.collaborators[teacherID]; !ok {
+		return ErrCourseHasNoSuchCollaborator
+	}
+
+	c.collaborators[teacherID] = role
+
+	return nil
+}
+
 func (c *Course) hasTeacher(teacherID string) bool {
-	return c.hasCreator(teacherID) || c.collaborators[teacherID]
+	_, ok := c.collaborators[teacherID]
+
+	return c.hasCreator(teacherID) || ok
 }
 
 func (c *Course) hasCreator(teacherID string) bool {
@@ -54,3 +91,17 @@ func (c *Course) hasCreator(teacherID string) bool {
 func (c *Course) hasStudent(studentID string) bool {
 	return c.students[studentID]
 }
+
+func unmarshalCollaborators(ids []string, roles map[string]CollaboratorRole) map[string]CollaboratorRole {
+	collaborators := make(map[string]CollaboratorRole, len(ids))
+	for _, id := range ids {
+		role := roles[id]
+		if !role.IsValid() {
+			role = CoTeacherRole
+		}
+
+		collaborators[id] = role
+	}
+
+	return collaborators
+}
@@ -3,6 +3,7 @@ package course_test
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/authena-ru/courses-organization/internal/domain/course"
@@ -115,3 +116,87 @@ func TestCourse_RemoveCollaborators(t *testing.T) {
 		})
 	}
 }
+
+func TestCourse_ChangeCollaboratorRole(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Academic       course.Academic
+		CollaboratorID string
+		Role           course.CollaboratorRole
+		IsErr          func(err error) bool
+	}{
+		{
+			Name:           "creator_can_change_role",
+			Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+			CollaboratorID: "collaborator-id",
+			Role:           course.AssistantRole,
+		},
+		{
+			Name:           "collaborator_cant_change_role",
+			Academic:       course.MustNewAcademic("collaborator-id", course.TeacherType),
+			CollaboratorID: "collaborator-id",
+			Role:           course.ObserverRole,
+			IsErr:          course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:           "invalid_role",
+			Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+			CollaboratorID: "collaborator-id",
+			Role:           course.CollaboratorRole(0),
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrInvalidCollaboratorRole)
+			},
+		},
+		{
+			Name:           "no_such_collaborator",
+			Academic:       course.MustNewAcademic("creator-id", course.TeacherType),
+			CollaboratorID: "creator-id",
+			Role:           course.ObserverRole,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchCollaborator)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withCollaborators("collaborator-id"))
+
+			err := crs.ChangeCollaboratorRole(c.Academic, c.CollaboratorID, c.Role)
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, map[string]course.CollaboratorRole{
+					"collaborator-id": course.CoTeacherRole,
+				}, crs.CollaboratorRoles())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, map[string]course.CollaboratorRole{
+				c.CollaboratorID: c.Role,
+			}, crs.CollaboratorRoles())
+		})
+	}
+}
+
+func TestCourse_AddCollaborators_keepsRoles(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withCollaborators("collaborator-id"))
+	require.NoError(t, crs.ChangeCollaboratorRole(creator, "collaborator-id", course.ObserverRole))
+
+	err := crs.AddCollaborators(creator, "collaborator-id", "collaborator1-id")
+	require.NoError(t, err)
+	require.Equal(t, map[string]course.CollaboratorRole{
+		"collaborator-id":  course.ObserverRole,
+		"collaborator1-id": course.CoTeacherRole,
+	}, crs.CollaboratorRoles())
+}
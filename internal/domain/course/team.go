@@ -0,0 +1,388 @@
+package course
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Team is group of course students doing team tasks together,
+// student can't be member of more than one team of course.
+type Team struct {
+	id      string
+	name    string
+	members map[string]bool
+}
+
+// TeamSettings defines whether students form teams by themselves
+// and maximum number of team members, zero max size means no limit.
+type TeamSettings struct {
+	selfFormation bool
+	maxSize       int
+}
+
+const teamNameMaxLen = 100
+
+var (
+	ErrEmptyTeamID               = errors.New("empty team id")
+	ErrEmptyTeamName             = errors.New("empty team name")
+	ErrTeamNameTooLong           = errors.New("team name too long")
+	ErrInvalidTeamMaxSize        = errors.New("team max size is negative")
+	ErrCourseHasNoSuchTeam       = errors.New("course has no such team")
+	ErrCourseAlreadyHasTeam      = errors.New("course already has team with such id")
+	ErrStudentAlreadyInTeam      = errors.New("student is already member of team")
+	ErrTeamHasNoSuchMember       = errors.New("team has no such member")
+	ErrTeamIsFull                = errors.New("team is full")
+	ErrTeamSelfFormationDisabled = errors.New("students can't form teams by themselves")
+)
+
+func IsInvalidTeamParametersError(err error) bool {
+	return errors.Is(err, ErrEmptyTeamID) ||
+		errors.Is(err, ErrEmptyTeamName) ||
+		errors.Is(err, ErrTeamNameTooLong) ||
+		errors.Is(err, ErrInvalidTeamMaxSize)
+}
+
+// IsTeamMembershipError reports whether students can't
+// join or leave team because of team rules of course.
+func IsTeamMembershipError(err error) bool {
+	return errors.Is(err, ErrCourseHasNoSuchStudent) ||
+		errors.Is(err, ErrStudentAlreadyInTeam) ||
+		errors.Is(err, ErrTeamHasNoSuchMember) ||
+		errors.Is(err, ErrTeamIsFull)
+}
+
+func NewTeamSettings(selfFormation bool, maxSize int) (TeamSettings, error) {
+	if maxSize < 0 {
+		return TeamSettings{}, ErrInvalidTeamMaxSize
+	}
+
+	return TeamSettings{selfFormation: selfFormation, maxSize: maxSize}, nil
+}
+
+func MustNewTeamSettings(selfFormation bool, maxSize int) TeamSettings {
+	settings, err := NewTeamSettings(selfFormation, maxSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return settings
+}
+
+// SelfFormation reports whether students can create,
+// rename, join and leave teams by themselves.
+func (s TeamSettings) SelfFormation() bool {
+	return s.selfFormation
+}
+
+func (s TeamSettings) MaxSize() int {
+	return s.maxSize
+}
+
+func (t Team) ID() string {
+	return t.id
+}
+
+func (t Team) Name() string {
+	return t.name
+}
+
+// Members returns sorted IDs of team students.
+func (t Team) Members() []string {
+	members := make([]string, 0, len(t.members))
+	for m := range t.members {
+		members = append(members, m)
+	}
+
+	sort.Strings(members)
+
+	return members
+}
+
+func (t Team) HasMember(studentID string) bool {
+	return t.members[studentID]
+}
+
+func (c *Course) TeamSettings() TeamSettings {
+	return c.teamSettings
+}
+
+// Teams returns teams of course sorted by name.
+func (c *Course) Teams() []Team {
+	teams := make([]Team, 0, len(c.teams))
+	for _, t := range c.teams {
+		teams = append(teams, t.copy())
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		if teams[i].name != teams[j].name {
+			return teams[i].name < teams[j].name
+		}
+
+		return teams[i].id < teams[j].id
+	})
+
+	return teams
+}
+
+// StudentTeam returns team of student, false if student isn't member of any team.
+func (c *Course) StudentTeam(studentID string) (Team, bool) {
+	for _, t := range c.teams {
+		if t.members[studentID] {
+			return t.copy(), true
+		}
+	}
+
+	return Team{}, false
+}
+
+// TaskSubmitters returns students whom solution of task submitted by student
+// counts for. It's whole team of student for team task and student himself otherwise.
+func (c *Course) TaskSubmitters(taskNumber int, studentID string) ([]string, error) {
+	task, err := c.obtainTask(taskNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.hasStudent(studentID) {
+		return nil, ErrCourseHasNoSuchStudent
+	}
+
+	if task.teamWork {
+		if team, ok := c.StudentTeam(studentID); ok {
+			return team.Members(), nil
+		}
+	}
+
+	return []string{studentID}, nil
+}
+
+// ChangeTeamSettings changes settings of teams, teams that are already
+// bigger than new max size are kept, but can't get new members.
+func (c *Course) ChangeTeamSettings(academic Academic, settings TeamSettings) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	c.teamSettings = settings
+
+	return nil
+}
+
+// CreateTeam creates team of given students. Student can create team only
+// with self formation and becomes its only member, so student can't pass
+// other members.
+func (c *Course) CreateTeam(academic Academic, teamID, name string, studentIDs ...string) error {
+	if academic.Type() == StudentType {
+		if err := c.canStudentFormTeams(academic); err != nil {
+			return err
+		}
+
+		if len(studentIDs) > 0 {
+			return AcademicCantEditCourseError{academicType: StudentType}
+		}
+
+		studentIDs = []string{academic.ID()}
+	} else if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if teamID == "" {
+		return ErrEmptyTeamID
+	}
+
+	if err := validateTeamName(name); err != nil {
+		return err
+	}
+
+	if _, ok := c.teams[teamID]; ok {
+		return ErrCourseAlreadyHasTeam
+	}
+
+	team := Team{id: teamID, name: name, members: make(map[string]bool, len(studentIDs))}
+	if err := c.putTeamMembers(team, studentIDs); err != nil {
+		return err
+	}
+
+	c.teams[teamID] = team
+
+	return nil
+}
+
+// RenameTeam renames team, student can rename only own team with self formation.
+func (c *Course) RenameTeam(academic Academic, teamID, name string) error {
+	team, err := c.obtainEditableTeam(academic, teamID)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTeamName(name); err != nil {
+		return err
+	}
+
+	team.name = name
+	c.teams[teamID] = team
+
+	return nil
+}
+
+func (c *Course) RemoveTeam(academic Academic, teamID string) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if _, ok := c.teams[teamID]; !ok {
+		return ErrCourseHasNoSuchTeam
+	}
+
+	delete(c.teams, teamID)
+
+	return nil
+}
+
+// AddTeamMembers adds students to team all or none, student
+// can only join team by himself with self formation.
+func (c *Course) AddTeamMembers(academic Academic, teamID string, studentIDs ...string) error {
+	if err := c.canAcademicEditTeamMembers(academic, studentIDs); err != nil {
+		return err
+	}
+
+	team, ok := c.teams[teamID]
+	if !ok {
+		return ErrCourseHasNoSuchTeam
+	}
+
+	return c.putTeamMembers(team, studentIDs)
+}
+
+// RemoveTeamMember removes student from team, student can
+// only leave team by himself with self formation.
+func (c *Course) RemoveTeamMember(academic Academic, teamID, studentID string) error {
+	if err := c.canAcademicEditTeamMembers(academic, []string{studentID}); err != nil {
+		return err
+	}
+
+	team, ok := c.teams[teamID]
+	if !ok {
+		return ErrCourseHasNoSuchTeam
+	}
+
+	if !team.members[studentID] {
+		return ErrTeamHasNoSuchMember
+	}
+
+	delete(team.members, studentID)
+
+	return nil
+}
+
+func (c *Course) canStudentFormTeams(academic Academic) error {
+	if !c.hasStudent(academic.ID()) {
+		return AcademicCantEditCourseError{academicType: StudentType}
+	}
+
+	if !c.teamSettings.selfFormation {
+		return ErrTeamSelfFormationDisabled
+	}
+
+	return nil
+}
+
+func (c *Course) canAcademicEditTeamMembers(academic Academic, studentIDs []string) error {
+	if academic.Type() != StudentType {
+		return c.CanAcademicEditStudents(academic)
+	}
+
+	if err := c.canStudentFormTeams(academic); err != nil {
+		return err
+	}
+
+	if len(studentIDs) != 1 || studentIDs[0] != academic.ID() {
+		return AcademicCantEditCourseError{academicType: StudentType}
+	}
+
+	return nil
+}
+
+func (c *Course) obtainEditableTeam(academic Academic, teamID string) (Team, error) {
+	if academic.Type() == StudentType {
+		if err := c.canStudentFormTeams(academic); err != nil {
+			return Team{}, err
+		}
+	} else if err := c.CanAcademicEditStudents(academic); err != nil {
+		return Team{}, err
+	}
+
+	team, ok := c.teams[teamID]
+	if !ok {
+		return Team{}, ErrCourseHasNoSuchTeam
+	}
+
+	if academic.Type() == StudentType && !team.members[academic.ID()] {
+		return Team{}, AcademicCantEditCourseError{academicType: StudentType}
+	}
+
+	return team, nil
+}
+
+// putTeamMembers checks all students before adding any of them.
+func (c *Course) putTeamMembers(team Team, studentIDs []string) error {
+	newMembers := make(map[string]bool, len(studentIDs))
+
+	for _, sid := range studentIDs {
+		if !c.hasStudent(sid) {
+			return errors.Wrapf(ErrCourseHasNoSuchStudent, "student #%s", sid)
+		}
+
+		if team.members[sid] || newMembers[sid] {
+			continue
+		}
+
+		if _, ok := c.StudentTeam(sid); ok {
+			return errors.Wrapf(ErrStudentAlreadyInTeam, "student #%s", sid)
+		}
+
+		newMembers[sid] = true
+	}
+
+	maxSize := c.teamSettings.maxSize
+	if maxSize > 0 && len(team.members)+len(newMembers) > maxSize {
+		return ErrTeamIsFull
+	}
+
+	for sid := range newMembers {
+		team.members[sid] = true
+	}
+
+	return nil
+}
+
+func (t Team) copy() Team {
+	members := make(map[string]bool, len(t.members))
+	for m := range t.members {
+		members[m] = true
+	}
+
+	return Team{id: t.id, name: t.name, members: members}
+}
+
+func validateTeamName(name string) error {
+	if name == "" {
+		return ErrEmptyTeamName
+	}
+
+	if len(name) > teamNameMaxLen {
+		return ErrTeamNameTooLong
+	}
+
+	return nil
+}
+
+func unmarshalTeams(params []UnmarshallingTeamParams) map[string]Team {
+	teams := make(map[string]Team, len(params))
+	for _, p := range params {
+		teams[p.ID] = Team{id: p.ID, name: p.Name, members: unmarshalIDs(p.Members)}
+	}
+
+	return teams
+}
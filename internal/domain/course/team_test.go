@@ -0,0 +1,290 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		Academic        course.Academic
+		Settings        course.TeamSettings
+		Members         []string
+		ExpectedMembers []string
+		IsErr           func(err error) bool
+	}{
+		{
+			Name:            "teacher_creates_team",
+			Academic:        course.MustNewAcademic("creator-id", course.TeacherType),
+			Members:         []string{"student1-id", "student2-id"},
+			ExpectedMembers: []string{"student1-id", "student2-id"},
+		},
+		{
+			Name:            "student_creates_team_with_self_formation",
+			Academic:        course.MustNewAcademic("student2-id", course.StudentType),
+			Settings:        course.MustNewTeamSettings(true, 2),
+			ExpectedMembers: []string{"student2-id"},
+		},
+		{
+			Name:     "student_cant_create_team_without_self_formation",
+			Academic: course.MustNewAcademic("student2-id", course.StudentType),
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTeamSelfFormationDisabled)
+			},
+		},
+		{
+			Name:     "student_cant_create_team_with_others",
+			Academic: course.MustNewAcademic("student2-id", course.StudentType),
+			Settings: course.MustNewTeamSettings(true, 0),
+			Members:  []string{"student2-id", "student3-id"},
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:     "observer_cant_create_team",
+			Academic: course.MustNewAcademic("observer-id", course.TeacherType),
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:     "student_already_in_team",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Members:  []string{"student1-id", "student-in-team-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrStudentAlreadyInTeam)
+			},
+		},
+		{
+			Name:     "not_course_student",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Members:  []string{"other-student-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrCourseHasNoSuchStudent)
+			},
+		},
+		{
+			Name:     "team_is_full",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Settings: course.MustNewTeamSettings(false, 2),
+			Members:  []string{"student1-id", "student2-id", "student3-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTeamIsFull)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(
+				t, creator,
+				withStudents("student1-id", "student2-id", "student3-id", "student-in-team-id"),
+				withCollaborators("observer-id"),
+			)
+			require.NoError(t, crs.ChangeCollaboratorRole(creator, "observer-id", course.ObserverRole))
+			require.NoError(t, crs.CreateTeam(creator, "existing-team-id", "Existing", "student-in-team-id"))
+			require.NoError(t, crs.ChangeTeamSettings(creator, c.Settings))
+
+			err := crs.CreateTeam(c.Academic, "team-id", "Team", c.Members...)
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Len(t, crs.Teams(), 1)
+
+				return
+			}
+			require.NoError(t, err)
+
+			team, ok := crs.StudentTeam(c.ExpectedMembers[0])
+			require.True(t, ok)
+			require.Equal(t, "team-id", team.ID())
+			require.Equal(t, "Team", team.Name())
+			require.Equal(t, c.ExpectedMembers, team.Members())
+		})
+	}
+}
+
+func TestCourse_AddTeamMembers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Settings course.TeamSettings
+		Students []string
+		IsErr    func(err error) bool
+	}{
+		{
+			Name:     "teacher_adds_members",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Students: []string{"student2-id", "student3-id"},
+		},
+		{
+			Name:     "student_joins_team",
+			Academic: course.MustNewAcademic("student2-id", course.StudentType),
+			Settings: course.MustNewTeamSettings(true, 2),
+			Students: []string{"student2-id"},
+		},
+		{
+			Name:     "student_cant_add_another_student",
+			Academic: course.MustNewAcademic("student1-id", course.StudentType),
+			Settings: course.MustNewTeamSettings(true, 0),
+			Students: []string{"student2-id"},
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+		{
+			Name:     "student_cant_join_full_team",
+			Academic: course.MustNewAcademic("student2-id", course.StudentType),
+			Settings: course.MustNewTeamSettings(true, 1),
+			Students: []string{"student2-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrTeamIsFull)
+			},
+		},
+		{
+			Name:     "student_from_another_team",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Students: []string{"student2-id", "student4-id"},
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrStudentAlreadyInTeam)
+			},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student1-id", "student2-id", "student3-id", "student4-id"))
+			require.NoError(t, crs.CreateTeam(creator, "team-id", "Team", "student1-id"))
+			require.NoError(t, crs.CreateTeam(creator, "other-team-id", "Other team", "student4-id"))
+			require.NoError(t, crs.ChangeTeamSettings(creator, c.Settings))
+
+			err := crs.AddTeamMembers(c.Academic, "team-id", c.Students...)
+
+			team, _ := crs.StudentTeam("student1-id")
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, []string{"student1-id"}, team.Members())
+
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, append([]string{"student1-id"}, c.Students...), team.Members())
+		})
+	}
+}
+
+func TestCourse_RemoveTeamMember(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	student1 := course.MustNewAcademic("student1-id", course.StudentType)
+	crs := newCourse(t, creator, withStudents("student1-id", "student2-id", "student3-id"))
+	require.NoError(t, crs.CreateTeam(creator, "team-id", "Team", "student1-id", "student2-id", "student3-id"))
+
+	err := crs.RemoveTeamMember(student1, "team-id", student1.ID())
+	require.ErrorIs(t, err, course.ErrTeamSelfFormationDisabled)
+
+	require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(true, 0)))
+
+	err = crs.RemoveTeamMember(student1, "team-id", "student2-id")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+
+	require.NoError(t, crs.RemoveTeamMember(student1, "team-id", student1.ID()))
+	require.NoError(t, crs.RemoveTeamMember(creator, "team-id", "student2-id"))
+
+	err = crs.RemoveTeamMember(creator, "team-id", "student2-id")
+	require.ErrorIs(t, err, course.ErrTeamHasNoSuchMember)
+
+	require.NoError(t, crs.RemoveStudent(creator, "student3-id", "", time.Now()))
+
+	teams := crs.Teams()
+	require.Len(t, teams, 1)
+	require.Empty(t, teams[0].Members())
+}
+
+func TestCourse_RenameTeam(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	member := course.MustNewAcademic("student1-id", course.StudentType)
+	outsider := course.MustNewAcademic("student2-id", course.StudentType)
+	crs := newCourse(t, creator, withStudents(member.ID(), outsider.ID()))
+	require.NoError(t, crs.ChangeTeamSettings(creator, course.MustNewTeamSettings(true, 3)))
+	require.NoError(t, crs.CreateTeam(member, "team-id", "Team"))
+
+	err := crs.RenameTeam(outsider, "team-id", "Outsiders")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+
+	err = crs.RenameTeam(member, "team-id", "")
+	require.ErrorIs(t, err, course.ErrEmptyTeamName)
+
+	require.NoError(t, crs.RenameTeam(member, "team-id", "Insiders"))
+	require.Equal(t, "Insiders", crs.Teams()[0].Name())
+
+	err = crs.RemoveTeam(member, "team-id")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+
+	require.NoError(t, crs.RemoveTeam(creator, "team-id"))
+	require.Empty(t, crs.Teams())
+
+	err = crs.RenameTeam(creator, "team-id", "Team")
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchTeam)
+}
+
+func TestCourse_TaskSubmitters(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student1-id", "student2-id", "student3-id"))
+	require.NoError(t, crs.CreateTeam(creator, "team-id", "Team", "student1-id", "student2-id"))
+
+	soloTaskNumber := addManualCheckingTaskToCourse(t, creator, crs)
+	teamTaskNumber, err := crs.AddTestingTask(creator, course.TestingTaskCreationParams{
+		Title:    "Team testing task",
+		TeamWork: true,
+	}, time.Now())
+	require.NoError(t, err)
+
+	submitters, err := crs.TaskSubmitters(soloTaskNumber, "student1-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student1-id"}, submitters)
+
+	submitters, err = crs.TaskSubmitters(teamTaskNumber, "student2-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student1-id", "student2-id"}, submitters)
+
+	submitters, err = crs.TaskSubmitters(teamTaskNumber, "student3-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student3-id"}, submitters)
+
+	_, err = crs.TaskSubmitters(teamTaskNumber, "other-student-id")
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchStudent)
+
+	teamWork := false
+	require.NoError(t, crs.EditTask(creator, teamTaskNumber, course.TaskEditParams{TeamWork: &teamWork}, time.Now()))
+
+	submitters, err = crs.TaskSubmitters(teamTaskNumber, "student2-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"student2-id"}, submitters)
+
+	firstVersion := findNewTaskVersion(t, crs, teamTaskNumber, 1)
+	require.NoError(t, crs.RestoreTaskVersion(creator, teamTaskNumber, firstVersion, time.Now()))
+
+	task, err := crs.Task(teamTaskNumber)
+	require.NoError(t, err)
+	require.True(t, task.TeamWork())
+}
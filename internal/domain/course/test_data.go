@@ -1,25 +1,75 @@
 package course
 
-import "github.com/pkg/errors"
+import (
+	"sort"
+	"strconv"
 
+	"github.com/pkg/errors"
+)
+
+type TestDataVisibility uint8
+
+const (
+	SampleTestData TestDataVisibility = iota + 1
+	HiddenTestData
+)
+
+func (v TestDataVisibility) String() string {
+	switch v {
+	case SampleTestData:
+		return "sample"
+	case HiddenTestData:
+		return "hidden"
+	}
+
+	return "%!TestDataVisibility(" + strconv.Itoa(int(v)) + ")"
+}
+
+func (v TestDataVisibility) IsValid() bool {
+	switch v {
+	case SampleTestData, HiddenTestData:
+		return true
+	}
+
+	return false
+}
+
+// TestData is input and expected output of auto code checking task test.
+// Small data is kept inline, large data is stored outside the course
+// and referenced by input and output IDs.
 type TestData struct {
-	inputData  string
-	outputData string
+	inputData    string
+	outputData   string
+	inputDataID  string
+	outputDataID string
+	visibility   TestDataVisibility
+	explanation  string
 }
 
-const testDataMaxLen = 1000
+const (
+	testDataMaxLen            = 1000
+	testDataExplanationMaxLen = 1000
+)
 
 var (
-	ErrTestInputDataTooLong  = errors.New("test input data too long")
-	ErrTestOutputDataTooLong = errors.New("test output data too long")
+	ErrTestInputDataTooLong       = errors.New("test input data too long")
+	ErrTestOutputDataTooLong      = errors.New("test output data too long")
+	ErrInvalidTestDataVisibility  = errors.New("invalid test data visibility")
+	ErrTestDataExplanationTooLong = errors.New("test data explanation too long")
+	ErrEmptyTestInputDataID       = errors.New("empty test input data id")
+	ErrEmptyTestOutputDataID      = errors.New("empty test output data id")
 )
 
 func IsInvalidTestDataError(err error) bool {
 	return errors.Is(err, ErrTestInputDataTooLong) ||
-		errors.Is(err, ErrTestOutputDataTooLong)
+		errors.Is(err, ErrTestOutputDataTooLong) ||
+		errors.Is(err, ErrInvalidTestDataVisibility) ||
+		errors.Is(err, ErrTestDataExplanationTooLong) ||
+		errors.Is(err, ErrEmptyTestInputDataID) ||
+		errors.Is(err, ErrEmptyTestOutputDataID)
 }
 
-func NewTestData(inputData, outputData string) (TestData, error) {
+func NewTestData(inputData, outputData string, visibility TestDataVisibility, explanation string) (TestData, error) {
 	if len(inputData) > testDataMaxLen {
 		return TestData{}, ErrTestInputDataTooLong
 	}
@@ -28,14 +78,72 @@ func NewTestData(inputData, outputData string) (TestData, error) {
 		return TestData{}, ErrTestOutputDataTooLong
 	}
 
+	if err := validateTestDataDescription(visibility, explanation); err != nil {
+		return TestData{}, err
+	}
+
+	return TestData{
+		inputData:   inputData,
+		outputData:  outputData,
+		visibility:  visibility,
+		explanation: explanation,
+	}, nil
+}
+
+// NewStoredTestData creates test data which input and output
+// are stored outside the course with given IDs.
+func NewStoredTestData(
+	inputDataID, outputDataID string,
+	visibility TestDataVisibility,
+	explanation string,
+) (TestData, error) {
+	if inputDataID == "" {
+		return TestData{}, ErrEmptyTestInputDataID
+	}
+
+	if outputDataID == "" {
+		return TestData{}, ErrEmptyTestOutputDataID
+	}
+
+	if err := validateTestDataDescription(visibility, explanation); err != nil {
+		return TestData{}, err
+	}
+
 	return TestData{
-		inputData:  inputData,
-		outputData: outputData,
+		inputDataID:  inputDataID,
+		outputDataID: outputDataID,
+		visibility:   visibility,
+		explanation:  explanation,
 	}, nil
 }
 
-func MustNewTestData(inputData, outputData string) TestData {
-	td, err := NewTestData(inputData, outputData)
+func validateTestDataDescription(visibility TestDataVisibility, explanation string) error {
+	if !visibility.IsValid() {
+		return ErrInvalidTestDataVisibility
+	}
+
+	if len(explanation) > testDataExplanationMaxLen {
+		return ErrTestDataExplanationTooLong
+	}
+
+	return nil
+}
+
+func MustNewTestData(inputData, outputData string, visibility TestDataVisibility, explanation string) TestData {
+	td, err := NewTestData(inputData, outputData, visibility, explanation)
+	if err != nil {
+		panic(err)
+	}
+
+	return td
+}
+
+func MustNewStoredTestData(
+	inputDataID, outputDataID string,
+	visibility TestDataVisibility,
+	explanation string,
+) TestData {
+	td, err := NewStoredTestData(inputDataID, outputDataID, visibility, explanation)
 	if err != nil {
 		panic(err)
 	}
@@ -51,6 +159,52 @@ func (td TestData) OutputData() string {
 	return td.outputData
 }
 
+func (td TestData) InputDataID() string {
+	return td.inputDataID
+}
+
+func (td TestData) OutputDataID() string {
+	return td.outputDataID
+}
+
+// IsStored reports whether input and output are stored
+// outside the course and should be obtained by IDs.
+func (td TestData) IsStored() bool {
+	return td.inputDataID != "" || td.outputDataID != ""
+}
+
+func (td TestData) Visibility() TestDataVisibility {
+	return td.visibility
+}
+
+// Explanation returns optional teacher's comment on test,
+// for example why sample output is exactly like this.
+func (td TestData) Explanation() string {
+	return td.explanation
+}
+
+func (td TestData) IsSample() bool {
+	return td.visibility == SampleTestData
+}
+
 func (td TestData) IsZero() bool {
 	return td == TestData{}
 }
+
+// StoredTestDataIDs returns sorted IDs of input and output
+// data that course tasks store outside the course.
+func (c *Course) StoredTestDataIDs() []string {
+	ids := make([]string, 0)
+
+	for _, t := range c.tasks {
+		for _, td := range t.testData() {
+			if td.IsStored() {
+				ids = append(ids, td.inputDataID, td.outputDataID)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
@@ -17,25 +17,52 @@ func TestNewTestData(t *testing.T) {
 		Name        string
 		InputData   string
 		OutputData  string
+		Visibility  course.TestDataVisibility
+		Explanation string
 		ExpectedErr error
 	}{
 		{
 			Name:       "valid_test_data_parameters",
 			InputData:  "1 + 1",
 			OutputData: "2",
+			Visibility: course.HiddenTestData,
+		},
+		{
+			Name:        "valid_sample_test_data_with_explanation",
+			InputData:   "2 2",
+			OutputData:  "4",
+			Visibility:  course.SampleTestData,
+			Explanation: "2 + 2 = 4",
 		},
 		{
 			Name:        "input_data_too_long",
 			InputData:   strings.Repeat("x", 1001),
 			OutputData:  "xxx",
+			Visibility:  course.HiddenTestData,
 			ExpectedErr: course.ErrTestInputDataTooLong,
 		},
 		{
 			Name:        "output_data_too_long",
 			InputData:   "xxx",
 			OutputData:  strings.Repeat("x", 1001),
+			Visibility:  course.HiddenTestData,
 			ExpectedErr: course.ErrTestOutputDataTooLong,
 		},
+		{
+			Name:        "invalid_visibility",
+			InputData:   "xxx",
+			OutputData:  "xxx",
+			Visibility:  course.TestDataVisibility(0),
+			ExpectedErr: course.ErrInvalidTestDataVisibility,
+		},
+		{
+			Name:        "explanation_too_long",
+			InputData:   "xxx",
+			OutputData:  "xxx",
+			Visibility:  course.SampleTestData,
+			Explanation: strings.Repeat("x", 1001),
+			ExpectedErr: course.ErrTestDataExplanationTooLong,
+		},
 	}
 
 	for i := range testCases {
@@ -43,7 +70,7 @@ func TestNewTestData(t *testing.T) {
 		t.Run(c.Name, func(t *testing.T) {
 			t.Parallel()
 
-			testData, err := course.NewTestData(c.InputData, c.OutputData)
+			testData, err := course.NewTestData(c.InputData, c.OutputData, c.Visibility, c.Explanation)
 
 			if c.ExpectedErr != nil {
 				require.Error(t, err)
@@ -54,6 +81,67 @@ func TestNewTestData(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, c.InputData, testData.InputData())
 			require.Equal(t, c.OutputData, testData.OutputData())
+			require.Equal(t, c.Visibility, testData.Visibility())
+			require.Equal(t, c.Explanation, testData.Explanation())
+		})
+	}
+}
+
+func TestNewStoredTestData(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		InputDataID  string
+		OutputDataID string
+		Visibility   course.TestDataVisibility
+		ExpectedErr  error
+	}{
+		{
+			Name:         "valid_stored_test_data_parameters",
+			InputDataID:  "input-data-id",
+			OutputDataID: "output-data-id",
+			Visibility:   course.HiddenTestData,
+		},
+		{
+			Name:         "empty_input_data_id",
+			OutputDataID: "output-data-id",
+			Visibility:   course.HiddenTestData,
+			ExpectedErr:  course.ErrEmptyTestInputDataID,
+		},
+		{
+			Name:        "empty_output_data_id",
+			InputDataID: "input-data-id",
+			Visibility:  course.SampleTestData,
+			ExpectedErr: course.ErrEmptyTestOutputDataID,
+		},
+		{
+			Name:         "invalid_visibility",
+			InputDataID:  "input-data-id",
+			OutputDataID: "output-data-id",
+			ExpectedErr:  course.ErrInvalidTestDataVisibility,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			testData, err := course.NewStoredTestData(c.InputDataID, c.OutputDataID, c.Visibility, "")
+
+			if c.ExpectedErr != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, c.ExpectedErr))
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, testData.IsStored())
+			require.Equal(t, c.InputDataID, testData.InputDataID())
+			require.Equal(t, c.OutputDataID, testData.OutputDataID())
+			require.Empty(t, testData.InputData())
+			require.Empty(t, testData.OutputData())
 		})
 	}
 }
@@ -73,7 +161,7 @@ func TestTestData_IsZero(t *testing.T) {
 		},
 		{
 			Name:         "should_not_be_zero",
-			TestData:     course.MustNewTestData("2 * 2", "4"),
+			TestData:     course.MustNewTestData("2 * 2", "4", course.SampleTestData, ""),
 			ShouldBeZero: false,
 		},
 	}
@@ -0,0 +1,243 @@
+package course
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WaitlistEntry is student who tried to join full course and
+// waits for free seat, entries are promoted in order of waiting.
+type WaitlistEntry struct {
+	studentID    string
+	waitlistedAt time.Time
+}
+
+// WaitlistPromotion records that waitlisted student
+// was added to course when seat became free.
+type WaitlistPromotion struct {
+	studentID    string
+	waitlistedAt time.Time
+	promotedAt   time.Time
+}
+
+var (
+	ErrNegativeCourseCapacity    = errors.New("course capacity is negative")
+	ErrStudentAlreadyWaitlisted  = errors.New("student is already in waitlist of course")
+	ErrCourseHasNoSuchWaitlisted = errors.New("course has no such student in waitlist")
+)
+
+// CourseIsFullError is returned when students can't be added
+// to course because it has no free seats for all of them.
+type CourseIsFullError struct {
+	capacity int
+}
+
+func (e CourseIsFullError) Error() string {
+	return fmt.Sprintf("course is full, its capacity is %d students", e.capacity)
+}
+
+func (e CourseIsFullError) Capacity() int {
+	return e.capacity
+}
+
+func IsCourseIsFullError(err error) bool {
+	var e CourseIsFullError
+
+	return errors.As(err, &e)
+}
+
+func (e WaitlistEntry) StudentID() string {
+	return e.studentID
+}
+
+func (e WaitlistEntry) WaitlistedAt() time.Time {
+	return e.waitlistedAt
+}
+
+func (p WaitlistPromotion) StudentID() string {
+	return p.studentID
+}
+
+func (p WaitlistPromotion) WaitlistedAt() time.Time {
+	return p.waitlistedAt
+}
+
+func (p WaitlistPromotion) PromotedAt() time.Time {
+	return p.promotedAt
+}
+
+// Capacity returns maximum number of course students, zero means no limit.
+func (c *Course) Capacity() int {
+	return c.capacity
+}
+
+// Waitlist returns waitlisted students in order of waiting.
+func (c *Course) Waitlist() []WaitlistEntry {
+	return append([]WaitlistEntry(nil), c.waitlist...)
+}
+
+// WaitlistPromotions returns promotions from waitlist from the oldest.
+func (c *Course) WaitlistPromotions() []WaitlistPromotion {
+	return append([]WaitlistPromotion(nil), c.promotions...)
+}
+
+func (c *Course) IsWaitlisted(studentID string) bool {
+	return c.waitlistIndex(studentID) >= 0
+}
+
+// ChangeCapacity changes maximum number of course students, students over
+// new capacity are kept. Increased capacity is filled from waitlist at once.
+func (c *Course) ChangeCapacity(academic Academic, capacity int, now time.Time) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	if capacity < 0 {
+		return ErrNegativeCourseCapacity
+	}
+
+	c.capacity = capacity
+	c.promoteWaitlisted(now)
+
+	return nil
+}
+
+// CanAddStudents returns error if academic can't add students to course
+// or course hasn't enough free seats for students who aren't in it yet.
+func (c *Course) CanAddStudents(academic Academic, studentIDs ...string) error {
+	if err := c.CanAcademicEditStudents(academic); err != nil {
+		return err
+	}
+
+	return c.checkFreeSeats(studentIDs)
+}
+
+// RemoveFromWaitlist removes student from waitlist, student
+// can leave waitlist by himself.
+func (c *Course) RemoveFromWaitlist(academic Academic, studentID string) error {
+	if academic.Type() != StudentType || academic.ID() != studentID {
+		if err := c.CanAcademicEditStudents(academic); err != nil {
+			return err
+		}
+	}
+
+	if !c.IsWaitlisted(studentID) {
+		return ErrCourseHasNoSuchWaitlisted
+	}
+
+	c.dropFromWaitlist(studentID)
+
+	return nil
+}
+
+func (c *Course) checkFreeSeats(studentIDs []string) error {
+	if c.capacity == 0 {
+		return nil
+	}
+
+	newStudents := make(map[string]bool, len(studentIDs))
+
+	for _, sid := range studentIDs {
+		if !c.hasStudent(sid) {
+			newStudents[sid] = true
+		}
+	}
+
+	if len(c.students)+len(newStudents) > c.capacity {
+		return CourseIsFullError{capacity: c.capacity}
+	}
+
+	return nil
+}
+
+// splitByFreeSeats returns students who take free seats of course in
+// given order and students who don't fit, students of course always fit.
+func (c *Course) splitByFreeSeats(studentIDs []string) (seatedIDs, waitingIDs []string) {
+	if c.capacity == 0 {
+		return studentIDs, nil
+	}
+
+	freeSeats := c.capacity - len(c.students)
+
+	for _, sid := range studentIDs {
+		switch {
+		case c.hasStudent(sid):
+			seatedIDs = append(seatedIDs, sid)
+		case freeSeats > 0:
+			seatedIDs = append(seatedIDs, sid)
+			freeSeats--
+		default:
+			waitingIDs = append(waitingIDs, sid)
+		}
+	}
+
+	return seatedIDs, waitingIDs
+}
+
+func (c *Course) isFull() bool {
+	return c.capacity > 0 && len(c.students) >= c.capacity
+}
+
+func (c *Course) waitlistStudent(studentID string, now time.Time) error {
+	if c.IsWaitlisted(studentID) {
+		return ErrStudentAlreadyWaitlisted
+	}
+
+	c.waitlist = append(c.waitlist, WaitlistEntry{studentID: studentID, waitlistedAt: now.UTC()})
+
+	return nil
+}
+
+// promoteWaitlisted adds students from head of waitlist while course has free seats.
+func (c *Course) promoteWaitlisted(now time.Time) {
+	for len(c.waitlist) > 0 && !c.isFull() {
+		entry := c.waitlist[0]
+		c.waitlist = c.waitlist[1:]
+		c.enrollStudent(entry.studentID, now)
+		c.promotions = append(c.promotions, WaitlistPromotion{
+			studentID:    entry.studentID,
+			waitlistedAt: entry.waitlistedAt,
+			promotedAt:   now.UTC(),
+		})
+	}
+}
+
+func (c *Course) dropFromWaitlist(studentID string) {
+	if i := c.waitlistIndex(studentID); i >= 0 {
+		c.waitlist = append(c.waitlist[:i:i], c.waitlist[i+1:]...)
+	}
+}
+
+func (c *Course) waitlistIndex(studentID string) int {
+	for i, e := range c.waitlist {
+		if e.studentID == studentID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func unmarshalWaitlist(params []UnmarshallingWaitlistEntryParams) []WaitlistEntry {
+	waitlist := make([]WaitlistEntry, 0, len(params))
+	for _, p := range params {
+		waitlist = append(waitlist, WaitlistEntry{studentID: p.StudentID, waitlistedAt: p.WaitlistedAt})
+	}
+
+	return waitlist
+}
+
+func unmarshalWaitlistPromotions(params []UnmarshallingWaitlistPromotionParams) []WaitlistPromotion {
+	promotions := make([]WaitlistPromotion, 0, len(params))
+	for _, p := range params {
+		promotions = append(promotions, WaitlistPromotion{
+			studentID:    p.StudentID,
+			waitlistedAt: p.WaitlistedAt,
+			promotedAt:   p.PromotedAt,
+		})
+	}
+
+	return promotions
+}
@@ -0,0 +1,151 @@
+package course_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestCourse_ChangeCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Academic course.Academic
+		Capacity int
+		IsErr    func(err error) bool
+	}{
+		{
+			Name:     "creator_changes_capacity",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Capacity: 25,
+		},
+		{
+			Name:     "capacity_is_removed",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+		},
+		{
+			Name:     "negative_capacity",
+			Academic: course.MustNewAcademic("creator-id", course.TeacherType),
+			Capacity: -1,
+			IsErr: func(err error) bool {
+				return errors.Is(err, course.ErrNegativeCourseCapacity)
+			},
+		},
+		{
+			Name:     "student_cant_change_capacity",
+			Academic: course.MustNewAcademic("student-id", course.StudentType),
+			Capacity: 25,
+			IsErr:    course.IsAcademicCantEditCourseError,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			creator := course.MustNewAcademic("creator-id", course.TeacherType)
+			crs := newCourse(t, creator, withStudents("student-id"))
+			require.NoError(t, crs.ChangeCapacity(creator, 10, time.Now()))
+
+			err := crs.ChangeCapacity(c.Academic, c.Capacity, time.Now())
+			if c.IsErr != nil {
+				require.Error(t, err)
+				require.True(t, c.IsErr(err))
+				require.Equal(t, 10, crs.Capacity())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.Capacity, crs.Capacity())
+		})
+	}
+}
+
+func TestCourse_AddStudents_to_full_course(t *testing.T) {
+	t.Parallel()
+
+	creator := course.MustNewAcademic("creator-id", course.TeacherType)
+	crs := newCourse(t, creator, withStudents("student1-id"))
+	require.NoError(t, crs.ChangeCapacity(creator, 2, time.Now()))
+
+	err := crs.AddStudents(creator, time.Now(), "student1-id", "student2-id", "student3-id")
+	require.True(t, course.IsCourseIsFullError(err))
+	require.ElementsMatch(t, []string{"student1-id"}, crs.Students())
+
+	err = crs.AddGroup(creator, "group-id", []string{"student2-id", "student3-id"}, time.Now())
+	require.True(t, course.IsCourseIsFullError(err))
+	require.Empty(t, crs.Groups())
+
+	require.NoError(t, crs.AddStudents(creator, time.Now(), "student1-id", "student2-id"))
+	require.ElementsMatch(t, []string{"student1-id", "student2-id"}, crs.Students())
+}
+
+func TestCourse_Waitlist(t *testing.T) {
+	t.Parallel()
+
+	var (
+		creator  = course.MustNewAcademic("creator-id", course.TeacherType)
+		student2 = course.MustNewAcademic("student2-id", course.StudentType)
+		student3 = course.MustNewAcademic("student3-id", course.StudentType)
+		student4 = course.MustNewAcademic("student4-id", course.StudentType)
+		now      = time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	crs := newCourse(t, creator, withStudents("student1-id"))
+	require.NoError(t, crs.ChangeCapacity(creator, 1, now))
+	require.NoError(t, crs.AddInvitation(creator, course.MustNewInvitation(
+		"K7QX2M4PZA", course.StudentType, now.Add(time.Hour), 10,
+	), now))
+
+	require.NoError(t, crs.RedeemInvitation(student2, "K7QX2M4PZA", now.In(time.FixedZone("MSK", 3*60*60))))
+	require.NoError(t, crs.RedeemInvitation(student3, "K7QX2M4PZA", now.Add(time.Minute)))
+	require.NoError(t, crs.RedeemInvitation(student4, "K7QX2M4PZA", now.Add(2*time.Minute)))
+
+	err := crs.RedeemInvitation(student3, "K7QX2M4PZA", now)
+	require.ErrorIs(t, err, course.ErrStudentAlreadyWaitlisted)
+	require.ElementsMatch(t, []string{"student1-id"}, crs.Students())
+	requireWaitlist(t, crs, "student2-id", "student3-id", "student4-id")
+
+	err = crs.RemoveFromWaitlist(student2, "student3-id")
+	require.True(t, course.IsAcademicCantEditCourseError(err))
+	require.NoError(t, crs.RemoveFromWaitlist(student3, "student3-id"))
+
+	err = crs.RemoveFromWaitlist(creator, "student3-id")
+	require.ErrorIs(t, err, course.ErrCourseHasNoSuchWaitlisted)
+
+	promotedAt := now.Add(time.Hour)
+	require.NoError(t, crs.RemoveStudent(creator, "student1-id", "", promotedAt.In(time.FixedZone("MSK", 3*60*60))))
+	require.ElementsMatch(t, []string{"student2-id"}, crs.Students())
+	requireWaitlist(t, crs, "student4-id")
+
+	promotions := crs.WaitlistPromotions()
+	require.Len(t, promotions, 1)
+	require.Equal(t, "student2-id", promotions[0].StudentID())
+	require.Equal(t, now, promotions[0].WaitlistedAt())
+	require.Equal(t, promotedAt, promotions[0].PromotedAt())
+	require.Equal(t, time.UTC, promotions[0].PromotedAt().Location())
+
+	require.NoError(t, crs.ChangeCapacity(creator, 0, promotedAt))
+	require.ElementsMatch(t, []string{"student2-id", "student4-id"}, crs.Students())
+	require.Empty(t, crs.Waitlist())
+	require.Len(t, crs.WaitlistPromotions(), 2)
+}
+
+func requireWaitlist(t *testing.T, crs *course.Course, studentIDs ...string) {
+	t.Helper()
+
+	waitlist := crs.Waitlist()
+	waitlisted := make([]string, 0, len(waitlist))
+
+	for _, e := range waitlist {
+		waitlisted = append(waitlisted, e.StudentID())
+	}
+
+	require.Equal(t, studentIDs, waitlisted)
+}
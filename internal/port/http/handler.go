@@ -13,27 +13,40 @@ import (
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/port/http/auth"
 	v1 "github.com/authena-ru/courses-organization/internal/port/http/v1"
+	"github.com/authena-ru/courses-organization/internal/port/http/webhook"
 	"github.com/authena-ru/courses-organization/pkg/logging"
 )
 
-func NewHandler(app app.Application) http.Handler {
+// NewHandler returns handler of API, webhooks of academics
+// service are handled only when webhook secret is given.
+func NewHandler(app app.Application, webhookSecret string) http.Handler {
 	apiRouter := chi.NewRouter()
 	addMiddlewares(apiRouter)
 
 	rootRouter := chi.NewRouter()
 	rootRouter.Mount("/v1", v1.NewHandler(app, apiRouter))
 
+	if webhookSecret != "" {
+		webhookRouter := chi.NewRouter()
+		addCommonMiddlewares(webhookRouter)
+		rootRouter.Mount("/webhooks", webhook.NewHandler(app, webhookSecret, webhookRouter))
+	}
+
 	return rootRouter
 }
 
 func addMiddlewares(router *chi.Mux) {
+	addCommonMiddlewares(router)
+	addCORSMiddleware(router)
+	router.Use(auth.MockAuthHTTPMiddleware)
+	router.Use(middleware.NoCache)
+}
+
+func addCommonMiddlewares(router *chi.Mux) {
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
 	router.Use(logging.NewStructuredLogger(logrus.StandardLogger()))
 	router.Use(middleware.Recoverer)
-	addCORSMiddleware(router)
-	router.Use(auth.MockAuthHTTPMiddleware)
-	router.Use(middleware.NoCache)
 }
 
 const maxAge = 300
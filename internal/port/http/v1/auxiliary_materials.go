@@ -1,11 +1,320 @@
 package v1
 
-import "net/http"
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
 
-func (h handler) AttachAuxiliaryMaterialToCourse(w http.ResponseWriter, _ *http.Request, _ string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+	"github.com/authena-ru/courses-organization/pkg/logging"
+)
+
+func (h handler) AttachAuxiliaryMaterialToCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalAttachAuxiliaryMaterialCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	materialID, err := h.app.Commands.AttachAuxiliaryMaterial.Handle(r.Context(), cmd)
+	if err == nil {
+		w.Header().Set("Content-Location", fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID))
+		w.WriteHeader(http.StatusCreated)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTask) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidAuxiliaryMaterialError(err) {
+		httperr.UnprocessableEntity("invalid-auxiliary-material", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) UploadAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalUploadAuxiliaryMaterialCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	materialID, err := h.app.Commands.UploadAuxiliaryMaterial.Handle(r.Context(), cmd)
+	if err == nil {
+		w.Header().Set("Content-Location", fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID))
+		w.WriteHeader(http.StatusCreated)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTask) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrMaterialFileTooLarge) {
+		httperr.RequestEntityTooLarge("material-file-too-large", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrMaterialFileTypeNotAllowed) {
+		httperr.UnsupportedMediaType("material-file-type-not-allowed", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidAuxiliaryMaterialError(err) {
+		httperr.UnprocessableEntity("invalid-auxiliary-material", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) GetAllCourseAuxiliaryMaterials(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetAllCourseAuxiliaryMaterialsParams,
+) {
+	qry, ok := unmarshalAllAuxiliaryMaterialsQuery(w, r, courseID, params)
+	if !ok {
+		return
+	}
+
+	materials, err := h.app.Queries.AllAuxiliaryMaterials.Handle(r.Context(), qry)
+	if err == nil {
+		marshalAuxiliaryMaterials(w, r, materials)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) GetCourseAuxiliaryMaterial(w http.ResponseWriter, r *http.Request, courseID, materialID string) {
+	qry, ok := unmarshalSpecificAuxiliaryMaterialQuery(w, r, courseID, materialID)
+	if !ok {
+		return
+	}
+
+	material, err := h.app.Queries.SpecificAuxiliaryMaterial.Handle(r.Context(), qry)
+	if err == nil {
+		marshalAuxiliaryMaterial(w, r, material)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrAuxiliaryMaterialDoesntExist) {
+		httperr.NotFound("auxiliary-material-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) EditAuxiliaryMaterial(w http.ResponseWriter, r *http.Request, courseID, materialID string) {
+	cmd, ok := unmarshalEditAuxiliaryMaterialCommand(w, r, courseID, materialID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.EditAuxiliaryMaterial.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchMaterial) {
+		httperr.NotFound("auxiliary-material-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTask) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidAuxiliaryMaterialError(err) {
+		httperr.UnprocessableEntity("invalid-auxiliary-material", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
 }
 
-func (h handler) GetAllCourseAuxiliaryMaterials(w http.ResponseWriter, _ *http.Request, _ string, _ GetAllCourseAuxiliaryMaterialsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (h handler) RemoveAuxiliaryMaterialFromCourse(w http.ResponseWriter, r *http.Request, courseID, materialID string) {
+	cmd, ok := unmarshalRemoveAuxiliaryMaterialCommand(w, r, courseID, materialID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RemoveAuxiliaryMaterial.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchMaterial) {
+		httperr.NotFound("auxiliary-material-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) ReorderAuxiliaryMaterials(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalReorderAuxiliaryMaterialsCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.ReorderAuxiliaryMaterials.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrInvalidMaterialsOrder) {
+		httperr.UnprocessableEntity("invalid-auxiliary-materials-order", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) GetAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request, courseID, materialID string) {
+	qry, ok := unmarshalAuxiliaryMaterialFileQuery(w, r, courseID, materialID)
+	if !ok {
+		return
+	}
+
+	file, err := h.app.Queries.AuxiliaryMaterialFile.Handle(r.Context(), qry)
+	if err == nil {
+		defer file.Data.Close()
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(file.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		if _, err := io.Copy(w, file.Data); err != nil {
+			logging.GetLogEntry(r).WithError(err).Warn("Failed to write auxiliary material file")
+		}
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrAuxiliaryMaterialDoesntExist) {
+		httperr.NotFound("auxiliary-material-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrMaterialFileDoesntExist) {
+		httperr.NotFound("material-file-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
 }
@@ -0,0 +1,922 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	cmock "github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_AttachAuxiliaryMaterialToCourse(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d"
+		materialID = "6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e"
+	)
+
+	testCases := []struct {
+		Name                 string
+		RequestBody          string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.AttachAuxiliaryMaterialHandler
+		StatusCode           int
+		ContentLocation      string
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:        "material_attached",
+			RequestBody: `{"resource": "https://youtu.be/lecture-1", "resourceType": "VIDEO"}`,
+			Authorized:  course.MustNewAcademic("7c8d9e0f-1a2b-4c3d-8e4f-5a6b7c8d9e0f", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.AttachAuxiliaryMaterialCommand) (string, error) {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, "https://youtu.be/lecture-1", cmd.Resource)
+					require.Equal(t, course.VideoResource, cmd.ResourceType)
+
+					return materialID, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ContentLocation: fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+		},
+		{
+			Name: "material_attached_to_task",
+			RequestBody: `{
+				"resource": "https://youtu.be/lecture-2",
+				"resourceType": "VIDEO",
+				"taskNumber": 2,
+				"releaseTime": "2043-09-15T09:00:00Z"
+			}`,
+			Authorized: course.MustNewAcademic("7c8d9e0f-1a2b-4c3d-8e4f-5a6b7c8d9e0f", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.AttachAuxiliaryMaterialCommand) (string, error) {
+					require.Equal(t, 2, cmd.TaskNumber)
+					require.Equal(t, time.Date(2043, time.September, 15, 9, 0, 0, 0, time.UTC), cmd.ReleaseTime)
+					require.False(t, cmd.Hidden)
+
+					return materialID, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ContentLocation: fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+		},
+		{
+			Name:        "task_not_found",
+			RequestBody: `{"resource": "https://youtu.be/lecture-2", "resourceType": "VIDEO", "taskNumber": 9}`,
+			Authorized:  course.MustNewAcademic("7c8d9e0f-1a2b-4c3d-8e4f-5a6b7c8d9e0f", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.AttachAuxiliaryMaterialCommand) (string, error) {
+					return "", course.ErrCourseHasNoSuchTask
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "course-task-not-found", "details": "course has no such task"}`,
+		},
+		{
+			Name:        "invalid_material",
+			RequestBody: `{"resource": "not url", "resourceType": "PRESENTATION"}`,
+			Authorized:  course.MustNewAcademic("8d9e0f1a-2b3c-4d4e-9f5a-6b7c8d9e0f1a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.AttachAuxiliaryMaterialCommand) (string, error) {
+					return "", course.ErrResourceIsNotURL
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody: `{
+				"slug": "invalid-auxiliary-material",
+				"details": "auxiliary material resource isn't http or https url"
+			}`,
+		},
+		{
+			Name:        "course_not_found",
+			RequestBody: `{"resource": "Read chapter 3", "resourceType": "OTHER"}`,
+			Authorized:  course.MustNewAcademic("9e0f1a2b-3c4d-4e5f-8a6b-7c8d9e0f1a2b", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.AttachAuxiliaryMaterialCommand) (string, error) {
+					return "", app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"resource": "Read chapter 3", "resourceType": "OTHER"}`,
+			Authorized:  course.MustNewAcademic("0f1a2b3c-4d5e-4f6a-9b7c-8d9e0f1a2b3c", course.StudentType),
+			PrepareHandler: func(_ *testing.T) cmock.AttachAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.AttachAuxiliaryMaterialCommand) (string, error) {
+					return "", course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					AttachAuxiliaryMaterial: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/auxiliary-materials", courseID),
+				c.RequestBody, c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.Equal(t, c.ContentLocation, w.Header().Get("Content-Location"))
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_UploadAuxiliaryMaterialFile(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "8f9a0b1c-2d3e-4f4a-9b5c-6d7e8f9a0b1c"
+		materialID = "9a0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d"
+		pdf        = "%PDF-1.7\n"
+	)
+
+	type formField struct {
+		Name, FileName, Value string
+	}
+
+	testCases := []struct {
+		Name                 string
+		Fields               []formField
+		PrepareHandler       func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler
+		StatusCode           int
+		ContentLocation      string
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name: "material_file_uploaded",
+			Fields: []formField{
+				{Name: "resourceType", Value: "PRESENTATION"},
+				{Name: "file", FileName: "Lecture 1.pdf", Value: pdf},
+			},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.UploadAuxiliaryMaterialCommand) (string, error) {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, course.PresentationResource, cmd.ResourceType)
+					require.Zero(t, cmd.TaskNumber)
+					require.Equal(t, "Lecture 1.pdf", cmd.FileName)
+
+					content, err := io.ReadAll(cmd.Data)
+					require.NoError(t, err)
+					require.Equal(t, pdf, string(content))
+
+					return materialID, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ContentLocation: fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+		},
+		{
+			Name: "material_file_uploaded_to_task",
+			Fields: []formField{
+				{Name: "resourceType", Value: "PRESENTATION"},
+				{Name: "taskNumber", Value: "3"},
+				{Name: "releaseTime", Value: "2043-09-22T09:00:00+03:00"},
+				{Name: "hidden", Value: "true"},
+				{Name: "file", FileName: "Lecture 3.pdf", Value: pdf},
+			},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.UploadAuxiliaryMaterialCommand) (string, error) {
+					require.Equal(t, 3, cmd.TaskNumber)
+					require.True(t, cmd.ReleaseTime.Equal(time.Date(2043, time.September, 22, 6, 0, 0, 0, time.UTC)))
+					require.True(t, cmd.Hidden)
+
+					return materialID, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ContentLocation: fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+		},
+		{
+			Name: "task_number_isnt_number",
+			Fields: []formField{
+				{Name: "resourceType", Value: "PRESENTATION"},
+				{Name: "taskNumber", Value: "third"},
+				{Name: "file", FileName: "Lecture 3.pdf", Value: pdf},
+			},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					t.Fatal("handler shouldn't be called")
+
+					return "", nil
+				}
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "release_time_isnt_rfc3339",
+			Fields: []formField{
+				{Name: "resourceType", Value: "PRESENTATION"},
+				{Name: "releaseTime", Value: "next monday"},
+				{Name: "file", FileName: "Lecture 3.pdf", Value: pdf},
+			},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					t.Fatal("handler shouldn't be called")
+
+					return "", nil
+				}
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "resource_type_after_file",
+			Fields: []formField{
+				{Name: "file", FileName: "Lecture 1.pdf", Value: pdf},
+				{Name: "resourceType", Value: "PRESENTATION"},
+			},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					t.Fatal("handler shouldn't be called")
+
+					return "", nil
+				}
+			},
+			StatusCode:           http.StatusBadRequest,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-multipart-form", "details": "resourceType field should precede file"}`,
+		},
+		{
+			Name:   "file_missing",
+			Fields: []formField{{Name: "resourceType", Value: "VIDEO"}},
+			PrepareHandler: func(t *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					t.Fatal("handler shouldn't be called")
+
+					return "", nil
+				}
+			},
+			StatusCode:           http.StatusBadRequest,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "material-file-missing", "details": "multipart form has no file"}`,
+		},
+		{
+			Name: "file_too_large",
+			Fields: []formField{
+				{Name: "resourceType", Value: "VIDEO"},
+				{Name: "file", FileName: "lecture.mp4", Value: "large video"},
+			},
+			PrepareHandler: func(_ *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					return "", app.ErrMaterialFileTooLarge
+				}
+			},
+			StatusCode:           http.StatusRequestEntityTooLarge,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "material-file-too-large", "details": "auxiliary material file too large"}`,
+		},
+		{
+			Name: "file_type_not_allowed",
+			Fields: []formField{
+				{Name: "resourceType", Value: "OTHER"},
+				{Name: "file", FileName: "page.pdf", Value: "<html></html>"},
+			},
+			PrepareHandler: func(_ *testing.T) cmock.UploadAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.UploadAuxiliaryMaterialCommand) (string, error) {
+					return "", app.ErrMaterialFileTypeNotAllowed
+				}
+			},
+			StatusCode:           http.StatusUnsupportedMediaType,
+			ShouldBeResponseBody: true,
+			ResponseBody: `{
+				"slug": "material-file-type-not-allowed",
+				"details": "auxiliary material file type isn't allowed"
+			}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					UploadAuxiliaryMaterial: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			for _, f := range c.Fields {
+				if f.FileName != "" {
+					part, err := form.CreateFormFile(f.Name, f.FileName)
+					require.NoError(t, err)
+					_, err = io.WriteString(part, f.Value)
+					require.NoError(t, err)
+
+					continue
+				}
+				require.NoError(t, form.WriteField(f.Name, f.Value))
+			}
+			require.NoError(t, form.Close())
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/auxiliary-materials/files", courseID),
+				body.String(), course.MustNewAcademic("0b1c2d3e-4f5a-4b6c-9d7e-8f9a0b1c2d3e", course.TeacherType),
+			)
+			r.Header.Set("Content-Type", form.FormDataContentType())
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.Equal(t, c.ContentLocation, w.Header().Get("Content-Location"))
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_GetAuxiliaryMaterialFile(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "1c2d3e4f-5a6b-4c7d-8e8f-9a0b1c2d3e4f"
+		materialID = "2d3e4f5a-6b7c-4d8e-9f9a-0b1c2d3e4f5a"
+	)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) qmock.AuxiliaryMaterialFileHandler
+		StatusCode     int
+		Headers        map[string]string
+		ResponseBody   string
+		IsJSONResponse bool
+	}{
+		{
+			Name: "file_downloaded",
+			PrepareHandler: func(t *testing.T) qmock.AuxiliaryMaterialFileHandler {
+				return func(_ context.Context, qry app.AuxiliaryMaterialFileQuery) (app.MaterialFileContent, error) {
+					require.Equal(t, courseID, qry.CourseID)
+					require.Equal(t, materialID, qry.MaterialID)
+
+					return app.MaterialFileContent{
+						Name:        "Lecture 1.pdf",
+						ContentType: "application/pdf",
+						Size:        int64(len("%PDF-1.7")),
+						Data:        io.NopCloser(strings.NewReader("%PDF-1.7")),
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			Headers: map[string]string{
+				"Content-Type":           "application/pdf",
+				"Content-Length":         "8",
+				"Content-Disposition":    `attachment; filename="Lecture 1.pdf"`,
+				"X-Content-Type-Options": "nosniff",
+			},
+			ResponseBody: "%PDF-1.7",
+		},
+		{
+			Name: "material_isnt_file",
+			PrepareHandler: func(_ *testing.T) qmock.AuxiliaryMaterialFileHandler {
+				return func(_ context.Context, _ app.AuxiliaryMaterialFileQuery) (app.MaterialFileContent, error) {
+					return app.MaterialFileContent{}, app.ErrMaterialFileDoesntExist
+				}
+			},
+			StatusCode:     http.StatusNotFound,
+			ResponseBody:   `{"slug": "material-file-not-found", "details": "auxiliary material file doesn't exist"}`,
+			IsJSONResponse: true,
+		},
+		{
+			Name: "academic_isnt_member_of_course",
+			PrepareHandler: func(_ *testing.T) qmock.AuxiliaryMaterialFileHandler {
+				return func(_ context.Context, _ app.AuxiliaryMaterialFileQuery) (app.MaterialFileContent, error) {
+					return app.MaterialFileContent{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:     http.StatusNotFound,
+			ResponseBody:   `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+			IsJSONResponse: true,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					AuxiliaryMaterialFile: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/auxiliary-materials/%s/file", courseID, materialID),
+				"", course.MustNewAcademic("3e4f5a6b-7c8d-4e9f-8a0b-1c2d3e4f5a6b", course.StudentType),
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			for name, value := range c.Headers {
+				require.Equal(t, value, w.Header().Get(name))
+			}
+
+			if c.IsJSONResponse {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+
+				return
+			}
+			require.Equal(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_GetAllCourseAuxiliaryMaterials(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "1a2b3c4d-5e6f-4a7b-8c8d-9e0f1a2b3c4d"
+
+	testCases := []struct {
+		Name           string
+		Query          string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T) qmock.AllAuxiliaryMaterialsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "materials_found",
+			Query:      "?resourceType=TRAINING_MANUAL&taskNumber=2",
+			Authorized: course.MustNewAcademic("2b3c4d5e-6f7a-4b8c-9d9e-0f1a2b3c4d5e", course.StudentType),
+			PrepareHandler: func(t *testing.T) qmock.AllAuxiliaryMaterialsHandler {
+				return func(_ context.Context, qry app.AllAuxiliaryMaterialsQuery) ([]app.AuxiliaryMaterial, error) {
+					require.Equal(t, courseID, qry.CourseID)
+					require.Equal(t, course.TrainingManualResource, qry.ResourceType)
+					require.Equal(t, 2, qry.TaskNumber)
+
+					return []app.AuxiliaryMaterial{
+						{
+							ID:           "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f",
+							Resource:     "https://go.dev/doc/effective_go",
+							ResourceType: course.TrainingManualResource,
+							TaskNumber:   2,
+							ReleaseTime:  time.Date(2043, time.September, 15, 9, 0, 0, 0, time.UTC),
+							Hidden:       true,
+							Status:       course.HiddenMaterial,
+						},
+						{
+							ID:           "4e5f6a7b-8c9d-4e0f-9a1b-2c3d4e5f6a7b",
+							Resource:     "Go memory model.pdf",
+							ResourceType: course.TrainingManualResource,
+							File: &app.MaterialFile{
+								Key:         "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+								Name:        "Go memory model.pdf",
+								ContentType: "application/pdf",
+								Size:        524288,
+							},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[
+				{
+					"id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f",
+					"resource": "https://go.dev/doc/effective_go",
+					"resourceType": "TRAINING_MANUAL",
+					"taskNumber": 2,
+					"releaseTime": "2043-09-15T09:00:00Z",
+					"hidden": true,
+					"status": "HIDDEN"
+				},
+				{
+					"id": "4e5f6a7b-8c9d-4e0f-9a1b-2c3d4e5f6a7b",
+					"resource": "Go memory model.pdf",
+					"resourceType": "TRAINING_MANUAL",
+					"file": {"name": "Go memory model.pdf", "contentType": "application/pdf", "size": 524288}
+				}
+			]`,
+		},
+		{
+			Name:       "course_not_found",
+			Authorized: course.MustNewAcademic("4d5e6f7a-8b9c-4d0e-9f1a-2b3c4d5e6f7a", course.TeacherType),
+			PrepareHandler: func(t *testing.T) qmock.AllAuxiliaryMaterialsHandler {
+				return func(_ context.Context, qry app.AllAuxiliaryMaterialsQuery) ([]app.AuxiliaryMaterial, error) {
+					require.Zero(t, qry.ResourceType)
+
+					return nil, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					AllAuxiliaryMaterials: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/auxiliary-materials%s", courseID, c.Query),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_GetCourseAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"
+		materialID = "6f7a8b9c-0d1e-4f2a-9b3c-4d5e6f7a8b9c"
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T) qmock.SpecificAuxiliaryMaterialHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "material_found",
+			Authorized: course.MustNewAcademic("7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d", course.TeacherType),
+			PrepareHandler: func(t *testing.T) qmock.SpecificAuxiliaryMaterialHandler {
+				return func(_ context.Context, qry app.SpecificAuxiliaryMaterialQuery) (app.AuxiliaryMaterial, error) {
+					require.Equal(t, courseID, qry.CourseID)
+					require.Equal(t, materialID, qry.MaterialID)
+
+					return app.AuxiliaryMaterial{
+						ID:           materialID,
+						Resource:     "Solve problems 1-10 from the book",
+						ResourceType: course.OtherResource,
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"id": "6f7a8b9c-0d1e-4f2a-9b3c-4d5e6f7a8b9c",
+				"resource": "Solve problems 1-10 from the book",
+				"resourceType": "OTHER"
+			}`,
+		},
+		{
+			Name:       "material_not_found",
+			Authorized: course.MustNewAcademic("8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e", course.StudentType),
+			PrepareHandler: func(_ *testing.T) qmock.SpecificAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.SpecificAuxiliaryMaterialQuery) (app.AuxiliaryMaterial, error) {
+					return app.AuxiliaryMaterial{}, app.ErrAuxiliaryMaterialDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "auxiliary-material-not-found", "details": "auxiliary material doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					SpecificAuxiliaryMaterial: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_EditAuxiliaryMaterial(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "9c0d1e2f-3a4b-4c5d-8e6f-7a8b9c0d1e2f"
+		materialID = "0d1e2f3a-4b5c-4d6e-9f7a-8b9c0d1e2f3a"
+	)
+
+	testCases := []struct {
+		Name                 string
+		RequestBody          string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.EditAuxiliaryMaterialHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:        "material_edited",
+			RequestBody: `{"resource": "https://slides.com/lecture-2", "resourceType": "PRESENTATION"}`,
+			Authorized:  course.MustNewAcademic("1e2f3a4b-5c6d-4e7f-8a8b-9c0d1e2f3a4b", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.EditAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.EditAuxiliaryMaterialCommand) error {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, materialID, cmd.MaterialID)
+					require.Equal(t, "https://slides.com/lecture-2", cmd.Resource)
+					require.Equal(t, course.PresentationResource, cmd.ResourceType)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "material_not_found",
+			RequestBody: `{"resource": "Read chapter 4", "resourceType": "OTHER"}`,
+			Authorized:  course.MustNewAcademic("2f3a4b5c-6d7e-4f8a-9b0c-1d2e3f4a5b6c", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.EditAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.EditAuxiliaryMaterialCommand) error {
+					return course.ErrCourseHasNoSuchMaterial
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "auxiliary-material-not-found", "details": "course has no such auxiliary material"}`,
+		},
+		{
+			Name:        "invalid_resource_type",
+			RequestBody: `{"resource": "Read chapter 4", "resourceType": "PODCAST"}`,
+			Authorized:  course.MustNewAcademic("3a4b5c6d-7e8f-4a9b-8c1d-2e3f4a5b6c7d", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.EditAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.EditAuxiliaryMaterialCommand) error {
+					require.False(t, cmd.ResourceType.IsValid())
+
+					return course.ErrInvalidResourceType
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-auxiliary-material", "details": "invalid auxiliary material resource type"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					EditAuxiliaryMaterial: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPut, fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+				c.RequestBody, c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_RemoveAuxiliaryMaterialFromCourse(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "4b5c6d7e-8f9a-4b0c-9d2e-3f4a5b6c7d8e"
+		materialID = "5c6d7e8f-9a0b-4c1d-8e3f-4a5b6c7d8e9f"
+	)
+
+	testCases := []struct {
+		Name                 string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.RemoveAuxiliaryMaterialHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:       "material_removed",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-9f4a-5b6c7d8e9f0a", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.RemoveAuxiliaryMaterialHandler {
+				return func(_ context.Context, cmd app.RemoveAuxiliaryMaterialCommand) error {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, materialID, cmd.MaterialID)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "academic_cant_edit_course",
+			Authorized: course.MustNewAcademic("7e8f9a0b-1c2d-4e3f-8a5b-6c7d8e9f0a1b", course.StudentType),
+			PrepareHandler: func(_ *testing.T) cmock.RemoveAuxiliaryMaterialHandler {
+				return func(_ context.Context, _ app.RemoveAuxiliaryMaterialCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					RemoveAuxiliaryMaterial: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodDelete, fmt.Sprintf("/courses/%s/auxiliary-materials/%s", courseID, materialID),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_ReorderAuxiliaryMaterials(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8f9a0b1c-2d3e-4f4a-9b6c-7d8e9f0a1b2c"
+
+	testCases := []struct {
+		Name                 string
+		RequestBody          string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.ReorderAuxiliaryMaterialsHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name: "materials_reordered",
+			RequestBody: `{"materialIds": [
+				"9a0b1c2d-3e4f-4a5b-8c7d-8e9f0a1b2c3d",
+				"0b1c2d3e-4f5a-4b6c-9d8e-9f0a1b2c3d4e"
+			]}`,
+			Authorized: course.MustNewAcademic("1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.ReorderAuxiliaryMaterialsHandler {
+				return func(_ context.Context, cmd app.ReorderAuxiliaryMaterialsCommand) error {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, []string{
+						"9a0b1c2d-3e4f-4a5b-8c7d-8e9f0a1b2c3d",
+						"0b1c2d3e-4f5a-4b6c-9d8e-9f0a1b2c3d4e",
+					}, cmd.MaterialIDs)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "invalid_order",
+			RequestBody: `{"materialIds": ["9a0b1c2d-3e4f-4a5b-8c7d-8e9f0a1b2c3d"]}`,
+			Authorized:  course.MustNewAcademic("1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.ReorderAuxiliaryMaterialsHandler {
+				return func(_ context.Context, _ app.ReorderAuxiliaryMaterialsCommand) error {
+					return course.ErrInvalidMaterialsOrder
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody: `{
+				"slug": "invalid-auxiliary-materials-order",
+				"details": "materials order should contain every material of course exactly once"
+			}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"materialIds": []}`,
+			Authorized:  course.MustNewAcademic("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a", course.StudentType),
+			PrepareHandler: func(_ *testing.T) cmock.ReorderAuxiliaryMaterialsHandler {
+				return func(_ context.Context, _ app.ReorderAuxiliaryMaterialsCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					ReorderAuxiliaryMaterials: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPut, fmt.Sprintf("/courses/%s/auxiliary-materials/order", courseID),
+				c.RequestBody, c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
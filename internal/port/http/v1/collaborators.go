@@ -10,8 +10,29 @@ import (
 	"github.com/authena-ru/courses-organization/pkg/httperr"
 )
 
-func (h handler) GetAllCourseCollaborators(w http.ResponseWriter, _ *http.Request, _ string) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (h handler) GetAllCourseCollaborators(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetAllCourseCollaboratorsParams,
+) {
+	qry, ok := unmarshalAllCourseCollaboratorsQuery(w, r, courseID, params)
+	if !ok {
+		return
+	}
+
+	page, err := h.app.Queries.AllCourseCollaborators.Handle(r.Context(), qry)
+	if err == nil {
+		marshalCollaboratorsPage(w, r, page)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
 }
 
 func (h handler) AddCollaboratorToCourse(w http.ResponseWriter, r *http.Request, courseID string) {
@@ -84,3 +105,46 @@ func (h handler) RemoveCollaboratorFromCourse(
 
 	httperr.InternalServerError("unexpected-error", err, w, r)
 }
+
+func (h handler) ChangeCollaboratorRole(
+	w http.ResponseWriter, r *http.Request,
+	courseID, teacherID string,
+) {
+	cmd, ok := unmarshalChangeCollaboratorRoleCommand(w, r, courseID, teacherID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.ChangeRole.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchCollaborator) {
+		httperr.NotFound("course-collaborator-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrInvalidCollaboratorRole) {
+		httperr.UnprocessableEntity("invalid-collaborator-role", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
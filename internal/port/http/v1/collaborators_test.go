@@ -12,9 +12,124 @@ import (
 
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
 )
 
+func TestHandler_GetAllCourseCollaborators(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8f7e6d5c-4b3a-4291-8f7e-6d5c4b3a2910"
+
+	testCases := []struct {
+		Name           string
+		Target         string
+		Authorized     course.Academic
+		Query          app.AllCourseCollaboratorsQuery
+		PrepareHandler func(
+			t *testing.T,
+			expectedQuery app.AllCourseCollaboratorsQuery,
+		) qmock.AllCourseCollaboratorsHandler
+		StatusCode   int
+		ResponseBody string
+	}{
+		{
+			Name:       "obtain_collaborators",
+			Target:     fmt.Sprintf("/courses/%s/collaborators?perPage=2", courseID),
+			Authorized: course.MustNewAcademic("2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d", course.TeacherType),
+			Query: app.AllCourseCollaboratorsQuery{
+				Academic:   course.MustNewAcademic("2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d", course.TeacherType),
+				CourseID:   courseID,
+				Pagination: app.Pagination{Page: 1, PerPage: 2},
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.AllCourseCollaboratorsQuery,
+			) qmock.AllCourseCollaboratorsHandler {
+				return func(_ context.Context, givenQuery app.AllCourseCollaboratorsQuery) (app.AcademicsPage, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.AcademicsPage{
+						Academics: []app.AcademicProfile{
+							{
+								ID:       "4edefb83-4b6b-479d-9ce2-60cd465630b6",
+								FullName: "Anna Smirnova",
+								Role:     course.CoTeacherRole,
+							},
+							{
+								ID:       "d3e2490f-5944-4a87-b29a-94177d1caaed",
+								FullName: "Ivan Petrov",
+								Role:     course.AssistantRole,
+							},
+						},
+						Total: 3,
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[
+				{"id": "4edefb83-4b6b-479d-9ce2-60cd465630b6", "fullName": "Anna Smirnova", "role": "CO_TEACHER"},
+				{"id": "d3e2490f-5944-4a87-b29a-94177d1caaed", "fullName": "Ivan Petrov", "role": "ASSISTANT"}
+			]`,
+		},
+		{
+			Name:       "invalid_pagination",
+			Target:     fmt.Sprintf("/courses/%s/collaborators?page=0", courseID),
+			Authorized: course.MustNewAcademic("5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a", course.TeacherType),
+			PrepareHandler: func(
+				_ *testing.T,
+				_ app.AllCourseCollaboratorsQuery,
+			) qmock.AllCourseCollaboratorsHandler {
+				return func(_ context.Context, _ app.AllCourseCollaboratorsQuery) (app.AcademicsPage, error) {
+					return app.AcademicsPage{}, errors.New("query shouldn't be handled")
+				}
+			},
+			StatusCode: http.StatusBadRequest,
+			ResponseBody: `{
+				"slug": "invalid-pagination",
+				"details": "page should be positive and perPage should be from 1 to 100"
+			}`,
+		},
+		{
+			Name:       "course_not_found",
+			Target:     fmt.Sprintf("/courses/%s/collaborators", courseID),
+			Authorized: course.MustNewAcademic("7b8c9d0e-1f2a-4b3c-8d4e-5f6a7b8c9d0e", course.TeacherType),
+			PrepareHandler: func(
+				_ *testing.T,
+				_ app.AllCourseCollaboratorsQuery,
+			) qmock.AllCourseCollaboratorsHandler {
+				return func(_ context.Context, _ app.AllCourseCollaboratorsQuery) (app.AcademicsPage, error) {
+					return app.AcademicsPage{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					AllCourseCollaborators: c.PrepareHandler(t, c.Query),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, c.Target, "", c.Authorized)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
 func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 	t.Parallel()
 
@@ -35,9 +150,31 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("1009d0ed-600f-4bd1-96fa-8ccaedb4e7d7", course.TeacherType),
 			CourseID:    "ecea7dcc-a1d9-48cc-8526-0d4b58bc298b",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "ecea7dcc-a1d9-48cc-8526-0d4b58bc298b",
-				Academic:       course.MustNewAcademic("1009d0ed-600f-4bd1-96fa-8ccaedb4e7d7", course.TeacherType),
-				CollaboratorID: "199cf094-0b92-455a-9da3-f353f4bf9ed3",
+				CourseID:        "ecea7dcc-a1d9-48cc-8526-0d4b58bc298b",
+				Academic:        course.MustNewAcademic("1009d0ed-600f-4bd1-96fa-8ccaedb4e7d7", course.TeacherType),
+				CollaboratorIDs: []string{"199cf094-0b92-455a-9da3-f353f4bf9ed3"},
+			},
+			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
+				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "several_collaborators_added_to_course",
+			RequestBody: `{"ids": ["199cf094-0b92-455a-9da3-f353f4bf9ed3", "5e4a1c2b-8d7f-4e69-b3a0-7c2d9f1e6b48"]}`,
+			Authorized:  course.MustNewAcademic("1009d0ed-600f-4bd1-96fa-8ccaedb4e7d7", course.TeacherType),
+			CourseID:    "ecea7dcc-a1d9-48cc-8526-0d4b58bc298b",
+			Command: app.AddCollaboratorCommand{
+				CourseID: "ecea7dcc-a1d9-48cc-8526-0d4b58bc298b",
+				Academic: course.MustNewAcademic("1009d0ed-600f-4bd1-96fa-8ccaedb4e7d7", course.TeacherType),
+				CollaboratorIDs: []string{
+					"199cf094-0b92-455a-9da3-f353f4bf9ed3",
+					"5e4a1c2b-8d7f-4e69-b3a0-7c2d9f1e6b48",
+				},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -54,9 +191,9 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("1ccb6e85-80ed-4f4d-aa76-5910ad054820", course.TeacherType),
 			CourseID:    "556a7670-e0cc-4867-af4d-b3d142bc0f56",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "556a7670-e0cc-4867-af4d-b3d142bc0f56",
-				Academic:       course.MustNewAcademic("1ccb6e85-80ed-4f4d-aa76-5910ad054820", course.TeacherType),
-				CollaboratorID: "",
+				CourseID:        "556a7670-e0cc-4867-af4d-b3d142bc0f56",
+				Academic:        course.MustNewAcademic("1ccb6e85-80ed-4f4d-aa76-5910ad054820", course.TeacherType),
+				CollaboratorIDs: []string{""},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -78,9 +215,9 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("69d13ada-be30-4c99-a93c-08cf1bce7eb8", course.TeacherType),
 			CourseID:    "b59cc92a-574d-4065-87d1-955709b6964d",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "b59cc92a-574d-4065-87d1-955709b6964d",
-				Academic:       course.MustNewAcademic("69d13ada-be30-4c99-a93c-08cf1bce7eb8", course.TeacherType),
-				CollaboratorID: "6db33767-f116-4499-89b2-3ef26fe842e3",
+				CourseID:        "b59cc92a-574d-4065-87d1-955709b6964d",
+				Academic:        course.MustNewAcademic("69d13ada-be30-4c99-a93c-08cf1bce7eb8", course.TeacherType),
+				CollaboratorIDs: []string{"6db33767-f116-4499-89b2-3ef26fe842e3"},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -99,9 +236,9 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("aac3880c-46f2-44c9-9d2f-06e016124e48", course.TeacherType),
 			CourseID:    "28104db1-8476-4279-830d-c49a6643a4b5",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "28104db1-8476-4279-830d-c49a6643a4b5",
-				Academic:       course.MustNewAcademic("aac3880c-46f2-44c9-9d2f-06e016124e48", course.TeacherType),
-				CollaboratorID: "d825c9e0-abca-48f2-90a9-c53ef9636bde",
+				CourseID:        "28104db1-8476-4279-830d-c49a6643a4b5",
+				Academic:        course.MustNewAcademic("aac3880c-46f2-44c9-9d2f-06e016124e48", course.TeacherType),
+				CollaboratorIDs: []string{"d825c9e0-abca-48f2-90a9-c53ef9636bde"},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -120,9 +257,9 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("d5cc070d-c562-4bcb-a6e8-e2af858d4a68", course.TeacherType),
 			CourseID:    "b55d1633-f6d5-40a0-9bce-7a79f86518e5",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "b55d1633-f6d5-40a0-9bce-7a79f86518e5",
-				Academic:       course.MustNewAcademic("d5cc070d-c562-4bcb-a6e8-e2af858d4a68", course.TeacherType),
-				CollaboratorID: "22c381b2-d2d7-487b-b4f8-1f6b78f9cb92",
+				CourseID:        "b55d1633-f6d5-40a0-9bce-7a79f86518e5",
+				Academic:        course.MustNewAcademic("d5cc070d-c562-4bcb-a6e8-e2af858d4a68", course.TeacherType),
+				CollaboratorIDs: []string{"22c381b2-d2d7-487b-b4f8-1f6b78f9cb92"},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -141,9 +278,9 @@ func TestHandler_AddCollaboratorToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("60cddd22-8718-4d18-921b-8935f85ed7b8", course.TeacherType),
 			CourseID:    "914c9a37-504c-496b-9715-f0ff2c8917ab",
 			Command: app.AddCollaboratorCommand{
-				CourseID:       "914c9a37-504c-496b-9715-f0ff2c8917ab",
-				Academic:       course.MustNewAcademic("60cddd22-8718-4d18-921b-8935f85ed7b8", course.TeacherType),
-				CollaboratorID: "8b41b8a4-4821-4029-87fc-a79ae0713cd5",
+				CourseID:        "914c9a37-504c-496b-9715-f0ff2c8917ab",
+				Academic:        course.MustNewAcademic("60cddd22-8718-4d18-921b-8935f85ed7b8", course.TeacherType),
+				CollaboratorIDs: []string{"8b41b8a4-4821-4029-87fc-a79ae0713cd5"},
 			},
 			PrepareHandler: func(expectedCommand app.AddCollaboratorCommand) mock.AddCollaboratorsHandler {
 				return func(_ context.Context, givenCommand app.AddCollaboratorCommand) error {
@@ -336,3 +473,97 @@ func TestHandler_RemoveCollaboratorFromCourse(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_ChangeCollaboratorRole(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID       = "d3d3392f-547e-41ff-800d-2851b8701247"
+		collaboratorID = "e1f995ec-afed-4b27-a1d7-0fb74f04b363"
+	)
+
+	creator := course.MustNewAcademic("7bae715a-f7a0-467b-87af-5b3843453b10", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		PrepareHandler func(t *testing.T) mock.ChangeCollaboratorRoleHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "role_changed",
+			RequestBody: `{"role": "OBSERVER"}`,
+			PrepareHandler: func(t *testing.T) mock.ChangeCollaboratorRoleHandler {
+				return func(_ context.Context, cmd app.ChangeCollaboratorRoleCommand) error {
+					require.Equal(t, app.ChangeCollaboratorRoleCommand{
+						Academic:       creator,
+						CourseID:       courseID,
+						CollaboratorID: collaboratorID,
+						Role:           course.ObserverRole,
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "invalid_role",
+			RequestBody: `{"role": "CREATOR"}`,
+			PrepareHandler: func(_ *testing.T) mock.ChangeCollaboratorRoleHandler {
+				return func(_ context.Context, _ app.ChangeCollaboratorRoleCommand) error {
+					return errors.New("command shouldn't be handled")
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-collaborator-role", "details": ""}`,
+		},
+		{
+			Name:        "course_collaborator_not_found",
+			RequestBody: `{"role": "ASSISTANT"}`,
+			PrepareHandler: func(_ *testing.T) mock.ChangeCollaboratorRoleHandler {
+				return func(_ context.Context, _ app.ChangeCollaboratorRoleCommand) error {
+					return course.ErrCourseHasNoSuchCollaborator
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-collaborator-not-found", "details": "course has no such collaborator"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"role": "ASSISTANT"}`,
+			PrepareHandler: func(_ *testing.T) mock.ChangeCollaboratorRoleHandler {
+				return func(_ context.Context, _ app.ChangeCollaboratorRoleCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:   http.StatusForbidden,
+			ResponseBody: `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{ChangeRole: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/collaborators/%s/role", courseID, collaboratorID)
+			r := newHTTPRequest(t, http.MethodPut, target, c.RequestBody, creator)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
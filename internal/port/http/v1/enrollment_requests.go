@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) GetEnrollmentRequests(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetEnrollmentRequestsParams,
+) {
+	qry, ok := unmarshalEnrollmentRequestsQuery(w, r, courseID, params)
+	if !ok {
+		return
+	}
+
+	requests, err := h.app.Queries.EnrollmentRequests.Handle(r.Context(), qry)
+	if err == nil {
+		marshalEnrollmentRequests(w, r, requests)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) RequestEnrollment(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalRequestEnrollmentCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	requestID, err := h.app.Commands.RequestEnrollment.Handle(r.Context(), cmd)
+	if err == nil {
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, RequestEnrollmentResponse{Id: requestID})
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrNotStudentCantRequestEnrollment) {
+		httperr.Forbidden("not-student-cant-request-enrollment", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidEnrollmentRequestError(err) {
+		httperr.UnprocessableEntity("invalid-enrollment-request-parameters", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrEnrollmentAlreadyRequested) {
+		httperr.UnprocessableEntity("enrollment-already-requested", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrAcademicAlreadyInCourse) {
+		httperr.UnprocessableEntity("student-already-in-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) ApproveEnrollmentRequests(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalApproveEnrollmentRequestsCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.ApproveEnrollment.Handle(r.Context(), cmd)
+	respondEnrollmentRequestsReviewed(w, r, err)
+}
+
+func (h handler) RejectEnrollmentRequests(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalRejectEnrollmentRequestsCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RejectEnrollment.Handle(r.Context(), cmd)
+	respondEnrollmentRequestsReviewed(w, r, err)
+}
+
+func respondEnrollmentRequestsReviewed(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchEnrollmentRequest) {
+		httperr.NotFound("course-enrollment-request-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrEnrollmentRequestAlreadyReviewed) {
+		httperr.UnprocessableEntity("enrollment-request-already-reviewed", err, w, r)
+
+		return
+	}
+
+	if course.IsCourseIsFullError(err) {
+		httperr.UnprocessableEntity("course-is-full", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrStudentAlreadyWaitlisted) {
+		httperr.UnprocessableEntity("student-already-waitlisted", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
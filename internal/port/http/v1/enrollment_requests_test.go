@@ -0,0 +1,313 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_GetEnrollmentRequests(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	teacher := course.MustNewAcademic("2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		Query          string
+		PrepareHandler func(t *testing.T) qmock.EnrollmentRequestsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "obtain_all_requests",
+			PrepareHandler: func(t *testing.T) qmock.EnrollmentRequestsHandler {
+				return func(_ context.Context, qry app.EnrollmentRequestsQuery) ([]app.EnrollmentRequest, error) {
+					require.Equal(t, app.EnrollmentRequestsQuery{Academic: teacher, CourseID: courseID}, qry)
+
+					return []app.EnrollmentRequest{
+						{
+							ID:         "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+							StudentID:  "d4c3b2a1-f6e5-4b7a-9d8c-5c4b3a2f1e0d",
+							Status:     course.ApprovedRequest,
+							CreatedAt:  time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+							ReviewerID: teacher.ID(),
+							ReviewedAt: time.Date(2025, time.March, 2, 12, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:        "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e",
+							StudentID: "e5d4c3b2-a1f6-4c8b-0e9d-6d5c4b3a2f1e",
+							Message:   "I'm from group 3",
+							Status:    course.PendingRequest,
+							CreatedAt: time.Date(2025, time.March, 3, 12, 0, 0, 0, time.UTC),
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[
+				{
+					"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+					"studentId": "d4c3b2a1-f6e5-4b7a-9d8c-5c4b3a2f1e0d",
+					"status": "APPROVED",
+					"createdAt": "2025-03-01T12:00:00Z",
+					"reviewerId": "2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f",
+					"reviewedAt": "2025-03-02T12:00:00Z"
+				},
+				{
+					"id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e",
+					"studentId": "e5d4c3b2-a1f6-4c8b-0e9d-6d5c4b3a2f1e",
+					"message": "I'm from group 3",
+					"status": "PENDING",
+					"createdAt": "2025-03-03T12:00:00Z"
+				}
+			]`,
+		},
+		{
+			Name:  "obtain_pending_requests",
+			Query: "?status=PENDING",
+			PrepareHandler: func(t *testing.T) qmock.EnrollmentRequestsHandler {
+				return func(_ context.Context, qry app.EnrollmentRequestsQuery) ([]app.EnrollmentRequest, error) {
+					require.Equal(t, course.PendingRequest, qry.Status)
+
+					return nil, nil
+				}
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `[]`,
+		},
+		{
+			Name: "course_not_found",
+			PrepareHandler: func(_ *testing.T) qmock.EnrollmentRequestsHandler {
+				return func(_ context.Context, _ app.EnrollmentRequestsQuery) ([]app.EnrollmentRequest, error) {
+					return nil, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{EnrollmentRequests: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/enrollment-requests%s", courseID, c.Query)
+			r := newHTTPRequest(t, http.MethodGet, target, "", teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_RequestEnrollment(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	student := course.MustNewAcademic("d4c3b2a1-f6e5-4b7a-9d8c-5c4b3a2f1e0d", course.StudentType)
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		PrepareHandler func(t *testing.T) mock.RequestEnrollmentHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "enrollment_requested",
+			RequestBody: `{"message": "I'm from group 3"}`,
+			PrepareHandler: func(t *testing.T) mock.RequestEnrollmentHandler {
+				return func(_ context.Context, cmd app.RequestEnrollmentCommand) (string, error) {
+					require.Equal(t, app.RequestEnrollmentCommand{
+						Academic: student,
+						CourseID: courseID,
+						Message:  "I'm from group 3",
+					}, cmd)
+
+					return "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", nil
+				}
+			},
+			StatusCode:   http.StatusCreated,
+			ResponseBody: `{"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"}`,
+		},
+		{
+			Name:        "not_student",
+			RequestBody: `{}`,
+			PrepareHandler: func(_ *testing.T) mock.RequestEnrollmentHandler {
+				return func(_ context.Context, _ app.RequestEnrollmentCommand) (string, error) {
+					return "", course.ErrNotStudentCantRequestEnrollment
+				}
+			},
+			StatusCode: http.StatusForbidden,
+			ResponseBody: `{
+				"slug": "not-student-cant-request-enrollment",
+				"details": "not student can't request enrollment"
+			}`,
+		},
+		{
+			Name:        "already_requested",
+			RequestBody: `{}`,
+			PrepareHandler: func(_ *testing.T) mock.RequestEnrollmentHandler {
+				return func(_ context.Context, _ app.RequestEnrollmentCommand) (string, error) {
+					return "", course.ErrEnrollmentAlreadyRequested
+				}
+			},
+			StatusCode: http.StatusUnprocessableEntity,
+			ResponseBody: `{
+				"slug": "enrollment-already-requested",
+				"details": "student already has pending enrollment request"
+			}`,
+		},
+		{
+			Name:        "student_already_in_course",
+			RequestBody: `{}`,
+			PrepareHandler: func(_ *testing.T) mock.RequestEnrollmentHandler {
+				return func(_ context.Context, _ app.RequestEnrollmentCommand) (string, error) {
+					return "", course.ErrAcademicAlreadyInCourse
+				}
+			},
+			StatusCode: http.StatusUnprocessableEntity,
+			ResponseBody: `{
+				"slug": "student-already-in-course",
+				"details": "academic already participates in course"
+			}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{RequestEnrollment: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/enrollment-requests", courseID)
+			r := newHTTPRequest(t, http.MethodPost, target, c.RequestBody, student)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_ApproveEnrollmentRequests(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	teacher := course.MustNewAcademic("2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f", course.TeacherType)
+	requestIDs := []string{"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e"}
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) mock.ApproveEnrollmentRequestsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "requests_approved",
+			PrepareHandler: func(t *testing.T) mock.ApproveEnrollmentRequestsHandler {
+				return func(_ context.Context, cmd app.ApproveEnrollmentRequestsCommand) error {
+					require.Equal(t, app.ApproveEnrollmentRequestsCommand{
+						Academic:   teacher,
+						CourseID:   courseID,
+						RequestIDs: requestIDs,
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name: "request_not_found",
+			PrepareHandler: func(_ *testing.T) mock.ApproveEnrollmentRequestsHandler {
+				return func(_ context.Context, _ app.ApproveEnrollmentRequestsCommand) error {
+					return errors.Wrap(course.ErrCourseHasNoSuchEnrollmentRequest, "request #unknown")
+				}
+			},
+			StatusCode: http.StatusNotFound,
+			ResponseBody: `{
+				"slug": "course-enrollment-request-not-found",
+				"details": "request #unknown: course has no such enrollment request"
+			}`,
+		},
+		{
+			Name: "request_already_reviewed",
+			PrepareHandler: func(_ *testing.T) mock.ApproveEnrollmentRequestsHandler {
+				return func(_ context.Context, _ app.ApproveEnrollmentRequestsCommand) error {
+					return course.ErrEnrollmentRequestAlreadyReviewed
+				}
+			},
+			StatusCode: http.StatusUnprocessableEntity,
+			ResponseBody: `{
+				"slug": "enrollment-request-already-reviewed",
+				"details": "enrollment request is already reviewed"
+			}`,
+		},
+		{
+			Name: "academic_cant_edit_course",
+			PrepareHandler: func(_ *testing.T) mock.ApproveEnrollmentRequestsHandler {
+				return func(_ context.Context, _ app.ApproveEnrollmentRequestsCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:   http.StatusForbidden,
+			ResponseBody: `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{ApproveEnrollment: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/enrollment-requests/approved", courseID)
+			body := fmt.Sprintf(`{"ids": ["%s", "%s"]}`, requestIDs[0], requestIDs[1])
+			r := newHTTPRequest(t, http.MethodPost, target, body, teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
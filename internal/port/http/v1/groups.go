@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) AddGroupToCourse(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalAddGroupCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.AddGroup.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondGroupCommandError(err, w, r)
+}
+
+func (h handler) RemoveGroupFromCourse(w http.ResponseWriter, r *http.Request, courseID, groupID string) {
+	cmd, ok := unmarshalRemoveGroupCommand(w, r, courseID, groupID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RemoveGroup.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondGroupCommandError(err, w, r)
+}
+
+func (h handler) SyncCourseGroup(w http.ResponseWriter, r *http.Request, courseID, groupID string) {
+	cmd, ok := unmarshalSyncGroupCommand(w, r, courseID, groupID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.SyncGroup.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondGroupCommandError(err, w, r)
+}
+
+func respondGroupCommandError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchGroup) {
+		httperr.NotFound("course-group-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrGroupDoesntExist) {
+		httperr.UnprocessableEntity("group-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsCourseIsFullError(err) {
+		httperr.UnprocessableEntity("course-is-full", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
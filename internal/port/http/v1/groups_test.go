@@ -0,0 +1,285 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_AddGroupToCourse(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		Authorized     course.Academic
+		Command        app.AddGroupCommand
+		PrepareHandler func(t *testing.T, expectedCommand app.AddGroupCommand) mock.AddGroupHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "group_added_to_course",
+			RequestBody: `{"id": "95dca190-f307-4954-8700-f992f8c12a86"}`,
+			Authorized:  course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			Command: app.AddGroupCommand{
+				Academic: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+				CourseID: courseID,
+				GroupID:  "95dca190-f307-4954-8700-f992f8c12a86",
+			},
+			PrepareHandler: func(t *testing.T, expectedCommand app.AddGroupCommand) mock.AddGroupHandler {
+				return func(_ context.Context, givenCommand app.AddGroupCommand) error {
+					require.Equalf(t, expectedCommand, givenCommand, "commands are not equal")
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "bad_request",
+			RequestBody: `{"id": 1}`,
+			Authorized:  course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ app.AddGroupCommand) mock.AddGroupHandler {
+				return func(_ context.Context, _ app.AddGroupCommand) error {
+					return errors.New("command shouldn't be handled")
+				}
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:        "group_not_found",
+			RequestBody: `{"id": "95dca190-f307-4954-8700-f992f8c12a86"}`,
+			Authorized:  course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ app.AddGroupCommand) mock.AddGroupHandler {
+				return func(_ context.Context, _ app.AddGroupCommand) error {
+					return app.ErrGroupDoesntExist
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "group-not-found", "details": "group doesn't exist"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"id": "95dca190-f307-4954-8700-f992f8c12a86"}`,
+			Authorized:  course.MustNewAcademic("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", course.StudentType),
+			PrepareHandler: func(_ *testing.T, _ app.AddGroupCommand) mock.AddGroupHandler {
+				return func(_ context.Context, _ app.AddGroupCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:   http.StatusForbidden,
+			ResponseBody: `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					AddGroup: c.PrepareHandler(t, c.Command),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPut, fmt.Sprintf("/courses/%s/groups", courseID),
+				c.RequestBody, c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_RemoveGroupFromCourse(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+		groupID  = "95dca190-f307-4954-8700-f992f8c12a86"
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T, authorized course.Academic) mock.RemoveGroupHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "group_removed_from_course",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(t *testing.T, authorized course.Academic) mock.RemoveGroupHandler {
+				return func(_ context.Context, cmd app.RemoveGroupCommand) error {
+					require.Equal(t, app.RemoveGroupCommand{
+						Academic: authorized,
+						CourseID: courseID,
+						GroupID:  groupID,
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "course_group_not_found",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ course.Academic) mock.RemoveGroupHandler {
+				return func(_ context.Context, _ app.RemoveGroupCommand) error {
+					return course.ErrCourseHasNoSuchGroup
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-group-not-found", "details": "course has no such group"}`,
+		},
+		{
+			Name:       "course_not_found",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ course.Academic) mock.RemoveGroupHandler {
+				return func(_ context.Context, _ app.RemoveGroupCommand) error {
+					return app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					RemoveGroup: c.PrepareHandler(t, c.Authorized),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodDelete, fmt.Sprintf("/courses/%s/groups/%s", courseID, groupID),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_SyncCourseGroup(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+		groupID  = "95dca190-f307-4954-8700-f992f8c12a86"
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T, authorized course.Academic) mock.SyncGroupHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "group_synced",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(t *testing.T, authorized course.Academic) mock.SyncGroupHandler {
+				return func(_ context.Context, cmd app.SyncGroupCommand) error {
+					require.Equal(t, app.SyncGroupCommand{
+						Academic: authorized,
+						CourseID: courseID,
+						GroupID:  groupID,
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "group_not_found",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ course.Academic) mock.SyncGroupHandler {
+				return func(_ context.Context, _ app.SyncGroupCommand) error {
+					return app.ErrGroupDoesntExist
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "group-not-found", "details": "group doesn't exist"}`,
+		},
+		{
+			Name:       "course_group_not_found",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-8f3a-4b5c6d7e8f9a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ course.Academic) mock.SyncGroupHandler {
+				return func(_ context.Context, _ app.SyncGroupCommand) error {
+					return course.ErrCourseHasNoSuchGroup
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-group-not-found", "details": "course has no such group"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					SyncGroup: c.PrepareHandler(t, c.Authorized),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/groups/%s/synced", courseID, groupID),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
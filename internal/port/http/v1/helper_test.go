@@ -51,7 +51,11 @@ func requireAddTaskCommandsEquals(t *testing.T, expectedCommand, givenCommand ap
 	require.Equalf(t, expectedCommand.TaskTitle, givenCommand.TaskTitle, "task titles are not equal")
 	require.Equalf(t, expectedCommand.TaskDescription, givenCommand.TaskDescription, "task descriptions are not equal")
 	require.Equalf(t, expectedCommand.TaskType, givenCommand.TaskType, "task types are not equal")
+	require.Equalf(t, expectedCommand.TeamWork, givenCommand.TeamWork, "team work flags are not equal")
 	require.ElementsMatchf(t, expectedCommand.TestData, givenCommand.TestData, "test data are not equal")
+	require.Equalf(t, expectedCommand.Checker, givenCommand.Checker, "checkers are not equal")
+	require.Equalf(t, expectedCommand.Languages, givenCommand.Languages, "languages are not equal")
+	require.Equalf(t, expectedCommand.Limits, givenCommand.Limits, "execution limits are not equal")
 	require.ElementsMatchf(t, expectedCommand.TestPoints, givenCommand.TestPoints, "test points are not equal")
 	requireDatesEquals(t, expectedCommand.Deadline.GoodGradeTime(), givenCommand.Deadline.GoodGradeTime())
 	requireDatesEquals(t, expectedCommand.Deadline.ExcellentGradeTime(), givenCommand.Deadline.ExcellentGradeTime())
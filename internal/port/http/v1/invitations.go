@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) GetAllInvitations(w http.ResponseWriter, r *http.Request, courseID string) {
+	qry, ok := unmarshalAllInvitationsQuery(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	invitations, err := h.app.Queries.AllInvitations.Handle(r.Context(), qry)
+	if err == nil {
+		marshalInvitations(w, r, invitations)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) CreateInvitation(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalCreateInvitationCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	code, err := h.app.Commands.CreateInvitation.Handle(r.Context(), cmd)
+	if err == nil {
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, CreateInvitationResponse{Code: code})
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidInvitationError(err) {
+		httperr.UnprocessableEntity("invalid-invitation-parameters", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) RevokeInvitation(w http.ResponseWriter, r *http.Request, courseID, code string) {
+	cmd, ok := unmarshalRevokeInvitationCommand(w, r, courseID, code)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RevokeInvitation.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchInvitation) {
+		httperr.NotFound("course-invitation-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) RedeemInvitation(w http.ResponseWriter, r *http.Request) {
+	cmd, ok := unmarshalRedeemInvitationCommand(w, r)
+	if !ok {
+		return
+	}
+
+	redeemed, err := h.app.Commands.RedeemInvitation.Handle(r.Context(), cmd)
+	if err == nil {
+		w.Header().Set("Content-Location", fmt.Sprintf("/courses/%s", redeemed.CourseID))
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, RedeemInvitationResponse{CourseId: redeemed.CourseID, Waitlisted: redeemed.Waitlisted})
+
+		return
+	}
+
+	if errors.Is(err, app.ErrInvitationDoesntExist) {
+		httperr.NotFound("invitation-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsInvitationNotRedeemableError(err) {
+		httperr.UnprocessableEntity("invitation-not-redeemable", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrStudentAlreadyWaitlisted) {
+		httperr.UnprocessableEntity("student-already-waitlisted", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
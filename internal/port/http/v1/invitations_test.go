@@ -0,0 +1,363 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_GetAllInvitations(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	teacher := course.MustNewAcademic("2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) qmock.AllInvitationsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "obtain_invitations",
+			PrepareHandler: func(t *testing.T) qmock.AllInvitationsHandler {
+				return func(_ context.Context, qry app.AllInvitationsQuery) ([]app.Invitation, error) {
+					require.Equal(t, app.AllInvitationsQuery{Academic: teacher, CourseID: courseID}, qry)
+
+					return []app.Invitation{
+						{
+							Code:      "ZK3QW7RT2M5XN4PA",
+							Role:      course.StudentType,
+							ExpiresAt: time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+							MaxUses:   30,
+							Uses:      12,
+						},
+						{
+							Code:      "M5XN4PAZK3QW7RT2",
+							Role:      course.TeacherType,
+							ExpiresAt: time.Date(2025, time.April, 1, 12, 0, 0, 0, time.UTC),
+							MaxUses:   1,
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[
+				{
+					"code": "ZK3QW7RT2M5XN4PA",
+					"role": "STUDENT",
+					"expiresAt": "2025-03-01T12:00:00Z",
+					"maxUses": 30,
+					"uses": 12
+				},
+				{
+					"code": "M5XN4PAZK3QW7RT2",
+					"role": "COLLABORATOR",
+					"expiresAt": "2025-04-01T12:00:00Z",
+					"maxUses": 1,
+					"uses": 0
+				}
+			]`,
+		},
+		{
+			Name: "course_not_found",
+			PrepareHandler: func(_ *testing.T) qmock.AllInvitationsHandler {
+				return func(_ context.Context, _ app.AllInvitationsQuery) ([]app.Invitation, error) {
+					return nil, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{AllInvitations: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, fmt.Sprintf("/courses/%s/invitations", courseID), "", teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_CreateInvitation(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	teacher := course.MustNewAcademic("2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		Command        app.CreateInvitationCommand
+		PrepareHandler func(t *testing.T, expectedCommand app.CreateInvitationCommand) mock.CreateInvitationHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "invitation_created",
+			RequestBody: `{"role": "COLLABORATOR", "expiresAt": "2025-03-01T12:00:00Z", "maxUses": 2}`,
+			Command: app.CreateInvitationCommand{
+				Academic:  teacher,
+				CourseID:  courseID,
+				Role:      course.TeacherType,
+				ExpiresAt: time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+				MaxUses:   2,
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedCommand app.CreateInvitationCommand,
+			) mock.CreateInvitationHandler {
+				return func(_ context.Context, givenCommand app.CreateInvitationCommand) (string, error) {
+					require.Equalf(t, expectedCommand, givenCommand, "commands are not equal")
+
+					return "ZK3QW7RT2M5XN4PA", nil
+				}
+			},
+			StatusCode:   http.StatusCreated,
+			ResponseBody: `{"code": "ZK3QW7RT2M5XN4PA"}`,
+		},
+		{
+			Name:        "invalid_role",
+			RequestBody: `{"role": "CREATOR", "expiresAt": "2025-03-01T12:00:00Z", "maxUses": 2}`,
+			PrepareHandler: func(_ *testing.T, _ app.CreateInvitationCommand) mock.CreateInvitationHandler {
+				return func(_ context.Context, _ app.CreateInvitationCommand) (string, error) {
+					return "", errors.New("command shouldn't be handled")
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-invitation-role", "details": ""}`,
+		},
+		{
+			Name:        "invalid_invitation_parameters",
+			RequestBody: `{"role": "STUDENT", "expiresAt": "2020-03-01T12:00:00Z", "maxUses": 30}`,
+			PrepareHandler: func(_ *testing.T, _ app.CreateInvitationCommand) mock.CreateInvitationHandler {
+				return func(_ context.Context, _ app.CreateInvitationCommand) (string, error) {
+					return "", course.ErrInvitationExpired
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-invitation-parameters", "details": "invitation expired"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"role": "STUDENT", "expiresAt": "2025-03-01T12:00:00Z", "maxUses": 30}`,
+			PrepareHandler: func(_ *testing.T, _ app.CreateInvitationCommand) mock.CreateInvitationHandler {
+				return func(_ context.Context, _ app.CreateInvitationCommand) (string, error) {
+					return "", course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:   http.StatusForbidden,
+			ResponseBody: `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{CreateInvitation: c.PrepareHandler(t, c.Command)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/invitations", courseID)
+			r := newHTTPRequest(t, http.MethodPost, target, c.RequestBody, teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_RevokeInvitation(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	teacher := course.MustNewAcademic("2f1e0d9c-8b7a-4c6d-9e5f-4a3b2c1d0e9f", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) mock.RevokeInvitationHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "invitation_revoked",
+			PrepareHandler: func(t *testing.T) mock.RevokeInvitationHandler {
+				return func(_ context.Context, cmd app.RevokeInvitationCommand) error {
+					require.Equal(t, app.RevokeInvitationCommand{
+						Academic: teacher,
+						CourseID: courseID,
+						Code:     "ZK3QW7RT2M5XN4PA",
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name: "course_invitation_not_found",
+			PrepareHandler: func(_ *testing.T) mock.RevokeInvitationHandler {
+				return func(_ context.Context, _ app.RevokeInvitationCommand) error {
+					return course.ErrCourseHasNoSuchInvitation
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-invitation-not-found", "details": "course has no such invitation"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{RevokeInvitation: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/invitations/ZK3QW7RT2M5XN4PA", courseID)
+			r := newHTTPRequest(t, http.MethodDelete, target, "", teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_RedeemInvitation(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+	student := course.MustNewAcademic("5e4d3c2b-1a0f-4e9d-8c7b-6a5f4e3d2c1b", course.StudentType)
+
+	testCases := []struct {
+		Name            string
+		RequestBody     string
+		PrepareHandler  func(t *testing.T) mock.RedeemInvitationHandler
+		StatusCode      int
+		ResponseBody    string
+		ContentLocation string
+	}{
+		{
+			Name:        "student_joined_course",
+			RequestBody: `{"code": "ZK3QW7RT2M5XN4PA"}`,
+			PrepareHandler: func(t *testing.T) mock.RedeemInvitationHandler {
+				return func(_ context.Context, cmd app.RedeemInvitationCommand) (app.RedeemedInvitation, error) {
+					require.Equal(t, app.RedeemInvitationCommand{Academic: student, Code: "ZK3QW7RT2M5XN4PA"}, cmd)
+
+					return app.RedeemedInvitation{CourseID: courseID}, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ResponseBody:    fmt.Sprintf(`{"courseId": "%s", "waitlisted": false}`, courseID),
+			ContentLocation: fmt.Sprintf("/courses/%s", courseID),
+		},
+		{
+			Name:        "student_waitlisted",
+			RequestBody: `{"code": "ZK3QW7RT2M5XN4PA"}`,
+			PrepareHandler: func(_ *testing.T) mock.RedeemInvitationHandler {
+				return func(_ context.Context, _ app.RedeemInvitationCommand) (app.RedeemedInvitation, error) {
+					return app.RedeemedInvitation{CourseID: courseID, Waitlisted: true}, nil
+				}
+			},
+			StatusCode:      http.StatusCreated,
+			ResponseBody:    fmt.Sprintf(`{"courseId": "%s", "waitlisted": true}`, courseID),
+			ContentLocation: fmt.Sprintf("/courses/%s", courseID),
+		},
+		{
+			Name:        "invitation_not_found",
+			RequestBody: `{"code": "UNKNOWN"}`,
+			PrepareHandler: func(_ *testing.T) mock.RedeemInvitationHandler {
+				return func(_ context.Context, _ app.RedeemInvitationCommand) (app.RedeemedInvitation, error) {
+					return app.RedeemedInvitation{}, app.ErrInvitationDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "invitation-not-found", "details": "invitation doesn't exist"}`,
+		},
+		{
+			Name:        "invitation_used_up",
+			RequestBody: `{"code": "ZK3QW7RT2M5XN4PA"}`,
+			PrepareHandler: func(_ *testing.T) mock.RedeemInvitationHandler {
+				return func(_ context.Context, _ app.RedeemInvitationCommand) (app.RedeemedInvitation, error) {
+					return app.RedeemedInvitation{}, course.ErrInvitationUsedUp
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invitation-not-redeemable", "details": "invitation has no uses left"}`,
+		},
+		{
+			Name:        "student_already_waitlisted",
+			RequestBody: `{"code": "ZK3QW7RT2M5XN4PA"}`,
+			PrepareHandler: func(_ *testing.T) mock.RedeemInvitationHandler {
+				return func(_ context.Context, _ app.RedeemInvitationCommand) (app.RedeemedInvitation, error) {
+					return app.RedeemedInvitation{}, course.ErrStudentAlreadyWaitlisted
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "student-already-waitlisted", "details": "student is already in waitlist of course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{RedeemInvitation: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodPost, "/enrollments", c.RequestBody, student)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+			require.Equal(t, c.ContentLocation, w.Header().Get("Content-Location"))
+		})
+	}
+}
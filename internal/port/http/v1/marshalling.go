@@ -2,12 +2,15 @@ package v1
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/render"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/textdiff"
 )
 
 func marshalCommonCourses(w http.ResponseWriter, r *http.Request, courses []app.CommonCourse) {
@@ -33,43 +36,172 @@ func marshalCommonCourseToCourseResponse(crs app.CommonCourse) Course {
 		CreatorId:   crs.CreatorID,
 		Started:     crs.Started,
 		TasksNumber: crs.TasksNumber,
+		Capacity:    marshalCapacity(crs.Capacity),
 	}
 }
 
+// marshalCapacity omits zero capacity of course without limit.
+func marshalCapacity(capacity int) *int {
+	if capacity == 0 {
+		return nil
+	}
+
+	return &capacity
+}
+
+type specificTaskResponse struct {
+	TaskResponse
+	Deadline             *Deadline   `json:"deadline,omitempty"`
+	TestData             []TestData  `json:"testData,omitempty"`
+	HiddenTestDataNumber *int        `json:"hiddenTestDataNumber,omitempty"`
+	Checker              *Checker    `json:"checker,omitempty"`
+	Languages            []string    `json:"languages,omitempty"`
+	TimeLimit            *int        `json:"timeLimit,omitempty"`
+	MemoryLimit          *int        `json:"memoryLimit,omitempty"`
+	MaxSourceSize        *int        `json:"maxSourceSize,omitempty"`
+	Points               []TestPoint `json:"points,omitempty"`
+}
+
 func marshalSpecificTask(w http.ResponseWriter, r *http.Request, task app.SpecificTask) {
-	type taskResponse struct {
-		TaskResponse
-		Deadline *Deadline   `json:"deadline,omitempty"`
-		TestData []TestData  `json:"testData,omitempty"`
-		Points   []TestPoint `json:"points,omitempty"`
+	render.Respond(w, r, newSpecificTaskResponse(task))
+}
+
+func newSpecificTaskResponse(task app.SpecificTask) specificTaskResponse {
+	var hiddenTestDataNumber *int
+	if task.Type == course.AutoCodeCheckingType {
+		hiddenTestDataNumber = &task.HiddenTestDataNumber
+	}
+
+	var timeLimit, memoryLimit, maxSourceSize *int
+	if task.Limits != nil {
+		timeLimitMS := int(task.Limits.TimeLimit / time.Millisecond)
+		memoryLimitMB := int(task.Limits.MemoryLimit >> 20)
+		maxSourceSizeKB := task.Limits.MaxSourceSize >> 10
+		timeLimit, memoryLimit, maxSourceSize = &timeLimitMS, &memoryLimitMB, &maxSourceSizeKB
 	}
 
-	response := taskResponse{
+	var version *int
+	if task.Version != 0 {
+		version = &task.Version
+	}
+
+	var materials *[]AuxiliaryMaterial
+	if len(task.Materials) != 0 {
+		materialsResponse := newAuxiliaryMaterialsResponse(task.Materials)
+		materials = &materialsResponse
+	}
+
+	return specificTaskResponse{
 		TaskResponse: TaskResponse{
-			Number: task.Number,
+			Number:             task.Number,
+			DescriptionHtml:    &task.DescriptionHTML,
+			Version:            version,
+			AuxiliaryMaterials: materials,
 			Task: Task{
 				Title:       task.Title,
 				Description: task.Description,
 				Type:        marshalTaskType(task.Type),
+				TeamWork:    marshalTeamWork(task.TeamWork),
 			},
 		},
-		Deadline: marshalDeadline(task.Deadline),
-		TestData: marshalTestData(task.TestData),
-		Points:   marshalTestPoints(task.Points),
+		Deadline:             marshalDeadline(task.Deadline),
+		TestData:             marshalTestData(task.TestData),
+		HiddenTestDataNumber: hiddenTestDataNumber,
+		Checker:              marshalChecker(task.Checker),
+		Languages:            task.Languages,
+		TimeLimit:            timeLimit,
+		MemoryLimit:          memoryLimit,
+		MaxSourceSize:        maxSourceSize,
+		Points:               marshalTestPoints(task.Points),
+	}
+}
+
+type taskVersionResponse struct {
+	TaskVersion
+	Task specificTaskResponse `json:"task"`
+}
+
+func marshalGeneralTaskVersions(w http.ResponseWriter, r *http.Request, versions []app.GeneralTaskVersion) {
+	response := make(GetTaskVersionsResponse, 0, len(versions))
+	for _, v := range versions {
+		response = append(response, TaskVersion{
+			Version:   v.Version,
+			AuthorId:  v.AuthorID,
+			CreatedAt: v.CreatedAt,
+			Title:     v.Title,
+		})
 	}
 
 	render.Respond(w, r, response)
 }
 
+func marshalTaskVersion(w http.ResponseWriter, r *http.Request, version app.TaskVersion) {
+	render.Respond(w, r, newTaskVersionResponse(version))
+}
+
+func newTaskVersionResponse(version app.TaskVersion) taskVersionResponse {
+	return taskVersionResponse{
+		TaskVersion: TaskVersion{
+			Version:   version.Version,
+			AuthorId:  version.AuthorID,
+			CreatedAt: version.CreatedAt,
+			Title:     version.Task.Title,
+		},
+		Task: newSpecificTaskResponse(version.Task),
+	}
+}
+
+func marshalTaskVersionsDiff(w http.ResponseWriter, r *http.Request, diff app.TaskVersionsDiff) {
+	changedFields := make([]TaskField, 0, len(diff.ChangedFields))
+	for _, f := range diff.ChangedFields {
+		changedFields = append(changedFields, TaskField(f))
+	}
+
+	descriptionDiff := make([]DiffLine, 0, len(diff.DescriptionDiff))
+	for _, l := range diff.DescriptionDiff {
+		descriptionDiff = append(descriptionDiff, DiffLine{Op: marshalDiffLineOp(l.Op), Text: l.Text})
+	}
+
+	response := struct {
+		From            taskVersionResponse `json:"from"`
+		To              taskVersionResponse `json:"to"`
+		ChangedFields   []TaskField         `json:"changedFields"`
+		DescriptionDiff []DiffLine          `json:"descriptionDiff"`
+	}{
+		From:            newTaskVersionResponse(diff.From),
+		To:              newTaskVersionResponse(diff.To),
+		ChangedFields:   changedFields,
+		DescriptionDiff: descriptionDiff,
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalDiffLineOp(op textdiff.Op) DiffLineOp {
+	switch op {
+	case textdiff.Equal:
+		return DiffLineOpEQUAL
+	case textdiff.Insert:
+		return DiffLineOpINSERT
+	case textdiff.Delete:
+		return DiffLineOpDELETE
+	}
+
+	return "UNKNOWN"
+}
+
 func marshalGeneralTasks(w http.ResponseWriter, r *http.Request, tasks []app.GeneralTask) {
 	response := make([]TaskResponse, 0, len(tasks))
 	for _, t := range tasks {
+		descriptionHTML := t.DescriptionHTML
 		response = append(response, TaskResponse{
-			Number: t.Number,
+			Number:          t.Number,
+			DescriptionHtml: &descriptionHTML,
 			Task: Task{
 				Title:       t.Title,
 				Description: t.Description,
 				Type:        marshalTaskType(t.Type),
+				TeamWork:    marshalTeamWork(t.TeamWork),
 			},
 		})
 	}
@@ -77,6 +209,106 @@ func marshalGeneralTasks(w http.ResponseWriter, r *http.Request, tasks []app.Gen
 	render.Respond(w, r, response)
 }
 
+func marshalAuxiliaryMaterials(w http.ResponseWriter, r *http.Request, materials []app.AuxiliaryMaterial) {
+	render.Respond(w, r, GetAllAuxiliaryMaterialsResponse(newAuxiliaryMaterialsResponse(materials)))
+}
+
+func newAuxiliaryMaterialsResponse(materials []app.AuxiliaryMaterial) []AuxiliaryMaterial {
+	response := make([]AuxiliaryMaterial, 0, len(materials))
+	for _, m := range materials {
+		response = append(response, newAuxiliaryMaterialResponse(m))
+	}
+
+	return response
+}
+
+func marshalAuxiliaryMaterial(w http.ResponseWriter, r *http.Request, material app.AuxiliaryMaterial) {
+	render.Respond(w, r, newAuxiliaryMaterialResponse(material))
+}
+
+func newAuxiliaryMaterialResponse(material app.AuxiliaryMaterial) AuxiliaryMaterial {
+	id := material.ID
+
+	var taskNumber *int
+	if material.TaskNumber != 0 {
+		taskNumber = &material.TaskNumber
+	}
+
+	var releaseTime *time.Time
+	if !material.ReleaseTime.IsZero() {
+		releaseTime = &material.ReleaseTime
+	}
+
+	var hidden *bool
+	if material.Hidden {
+		hidden = &material.Hidden
+	}
+
+	return AuxiliaryMaterial{
+		Id:           &id,
+		Resource:     material.Resource,
+		ResourceType: marshalResourceType(material.ResourceType),
+		File:         marshalMaterialFile(material.File),
+		TaskNumber:   taskNumber,
+		ReleaseTime:  releaseTime,
+		Hidden:       hidden,
+		Status:       marshalMaterialStatus(material.Status),
+	}
+}
+
+func marshalMaterialStatus(status course.MaterialStatus) *MaterialStatus {
+	var apiStatus MaterialStatus
+
+	switch status {
+	case course.ReleasedMaterial:
+		apiStatus = MaterialStatusRELEASED
+	case course.ScheduledMaterial:
+		apiStatus = MaterialStatusSCHEDULED
+	case course.HiddenMaterial:
+		apiStatus = MaterialStatusHIDDEN
+	default:
+		return nil
+	}
+
+	return &apiStatus
+}
+
+func marshalMaterialFile(file *app.MaterialFile) *MaterialFile {
+	if file == nil {
+		return nil
+	}
+
+	return &MaterialFile{
+		Name:        file.Name,
+		ContentType: file.ContentType,
+		Size:        file.Size,
+	}
+}
+
+func marshalResourceType(resourceType course.ResourceType) ResourceType {
+	switch resourceType {
+	case course.TrainingManualResource:
+		return ResourceTypeTRAININGMANUAL
+	case course.VideoResource:
+		return ResourceTypeVIDEO
+	case course.PresentationResource:
+		return ResourceTypePRESENTATION
+	case course.OtherResource:
+		return ResourceTypeOTHER
+	}
+
+	return "UNKNOWN"
+}
+
+// marshalTeamWork omits team work flag of individual task.
+func marshalTeamWork(teamWork bool) *bool {
+	if !teamWork {
+		return nil
+	}
+
+	return &teamWork
+}
+
 func marshalTaskType(taskType course.TaskType) TaskType {
 	switch taskType {
 	case course.ManualCheckingType:
@@ -124,17 +356,79 @@ func marshalTestData(testData []app.TestData) []TestData {
 	marshalled := make([]TestData, 0, len(testData))
 
 	for _, td := range testData {
-		inputData, outputData := td.InputData, td.OutputData
+		inputData, outputData, explanation := td.InputData, td.OutputData, td.Explanation
+		inputDataID, outputDataID := td.InputDataID, td.OutputDataID
+		visibility := marshalTestDataVisibility(td.Visibility)
+
+		var explanationPtr *string
+		if explanation != "" {
+			explanationPtr = &explanation
+		}
+
+		if inputDataID != "" || outputDataID != "" {
+			marshalled = append(marshalled, TestData{
+				InputDataId:  &inputDataID,
+				OutputDataId: &outputDataID,
+				Visibility:   &visibility,
+				Explanation:  explanationPtr,
+			})
+
+			continue
+		}
 
 		marshalled = append(marshalled, TestData{
-			InputData:  &inputData,
-			OutputData: &outputData,
+			InputData:   &inputData,
+			OutputData:  &outputData,
+			Visibility:  &visibility,
+			Explanation: explanationPtr,
 		})
 	}
 
 	return marshalled
 }
 
+func marshalChecker(checker *app.Checker) *Checker {
+	if checker == nil {
+		return nil
+	}
+
+	var tolerance *float64
+	if checker.Tolerance != 0 {
+		tolerance = &checker.Tolerance
+	}
+
+	return &Checker{
+		Mode:      marshalCheckerMode(checker.Mode),
+		Tolerance: tolerance,
+	}
+}
+
+func marshalCheckerMode(mode course.CheckerMode) CheckerMode {
+	switch mode {
+	case course.ExactChecker:
+		return CheckerModeEXACT
+	case course.TokensChecker:
+		return CheckerModeTOKENS
+	case course.FloatChecker:
+		return CheckerModeFLOAT
+	case course.UnorderedLinesChecker:
+		return CheckerModeUNORDEREDLINES
+	}
+
+	return "UNKNOWN"
+}
+
+func marshalTestDataVisibility(visibility course.TestDataVisibility) TestDataVisibility {
+	switch visibility {
+	case course.SampleTestData:
+		return TestDataVisibilitySAMPLE
+	case course.HiddenTestData:
+		return TestDataVisibilityHIDDEN
+	}
+
+	return "UNKNOWN"
+}
+
 func marshalTestPoints(testPoints []app.TestPoint) []TestPoint {
 	marshalled := make([]TestPoint, 0, len(testPoints))
 
@@ -158,3 +452,255 @@ func marshalTestPoints(testPoints []app.TestPoint) []TestPoint {
 
 	return marshalled
 }
+
+func marshalCourseViewsReport(w http.ResponseWriter, r *http.Request, report app.CourseViewsReport) {
+	response := CourseViewsReportResponse{
+		Tasks:              make([]TaskViewsReport, 0, len(report.Tasks)),
+		AuxiliaryMaterials: make([]AuxiliaryMaterialViewsReport, 0, len(report.Materials)),
+	}
+
+	for _, t := range report.Tasks {
+		response.Tasks = append(response.Tasks, TaskViewsReport{
+			TaskNumber:      t.Item.TaskNumber,
+			ItemViewsReport: marshalItemViewsReport(t),
+		})
+	}
+
+	for _, m := range report.Materials {
+		response.AuxiliaryMaterials = append(response.AuxiliaryMaterials, AuxiliaryMaterialViewsReport{
+			MaterialId:      m.Item.MaterialID,
+			ItemViewsReport: marshalItemViewsReport(m),
+		})
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalItemViewsReport(report app.ItemViewsReport) ItemViewsReport {
+	return ItemViewsReport{
+		Title:             report.Title,
+		UniqueViewers:     report.UniqueViewers,
+		NotViewedStudents: report.NotViewedStudents,
+	}
+}
+
+func marshalStudentsPage(w http.ResponseWriter, r *http.Request, page app.AcademicsPage) {
+	response := make(GetAllCourseStudentsResponse, 0, len(page.Academics))
+	for _, a := range page.Academics {
+		response = append(response, marshalStudent(a))
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	render.Respond(w, r, response)
+}
+
+// marshalStudent omits enrollment fields that are unknown or zero.
+func marshalStudent(profile app.AcademicProfile) Student {
+	student := Student{Id: profile.ID, FullName: profile.FullName}
+	if profile.Enrollment == nil {
+		return student
+	}
+
+	status := marshalStudentStatus(profile.Enrollment.Status)
+	student.Status = &status
+	student.EnrolledAt = marshalOptionalTime(profile.Enrollment.EnrolledAt)
+	student.WithdrawnAt = marshalOptionalTime(profile.Enrollment.WithdrawnAt)
+
+	if profile.Enrollment.Reason != "" {
+		student.WithdrawalReason = &profile.Enrollment.Reason
+	}
+
+	return student
+}
+
+func marshalStudentStatus(status course.StudentStatus) StudentStatus {
+	switch status {
+	case course.WithdrawnStudent:
+		return StudentStatusWITHDRAWN
+	case course.CompletedStudent:
+		return StudentStatusCOMPLETED
+	}
+
+	return StudentStatusACTIVE
+}
+
+// marshalOptionalTime omits zero time of not happened event.
+func marshalOptionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func marshalEnrollmentRows(w http.ResponseWriter, r *http.Request, rows []app.EnrollmentRow) {
+	response := EnrollStudentsResponse{Rows: make([]EnrollmentRow, 0, len(rows))}
+
+	for _, row := range rows {
+		apiRow := EnrollmentRow{
+			Row:    row.Number,
+			Value:  row.Value,
+			Status: marshalEnrollmentStatus(row.Status),
+		}
+
+		if row.StudentID != "" {
+			studentID := row.StudentID
+			apiRow.StudentId = &studentID
+		}
+
+		response.Rows = append(response.Rows, apiRow)
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalEnrollmentStatus(status app.EnrollmentStatus) EnrollmentStatus {
+	switch status {
+	case app.EnrollmentAdded:
+		return EnrollmentStatusADDED
+	case app.EnrollmentAlreadyEnrolled:
+		return EnrollmentStatusALREADYENROLLED
+	case app.EnrollmentUnknown:
+		return EnrollmentStatusUNKNOWN
+	}
+
+	return EnrollmentStatusINVALID
+}
+
+func marshalInvitations(w http.ResponseWriter, r *http.Request, invitations []app.Invitation) {
+	response := make(GetAllInvitationsResponse, 0, len(invitations))
+	for _, i := range invitations {
+		response = append(response, Invitation{
+			Code:      i.Code,
+			Role:      marshalInvitationRole(i.Role),
+			ExpiresAt: i.ExpiresAt,
+			MaxUses:   i.MaxUses,
+			Uses:      i.Uses,
+		})
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalInvitationRole(role course.AcademicType) InvitationRole {
+	if role == course.TeacherType {
+		return InvitationRoleCOLLABORATOR
+	}
+
+	return InvitationRoleSTUDENT
+}
+
+func marshalTeams(w http.ResponseWriter, r *http.Request, teams app.Teams) {
+	response := GetAllTeamsResponse{
+		TeamSettings: TeamSettings{
+			SelfFormation: teams.SelfFormation,
+			MaxSize:       teams.MaxSize,
+		},
+		Teams: make([]Team, 0, len(teams.Teams)),
+	}
+
+	for _, t := range teams.Teams {
+		response.Teams = append(response.Teams, Team{
+			Id:        t.ID,
+			Name:      t.Name,
+			MemberIds: t.MemberIDs,
+		})
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalWaitlist(w http.ResponseWriter, r *http.Request, waitlist app.Waitlist) {
+	response := GetCourseWaitlistResponse{
+		CourseCapacity: CourseCapacity{Capacity: waitlist.Capacity},
+		Entries:        make([]WaitlistEntry, 0, len(waitlist.Entries)),
+		Promotions:     make([]WaitlistPromotion, 0, len(waitlist.Promotions)),
+	}
+
+	for _, e := range waitlist.Entries {
+		response.Entries = append(response.Entries, WaitlistEntry{
+			StudentId:    e.StudentID,
+			WaitlistedAt: e.WaitlistedAt,
+		})
+	}
+
+	for _, p := range waitlist.Promotions {
+		response.Promotions = append(response.Promotions, WaitlistPromotion{
+			StudentId:    p.StudentID,
+			WaitlistedAt: p.WaitlistedAt,
+			PromotedAt:   p.PromotedAt,
+		})
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalEnrollmentRequests(w http.ResponseWriter, r *http.Request, requests []app.EnrollmentRequest) {
+	response := make(GetEnrollmentRequestsResponse, 0, len(requests))
+	for _, er := range requests {
+		request := er
+
+		var message *string
+		if request.Message != "" {
+			message = &request.Message
+		}
+
+		var reviewerID *string
+		if request.ReviewerID != "" {
+			reviewerID = &request.ReviewerID
+		}
+
+		var reviewedAt *time.Time
+		if !request.ReviewedAt.IsZero() {
+			reviewedAt = &request.ReviewedAt
+		}
+
+		response = append(response, EnrollmentRequest{
+			Id:         request.ID,
+			StudentId:  request.StudentID,
+			Message:    message,
+			Status:     marshalEnrollmentRequestStatus(request.Status),
+			CreatedAt:  request.CreatedAt,
+			ReviewerId: reviewerID,
+			ReviewedAt: reviewedAt,
+		})
+	}
+
+	render.Respond(w, r, response)
+}
+
+func marshalEnrollmentRequestStatus(status course.EnrollmentRequestStatus) EnrollmentRequestStatus {
+	switch status {
+	case course.ApprovedRequest:
+		return EnrollmentRequestStatusAPPROVED
+	case course.RejectedRequest:
+		return EnrollmentRequestStatusREJECTED
+	}
+
+	return EnrollmentRequestStatusPENDING
+}
+
+func marshalCollaboratorsPage(w http.ResponseWriter, r *http.Request, page app.AcademicsPage) {
+	response := make(GetAllCourseCollaboratorsResponse, 0, len(page.Academics))
+	for _, a := range page.Academics {
+		response = append(response, Teacher{
+			Id:       a.ID,
+			FullName: a.FullName,
+			Role:     marshalCollaboratorRole(a.Role),
+		})
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	render.Respond(w, r, response)
+}
+
+func marshalCollaboratorRole(role course.CollaboratorRole) CollaboratorRole {
+	switch role {
+	case course.AssistantRole:
+		return CollaboratorRoleASSISTANT
+	case course.ObserverRole:
+		return CollaboratorRoleOBSERVER
+	}
+
+	return CollaboratorRoleCOTEACHER
+}
@@ -33,8 +33,29 @@ type ServerInterface interface {
 	// (POST /courses/{courseId}/auxiliary-materials)
 	AttachAuxiliaryMaterialToCourse(w http.ResponseWriter, r *http.Request, courseId string)
 
+	// (POST /courses/{courseId}/auxiliary-materials/files)
+	UploadAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (PUT /courses/{courseId}/auxiliary-materials/order)
+	ReorderAuxiliaryMaterials(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (DELETE /courses/{courseId}/auxiliary-materials/{materialId})
+	RemoveAuxiliaryMaterialFromCourse(w http.ResponseWriter, r *http.Request, courseId string, materialId string)
+
+	// (GET /courses/{courseId}/auxiliary-materials/{materialId})
+	GetCourseAuxiliaryMaterial(w http.ResponseWriter, r *http.Request, courseId string, materialId string)
+
+	// (PUT /courses/{courseId}/auxiliary-materials/{materialId})
+	EditAuxiliaryMaterial(w http.ResponseWriter, r *http.Request, courseId string, materialId string)
+
+	// (GET /courses/{courseId}/auxiliary-materials/{materialId}/file)
+	GetAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request, courseId string, materialId string)
+
+	// (PUT /courses/{courseId}/capacity)
+	ChangeCourseCapacity(w http.ResponseWriter, r *http.Request, courseId string)
+
 	// (GET /courses/{courseId}/collaborators)
-	GetAllCourseCollaborators(w http.ResponseWriter, r *http.Request, courseId string)
+	GetAllCourseCollaborators(w http.ResponseWriter, r *http.Request, courseId string, params GetAllCourseCollaboratorsParams)
 
 	// (PUT /courses/{courseId}/collaborators)
 	AddCollaboratorToCourse(w http.ResponseWriter, r *http.Request, courseId string)
@@ -42,20 +63,56 @@ type ServerInterface interface {
 	// (DELETE /courses/{courseId}/collaborators/{teacherId})
 	RemoveCollaboratorFromCourse(w http.ResponseWriter, r *http.Request, courseId string, teacherId string)
 
+	// (PUT /courses/{courseId}/collaborators/{teacherId}/role)
+	ChangeCollaboratorRole(w http.ResponseWriter, r *http.Request, courseId string, teacherId string)
+
+	// (GET /courses/{courseId}/enrollment-requests)
+	GetEnrollmentRequests(w http.ResponseWriter, r *http.Request, courseId string, params GetEnrollmentRequestsParams)
+
+	// (POST /courses/{courseId}/enrollment-requests)
+	RequestEnrollment(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (POST /courses/{courseId}/enrollment-requests/approved)
+	ApproveEnrollmentRequests(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (POST /courses/{courseId}/enrollment-requests/rejected)
+	RejectEnrollmentRequests(w http.ResponseWriter, r *http.Request, courseId string)
+
 	// (POST /courses/{courseId}/extended)
 	ExtendCourse(w http.ResponseWriter, r *http.Request, courseId string)
 
 	// (PUT /courses/{courseId}/groups)
 	AddGroupToCourse(w http.ResponseWriter, r *http.Request, courseId string)
 
+	// (DELETE /courses/{courseId}/groups/{groupId})
+	RemoveGroupFromCourse(w http.ResponseWriter, r *http.Request, courseId string, groupId string)
+
+	// (POST /courses/{courseId}/groups/{groupId}/synced)
+	SyncCourseGroup(w http.ResponseWriter, r *http.Request, courseId string, groupId string)
+
+	// (GET /courses/{courseId}/invitations)
+	GetAllInvitations(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (POST /courses/{courseId}/invitations)
+	CreateInvitation(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (DELETE /courses/{courseId}/invitations/{code})
+	RevokeInvitation(w http.ResponseWriter, r *http.Request, courseId string, code string)
+
 	// (GET /courses/{courseId}/students)
 	GetAllCourseStudents(w http.ResponseWriter, r *http.Request, courseId string, params GetAllCourseStudentsParams)
 
 	// (PUT /courses/{courseId}/students)
 	AddStudentToCourse(w http.ResponseWriter, r *http.Request, courseId string)
 
+	// (POST /courses/{courseId}/students/enrollment)
+	EnrollStudentsToCourse(w http.ResponseWriter, r *http.Request, courseId string, params EnrollStudentsToCourseParams)
+
 	// (DELETE /courses/{courseId}/students/{studentId})
-	RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request, courseId string, studentId string)
+	RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request, courseId string, studentId string, params RemoveStudentFromCourseParams)
+
+	// (POST /courses/{courseId}/students/{studentId}/completed)
+	CompleteCourseStudent(w http.ResponseWriter, r *http.Request, courseId string, studentId string)
 
 	// (GET /courses/{courseId}/tasks)
 	GetCourseTasks(w http.ResponseWriter, r *http.Request, courseId string, params GetCourseTasksParams)
@@ -65,6 +122,60 @@ type ServerInterface interface {
 
 	// (GET /courses/{courseId}/tasks/{taskNumber})
 	GetCourseTask(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int)
+
+	// (PATCH /courses/{courseId}/tasks/{taskNumber})
+	EditCourseTask(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int)
+
+	// (GET /courses/{courseId}/tasks/{taskNumber}/versions)
+	GetCourseTaskVersions(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int)
+
+	// (GET /courses/{courseId}/tasks/{taskNumber}/versions-diff)
+	GetCourseTaskVersionsDiff(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int, params GetCourseTaskVersionsDiffParams)
+
+	// (GET /courses/{courseId}/tasks/{taskNumber}/versions/{version})
+	GetCourseTaskVersion(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int, version int)
+
+	// (POST /courses/{courseId}/tasks/{taskNumber}/versions/{version}/restored)
+	RestoreCourseTaskVersion(w http.ResponseWriter, r *http.Request, courseId string, taskNumber int, version int)
+
+	// (GET /courses/{courseId}/teams)
+	GetAllTeams(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (POST /courses/{courseId}/teams)
+	CreateTeam(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (PUT /courses/{courseId}/teams/settings)
+	ChangeTeamSettings(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (DELETE /courses/{courseId}/teams/{teamId})
+	RemoveTeam(w http.ResponseWriter, r *http.Request, courseId string, teamId string)
+
+	// (PATCH /courses/{courseId}/teams/{teamId})
+	RenameTeam(w http.ResponseWriter, r *http.Request, courseId string, teamId string)
+
+	// (POST /courses/{courseId}/teams/{teamId}/members)
+	AddTeamMembers(w http.ResponseWriter, r *http.Request, courseId string, teamId string)
+
+	// (DELETE /courses/{courseId}/teams/{teamId}/members/{studentId})
+	RemoveTeamMember(w http.ResponseWriter, r *http.Request, courseId string, teamId string, studentId string)
+
+	// (POST /courses/{courseId}/test-data)
+	UploadTestData(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (GET /courses/{courseId}/test-data/{testDataId})
+	GetStoredTestData(w http.ResponseWriter, r *http.Request, courseId string, testDataId string)
+
+	// (GET /courses/{courseId}/views-report)
+	GetCourseViewsReport(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (GET /courses/{courseId}/waitlist)
+	GetCourseWaitlist(w http.ResponseWriter, r *http.Request, courseId string)
+
+	// (DELETE /courses/{courseId}/waitlist/{studentId})
+	RemoveFromWaitlist(w http.ResponseWriter, r *http.Request, courseId string, studentId string)
+
+	// (POST /enrollments)
+	RedeemInvitation(w http.ResponseWriter, r *http.Request)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -212,6 +323,17 @@ func (siw *ServerInterfaceWrapper) GetAllCourseAuxiliaryMaterials(w http.Respons
 		return
 	}
 
+	// ------------- Optional query parameter "taskNumber" -------------
+	if paramValue := r.URL.Query().Get("taskNumber"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "taskNumber", r.URL.Query(), &params.TaskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAllCourseAuxiliaryMaterials(w, r, courseId, params)
 	}
@@ -251,8 +373,8 @@ func (siw *ServerInterfaceWrapper) AttachAuxiliaryMaterialToCourse(w http.Respon
 	handler(w, r.WithContext(ctx))
 }
 
-// GetAllCourseCollaborators operation middleware
-func (siw *ServerInterfaceWrapper) GetAllCourseCollaborators(w http.ResponseWriter, r *http.Request) {
+// UploadAuxiliaryMaterialFile operation middleware
+func (siw *ServerInterfaceWrapper) UploadAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -269,7 +391,7 @@ func (siw *ServerInterfaceWrapper) GetAllCourseCollaborators(w http.ResponseWrit
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllCourseCollaborators(w, r, courseId)
+		siw.Handler.UploadAuxiliaryMaterialFile(w, r, courseId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -279,8 +401,8 @@ func (siw *ServerInterfaceWrapper) GetAllCourseCollaborators(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// AddCollaboratorToCourse operation middleware
-func (siw *ServerInterfaceWrapper) AddCollaboratorToCourse(w http.ResponseWriter, r *http.Request) {
+// ReorderAuxiliaryMaterials operation middleware
+func (siw *ServerInterfaceWrapper) ReorderAuxiliaryMaterials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -297,7 +419,7 @@ func (siw *ServerInterfaceWrapper) AddCollaboratorToCourse(w http.ResponseWriter
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddCollaboratorToCourse(w, r, courseId)
+		siw.Handler.ReorderAuxiliaryMaterials(w, r, courseId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -307,8 +429,8 @@ func (siw *ServerInterfaceWrapper) AddCollaboratorToCourse(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// RemoveCollaboratorFromCourse operation middleware
-func (siw *ServerInterfaceWrapper) RemoveCollaboratorFromCourse(w http.ResponseWriter, r *http.Request) {
+// RemoveAuxiliaryMaterialFromCourse operation middleware
+func (siw *ServerInterfaceWrapper) RemoveAuxiliaryMaterialFromCourse(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -322,19 +444,19 @@ func (siw *ServerInterfaceWrapper) RemoveCollaboratorFromCourse(w http.ResponseW
 		return
 	}
 
-	// ------------- Path parameter "teacherId" -------------
-	var teacherId string
+	// ------------- Path parameter "materialId" -------------
+	var materialId string
 
-	err = runtime.BindStyledParameter("simple", false, "teacherId", chi.URLParam(r, "teacherId"), &teacherId)
+	err = runtime.BindStyledParameter("simple", false, "materialId", chi.URLParam(r, "materialId"), &materialId)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter teacherId: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter materialId: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveCollaboratorFromCourse(w, r, courseId, teacherId)
+		siw.Handler.RemoveAuxiliaryMaterialFromCourse(w, r, courseId, materialId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -344,8 +466,45 @@ func (siw *ServerInterfaceWrapper) RemoveCollaboratorFromCourse(w http.ResponseW
 	handler(w, r.WithContext(ctx))
 }
 
-// ExtendCourse operation middleware
-func (siw *ServerInterfaceWrapper) ExtendCourse(w http.ResponseWriter, r *http.Request) {
+// GetCourseAuxiliaryMaterial operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseAuxiliaryMaterial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "materialId" -------------
+	var materialId string
+
+	err = runtime.BindStyledParameter("simple", false, "materialId", chi.URLParam(r, "materialId"), &materialId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter materialId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseAuxiliaryMaterial(w, r, courseId, materialId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// EditAuxiliaryMaterial operation middleware
+func (siw *ServerInterfaceWrapper) EditAuxiliaryMaterial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -359,10 +518,19 @@ func (siw *ServerInterfaceWrapper) ExtendCourse(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "materialId" -------------
+	var materialId string
+
+	err = runtime.BindStyledParameter("simple", false, "materialId", chi.URLParam(r, "materialId"), &materialId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter materialId: %s", err), http.StatusBadRequest)
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExtendCourse(w, r, courseId)
+		siw.Handler.EditAuxiliaryMaterial(w, r, courseId, materialId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -372,8 +540,8 @@ func (siw *ServerInterfaceWrapper) ExtendCourse(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// AddGroupToCourse operation middleware
-func (siw *ServerInterfaceWrapper) AddGroupToCourse(w http.ResponseWriter, r *http.Request) {
+// GetAuxiliaryMaterialFile operation middleware
+func (siw *ServerInterfaceWrapper) GetAuxiliaryMaterialFile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -387,10 +555,19 @@ func (siw *ServerInterfaceWrapper) AddGroupToCourse(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Path parameter "materialId" -------------
+	var materialId string
+
+	err = runtime.BindStyledParameter("simple", false, "materialId", chi.URLParam(r, "materialId"), &materialId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter materialId: %s", err), http.StatusBadRequest)
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddGroupToCourse(w, r, courseId)
+		siw.Handler.GetAuxiliaryMaterialFile(w, r, courseId, materialId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -400,8 +577,36 @@ func (siw *ServerInterfaceWrapper) AddGroupToCourse(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// GetAllCourseStudents operation middleware
-func (siw *ServerInterfaceWrapper) GetAllCourseStudents(w http.ResponseWriter, r *http.Request) {
+// ChangeCourseCapacity operation middleware
+func (siw *ServerInterfaceWrapper) ChangeCourseCapacity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeCourseCapacity(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAllCourseCollaborators operation middleware
+func (siw *ServerInterfaceWrapper) GetAllCourseCollaborators(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -418,21 +623,32 @@ func (siw *ServerInterfaceWrapper) GetAllCourseStudents(w http.ResponseWriter, r
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllCourseStudentsParams
+	var params GetAllCourseCollaboratorsParams
 
-	// ------------- Optional query parameter "fullName" -------------
-	if paramValue := r.URL.Query().Get("fullName"); paramValue != "" {
+	// ------------- Optional query parameter "page" -------------
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "fullName", r.URL.Query(), &params.FullName)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter fullName: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter page: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+	if paramValue := r.URL.Query().Get("perPage"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter perPage: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllCourseStudents(w, r, courseId, params)
+		siw.Handler.GetAllCourseCollaborators(w, r, courseId, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -442,8 +658,8 @@ func (siw *ServerInterfaceWrapper) GetAllCourseStudents(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// AddStudentToCourse operation middleware
-func (siw *ServerInterfaceWrapper) AddStudentToCourse(w http.ResponseWriter, r *http.Request) {
+// AddCollaboratorToCourse operation middleware
+func (siw *ServerInterfaceWrapper) AddCollaboratorToCourse(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -460,7 +676,7 @@ func (siw *ServerInterfaceWrapper) AddStudentToCourse(w http.ResponseWriter, r *
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddStudentToCourse(w, r, courseId)
+		siw.Handler.AddCollaboratorToCourse(w, r, courseId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -470,8 +686,8 @@ func (siw *ServerInterfaceWrapper) AddStudentToCourse(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// RemoveStudentFromCourse operation middleware
-func (siw *ServerInterfaceWrapper) RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request) {
+// RemoveCollaboratorFromCourse operation middleware
+func (siw *ServerInterfaceWrapper) RemoveCollaboratorFromCourse(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -485,19 +701,19 @@ func (siw *ServerInterfaceWrapper) RemoveStudentFromCourse(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "studentId" -------------
-	var studentId string
+	// ------------- Path parameter "teacherId" -------------
+	var teacherId string
 
-	err = runtime.BindStyledParameter("simple", false, "studentId", chi.URLParam(r, "studentId"), &studentId)
+	err = runtime.BindStyledParameter("simple", false, "teacherId", chi.URLParam(r, "teacherId"), &teacherId)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter studentId: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teacherId: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveStudentFromCourse(w, r, courseId, studentId)
+		siw.Handler.RemoveCollaboratorFromCourse(w, r, courseId, teacherId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -507,8 +723,8 @@ func (siw *ServerInterfaceWrapper) RemoveStudentFromCourse(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// GetCourseTasks operation middleware
-func (siw *ServerInterfaceWrapper) GetCourseTasks(w http.ResponseWriter, r *http.Request) {
+// ChangeCollaboratorRole operation middleware
+func (siw *ServerInterfaceWrapper) ChangeCollaboratorRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -522,35 +738,61 @@ func (siw *ServerInterfaceWrapper) GetCourseTasks(w http.ResponseWriter, r *http
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+	// ------------- Path parameter "teacherId" -------------
+	var teacherId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCourseTasksParams
+	err = runtime.BindStyledParameter("simple", false, "teacherId", chi.URLParam(r, "teacherId"), &teacherId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teacherId: %s", err), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Optional query parameter "type" -------------
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeCollaboratorRole(w, r, courseId, teacherId)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter type: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "text" -------------
-	if paramValue := r.URL.Query().Get("text"); paramValue != "" {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnrollmentRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "text", r.URL.Query(), &params.Text)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter text: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter status: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCourseTasks(w, r, courseId, params)
+		siw.Handler.GetEnrollmentRequests(w, r, courseId, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -560,8 +802,8 @@ func (siw *ServerInterfaceWrapper) GetCourseTasks(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// AddTaskToCourse operation middleware
-func (siw *ServerInterfaceWrapper) AddTaskToCourse(w http.ResponseWriter, r *http.Request) {
+// RequestEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) RequestEnrollment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -578,7 +820,7 @@ func (siw *ServerInterfaceWrapper) AddTaskToCourse(w http.ResponseWriter, r *htt
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTaskToCourse(w, r, courseId)
+		siw.Handler.RequestEnrollment(w, r, courseId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -588,8 +830,8 @@ func (siw *ServerInterfaceWrapper) AddTaskToCourse(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// GetCourseTask operation middleware
-func (siw *ServerInterfaceWrapper) GetCourseTask(w http.ResponseWriter, r *http.Request) {
+// ApproveEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -603,19 +845,38 @@ func (siw *ServerInterfaceWrapper) GetCourseTask(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "taskNumber" -------------
-	var taskNumber int
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveEnrollmentRequests(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RejectEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) RejectEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCourseTask(w, r, courseId, taskNumber)
+		siw.Handler.RejectEnrollmentRequests(w, r, courseId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -625,23 +886,1247 @@ func (siw *ServerInterfaceWrapper) GetCourseTask(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// ExtendCourse operation middleware
+func (siw *ServerInterfaceWrapper) ExtendCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ChiServerOptions struct {
-	BaseURL     string
-	BaseRouter  chi.Router
-	Middlewares []MiddlewareFunc
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExtendCourse(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddGroupToCourse operation middleware
+func (siw *ServerInterfaceWrapper) AddGroupToCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddGroupToCourse(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveGroupFromCourse operation middleware
+func (siw *ServerInterfaceWrapper) RemoveGroupFromCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter groupId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveGroupFromCourse(w, r, courseId, groupId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SyncCourseGroup operation middleware
+func (siw *ServerInterfaceWrapper) SyncCourseGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter groupId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncCourseGroup(w, r, courseId, groupId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAllInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetAllInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllInvitations(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvitation(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RevokeInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameter("simple", false, "code", chi.URLParam(r, "code"), &code)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter code: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeInvitation(w, r, courseId, code)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAllCourseStudents operation middleware
+func (siw *ServerInterfaceWrapper) GetAllCourseStudents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllCourseStudentsParams
+
+	// ------------- Optional query parameter "fullName" -------------
+	if paramValue := r.URL.Query().Get("fullName"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "fullName", r.URL.Query(), &params.FullName)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter fullName: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "includeWithdrawn" -------------
+	if paramValue := r.URL.Query().Get("includeWithdrawn"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "includeWithdrawn", r.URL.Query(), &params.IncludeWithdrawn)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter includeWithdrawn: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter page: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+	if paramValue := r.URL.Query().Get("perPage"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter perPage: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllCourseStudents(w, r, courseId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddStudentToCourse operation middleware
+func (siw *ServerInterfaceWrapper) AddStudentToCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddStudentToCourse(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// EnrollStudentsToCourse operation middleware
+func (siw *ServerInterfaceWrapper) EnrollStudentsToCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EnrollStudentsToCourseParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+	if paramValue := r.URL.Query().Get("dryRun"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter dryRun: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollStudentsToCourse(w, r, courseId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveStudentFromCourse operation middleware
+func (siw *ServerInterfaceWrapper) RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "studentId" -------------
+	var studentId string
+
+	err = runtime.BindStyledParameter("simple", false, "studentId", chi.URLParam(r, "studentId"), &studentId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter studentId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveStudentFromCourseParams
+
+	// ------------- Optional query parameter "reason" -------------
+	if paramValue := r.URL.Query().Get("reason"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter reason: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveStudentFromCourse(w, r, courseId, studentId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CompleteCourseStudent operation middleware
+func (siw *ServerInterfaceWrapper) CompleteCourseStudent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "studentId" -------------
+	var studentId string
+
+	err = runtime.BindStyledParameter("simple", false, "studentId", chi.URLParam(r, "studentId"), &studentId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter studentId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteCourseStudent(w, r, courseId, studentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCourseTasksParams
+
+	// ------------- Optional query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter type: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "text" -------------
+	if paramValue := r.URL.Query().Get("text"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "text", r.URL.Query(), &params.Text)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter text: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseTasks(w, r, courseId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddTaskToCourse operation middleware
+func (siw *ServerInterfaceWrapper) AddTaskToCourse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTaskToCourse(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseTask operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseTask(w, r, courseId, taskNumber)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// EditCourseTask operation middleware
+func (siw *ServerInterfaceWrapper) EditCourseTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditCourseTask(w, r, courseId, taskNumber)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseTaskVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseTaskVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseTaskVersions(w, r, courseId, taskNumber)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseTaskVersionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseTaskVersionsDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCourseTaskVersionsDiffParams
+
+	// ------------- Required query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		http.Error(w, "Query argument from is required, but not found", http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter from: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		http.Error(w, "Query argument to is required, but not found", http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter to: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseTaskVersionsDiff(w, r, courseId, taskNumber, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseTaskVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseTaskVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter version: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseTaskVersion(w, r, courseId, taskNumber, version)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RestoreCourseTaskVersion operation middleware
+func (siw *ServerInterfaceWrapper) RestoreCourseTaskVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskNumber" -------------
+	var taskNumber int
+
+	err = runtime.BindStyledParameter("simple", false, "taskNumber", chi.URLParam(r, "taskNumber"), &taskNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter taskNumber: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter version: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreCourseTaskVersion(w, r, courseId, taskNumber, version)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAllTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetAllTeams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllTeams(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTeam(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ChangeTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) ChangeTeamSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeTeamSettings(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveTeam operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "teamId" -------------
+	var teamId string
+
+	err = runtime.BindStyledParameter("simple", false, "teamId", chi.URLParam(r, "teamId"), &teamId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teamId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTeam(w, r, courseId, teamId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RenameTeam operation middleware
+func (siw *ServerInterfaceWrapper) RenameTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "teamId" -------------
+	var teamId string
+
+	err = runtime.BindStyledParameter("simple", false, "teamId", chi.URLParam(r, "teamId"), &teamId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teamId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameTeam(w, r, courseId, teamId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) AddTeamMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "teamId" -------------
+	var teamId string
+
+	err = runtime.BindStyledParameter("simple", false, "teamId", chi.URLParam(r, "teamId"), &teamId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teamId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeamMembers(w, r, courseId, teamId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveTeamMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTeamMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "teamId" -------------
+	var teamId string
+
+	err = runtime.BindStyledParameter("simple", false, "teamId", chi.URLParam(r, "teamId"), &teamId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter teamId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "studentId" -------------
+	var studentId string
+
+	err = runtime.BindStyledParameter("simple", false, "studentId", chi.URLParam(r, "studentId"), &studentId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter studentId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTeamMember(w, r, courseId, teamId, studentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UploadTestData operation middleware
+func (siw *ServerInterfaceWrapper) UploadTestData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadTestData(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetStoredTestData operation middleware
+func (siw *ServerInterfaceWrapper) GetStoredTestData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "testDataId" -------------
+	var testDataId string
+
+	err = runtime.BindStyledParameter("simple", false, "testDataId", chi.URLParam(r, "testDataId"), &testDataId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter testDataId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStoredTestData(w, r, courseId, testDataId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseViewsReport operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseViewsReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseViewsReport(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCourseWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseWaitlist(w, r, courseId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveFromWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFromWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameter("simple", false, "courseId", chi.URLParam(r, "courseId"), &courseId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter courseId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "studentId" -------------
+	var studentId string
+
+	err = runtime.BindStyledParameter("simple", false, "studentId", chi.URLParam(r, "studentId"), &studentId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter studentId: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveFromWaitlist(w, r, courseId, studentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RedeemInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RedeemInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeemInvitation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL     string
+	BaseRouter  chi.Router
+	Middlewares []MiddlewareFunc
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
 
 func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
 	return HandlerWithOptions(si, ChiServerOptions{
@@ -680,6 +2165,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/courses/{courseId}/auxiliary-materials", wrapper.AttachAuxiliaryMaterialToCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/auxiliary-materials/files", wrapper.UploadAuxiliaryMaterialFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/auxiliary-materials/order", wrapper.ReorderAuxiliaryMaterials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/auxiliary-materials/{materialId}", wrapper.RemoveAuxiliaryMaterialFromCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/auxiliary-materials/{materialId}", wrapper.GetCourseAuxiliaryMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/auxiliary-materials/{materialId}", wrapper.EditAuxiliaryMaterial)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/auxiliary-materials/{materialId}/file", wrapper.GetAuxiliaryMaterialFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/capacity", wrapper.ChangeCourseCapacity)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses/{courseId}/collaborators", wrapper.GetAllCourseCollaborators)
 	})
@@ -689,21 +2195,57 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/courses/{courseId}/collaborators/{teacherId}", wrapper.RemoveCollaboratorFromCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/collaborators/{teacherId}/role", wrapper.ChangeCollaboratorRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/enrollment-requests", wrapper.GetEnrollmentRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/enrollment-requests", wrapper.RequestEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/enrollment-requests/approved", wrapper.ApproveEnrollmentRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/enrollment-requests/rejected", wrapper.RejectEnrollmentRequests)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/courses/{courseId}/extended", wrapper.ExtendCourse)
 	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/courses/{courseId}/groups", wrapper.AddGroupToCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/groups/{groupId}", wrapper.RemoveGroupFromCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/groups/{groupId}/synced", wrapper.SyncCourseGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/invitations", wrapper.GetAllInvitations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/invitations", wrapper.CreateInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/invitations/{code}", wrapper.RevokeInvitation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses/{courseId}/students", wrapper.GetAllCourseStudents)
 	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/courses/{courseId}/students", wrapper.AddStudentToCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/students/enrollment", wrapper.EnrollStudentsToCourse)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/courses/{courseId}/students/{studentId}", wrapper.RemoveStudentFromCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/students/{studentId}/completed", wrapper.CompleteCourseStudent)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses/{courseId}/tasks", wrapper.GetCourseTasks)
 	})
@@ -713,6 +2255,60 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}", wrapper.GetCourseTask)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}", wrapper.EditCourseTask)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}/versions", wrapper.GetCourseTaskVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}/versions-diff", wrapper.GetCourseTaskVersionsDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}/versions/{version}", wrapper.GetCourseTaskVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/tasks/{taskNumber}/versions/{version}/restored", wrapper.RestoreCourseTaskVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/teams", wrapper.GetAllTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/teams", wrapper.CreateTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/teams/settings", wrapper.ChangeTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/teams/{teamId}", wrapper.RemoveTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/courses/{courseId}/teams/{teamId}", wrapper.RenameTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/teams/{teamId}/members", wrapper.AddTeamMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/teams/{teamId}/members/{studentId}", wrapper.RemoveTeamMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/test-data", wrapper.UploadTestData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/test-data/{testDataId}", wrapper.GetStoredTestData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/views-report", wrapper.GetCourseViewsReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/waitlist", wrapper.GetCourseWaitlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/waitlist/{studentId}", wrapper.RemoveFromWaitlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/enrollments", wrapper.RedeemInvitation)
+	})
 
 	return r
 }
@@ -4,6 +4,8 @@
 package v1
 
 import (
+	"time"
+
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 )
 
@@ -11,6 +13,71 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CheckerMode.
+const (
+	CheckerModeEXACT CheckerMode = "EXACT"
+
+	CheckerModeFLOAT CheckerMode = "FLOAT"
+
+	CheckerModeTOKENS CheckerMode = "TOKENS"
+
+	CheckerModeUNORDEREDLINES CheckerMode = "UNORDERED_LINES"
+)
+
+// Defines values for CollaboratorRole.
+const (
+	CollaboratorRoleASSISTANT CollaboratorRole = "ASSISTANT"
+
+	CollaboratorRoleCOTEACHER CollaboratorRole = "CO_TEACHER"
+
+	CollaboratorRoleOBSERVER CollaboratorRole = "OBSERVER"
+)
+
+// Defines values for DiffLineOp.
+const (
+	DiffLineOpDELETE DiffLineOp = "DELETE"
+
+	DiffLineOpEQUAL DiffLineOp = "EQUAL"
+
+	DiffLineOpINSERT DiffLineOp = "INSERT"
+)
+
+// Defines values for EnrollmentRequestStatus.
+const (
+	EnrollmentRequestStatusAPPROVED EnrollmentRequestStatus = "APPROVED"
+
+	EnrollmentRequestStatusPENDING EnrollmentRequestStatus = "PENDING"
+
+	EnrollmentRequestStatusREJECTED EnrollmentRequestStatus = "REJECTED"
+)
+
+// Defines values for EnrollmentStatus.
+const (
+	EnrollmentStatusADDED EnrollmentStatus = "ADDED"
+
+	EnrollmentStatusALREADYENROLLED EnrollmentStatus = "ALREADY_ENROLLED"
+
+	EnrollmentStatusINVALID EnrollmentStatus = "INVALID"
+
+	EnrollmentStatusUNKNOWN EnrollmentStatus = "UNKNOWN"
+)
+
+// Defines values for InvitationRole.
+const (
+	InvitationRoleCOLLABORATOR InvitationRole = "COLLABORATOR"
+
+	InvitationRoleSTUDENT InvitationRole = "STUDENT"
+)
+
+// Defines values for MaterialStatus.
+const (
+	MaterialStatusHIDDEN MaterialStatus = "HIDDEN"
+
+	MaterialStatusRELEASED MaterialStatus = "RELEASED"
+
+	MaterialStatusSCHEDULED MaterialStatus = "SCHEDULED"
+)
+
 // Defines values for ResourceType.
 const (
 	ResourceTypeOTHER ResourceType = "OTHER"
@@ -29,6 +96,36 @@ const (
 	SemesterSECOND Semester = "SECOND"
 )
 
+// Defines values for StudentStatus.
+const (
+	StudentStatusACTIVE StudentStatus = "ACTIVE"
+
+	StudentStatusCOMPLETED StudentStatus = "COMPLETED"
+
+	StudentStatusWITHDRAWN StudentStatus = "WITHDRAWN"
+)
+
+// Defines values for TaskField.
+const (
+	TaskFieldChecker TaskField = "checker"
+
+	TaskFieldDeadline TaskField = "deadline"
+
+	TaskFieldDescription TaskField = "description"
+
+	TaskFieldLanguages TaskField = "languages"
+
+	TaskFieldLimits TaskField = "limits"
+
+	TaskFieldTeamWork TaskField = "teamWork"
+
+	TaskFieldTestData TaskField = "testData"
+
+	TaskFieldTestPoints TaskField = "testPoints"
+
+	TaskFieldTitle TaskField = "title"
+)
+
 // Defines values for TaskType.
 const (
 	TaskTypeAUTOCODECHECKING TaskType = "AUTO_CODE_CHECKING"
@@ -38,6 +135,13 @@ const (
 	TaskTypeTESTING TaskType = "TESTING"
 )
 
+// Defines values for TestDataVisibility.
+const (
+	TestDataVisibilityHIDDEN TestDataVisibility = "HIDDEN"
+
+	TestDataVisibilitySAMPLE TestDataVisibility = "SAMPLE"
+)
+
 // AddAutoCodeCheckingTaskRequest defines model for AddAutoCodeCheckingTaskRequest.
 type AddAutoCodeCheckingTaskRequest struct {
 	// Embedded struct due to allOf(#/components/schemas/AddTaskRequest)
@@ -49,9 +153,10 @@ type AddAutoCodeCheckingTaskRequest struct {
 // AddAuxiliaryMaterialRequest defines model for AddAuxiliaryMaterialRequest.
 type AddAuxiliaryMaterialRequest AuxiliaryMaterial
 
-// AddCollaboratorToCourseRequest defines model for AddCollaboratorToCourseRequest.
+// either id of one teacher or ids of several teachers
 type AddCollaboratorToCourseRequest struct {
-	Id string `json:"id"`
+	Id  *string   `json:"id,omitempty"`
+	Ids *[]string `json:"ids,omitempty"`
 }
 
 // AddGroupToCourseRequest defines model for AddGroupToCourseRequest.
@@ -67,14 +172,20 @@ type AddManualCheckingTaskRequest struct {
 	ManualCheckingTaskPart `yaml:",inline"`
 }
 
-// AddStudentToCourseRequest defines model for AddStudentToCourseRequest.
+// either id of one student or ids of several students
 type AddStudentToCourseRequest struct {
-	Id string `json:"id"`
+	Id  *string   `json:"id,omitempty"`
+	Ids *[]string `json:"ids,omitempty"`
 }
 
 // AddTaskRequest defines model for AddTaskRequest.
 type AddTaskRequest Task
 
+// AddTeamMembersRequest defines model for AddTeamMembersRequest.
+type AddTeamMembersRequest struct {
+	StudentIds []string `json:"studentIds"`
+}
+
 // AddTestingTaskRequest defines model for AddTestingTaskRequest.
 type AddTestingTaskRequest struct {
 	// Embedded struct due to allOf(#/components/schemas/AddTaskRequest)
@@ -85,8 +196,26 @@ type AddTestingTaskRequest struct {
 
 // AutoCodeCheckingTaskPart defines model for AutoCodeCheckingTaskPart.
 type AutoCodeCheckingTaskPart struct {
-	Deadline *Deadline   `json:"deadline,omitempty"`
+	Checker  *Checker  `json:"checker,omitempty"`
+	Deadline *Deadline `json:"deadline,omitempty"`
+
+	// number of hidden test data, property only in response
+	HiddenTestDataNumber *int `json:"hiddenTestDataNumber,omitempty"`
+
+	// ids of languages from server registry allowed for solutions, any supported language if empty
+	Languages *[]string `json:"languages,omitempty"`
+
+	// max size of solution source in kilobytes, 64 by default
+	MaxSourceSize *int `json:"maxSourceSize,omitempty"`
+
+	// memory limit of solution in megabytes, 256 by default
+	MemoryLimit *int `json:"memoryLimit,omitempty"`
+
+	// student gets only sample test data
 	TestData *[]TestData `json:"testData,omitempty"`
+
+	// time limit of solution in milliseconds, 1000 by default
+	TimeLimit *int `json:"timeLimit,omitempty"`
 }
 
 // AutoCodeCheckingTaskResponse defines model for AutoCodeCheckingTaskResponse.
@@ -99,12 +228,66 @@ type AutoCodeCheckingTaskResponse struct {
 
 // AuxiliaryMaterial defines model for AuxiliaryMaterial.
 type AuxiliaryMaterial struct {
+	// uploaded file of auxiliary material, resource is file name then
+	File *MaterialFile `json:"file,omitempty"`
+
+	// hidden material isn't released to students regardless of release time
+	Hidden *bool   `json:"hidden,omitempty"`
+	Id     *string `json:"id,omitempty"`
+
+	// time material is released to students at, material is released immediately if missing
+	ReleaseTime  *time.Time   `json:"releaseTime,omitempty"`
 	Resource     string       `json:"resource"`
 	ResourceType ResourceType `json:"resourceType"`
+
+	// whether students can get material, only released materials are shown to them
+	Status *MaterialStatus `json:"status,omitempty"`
+
+	// number of task material is attached to, material is course-level if missing
+	TaskNumber *int `json:"taskNumber,omitempty"`
+}
+
+// AuxiliaryMaterialViewsReport defines model for AuxiliaryMaterialViewsReport.
+type AuxiliaryMaterialViewsReport struct {
+	// Embedded fields due to inline allOf schema
+	MaterialId string `json:"materialId"`
+	// Embedded struct due to allOf(#/components/schemas/ItemViewsReport)
+	ItemViewsReport `yaml:",inline"`
 }
 
+// ChangeCollaboratorRoleRequest defines model for ChangeCollaboratorRoleRequest.
+type ChangeCollaboratorRoleRequest struct {
+	// CO_TEACHER can edit tasks, materials and members of course,
+	// ASSISTANT can only review and grade solutions, so also read task versions and views report,
+	// OBSERVER can only read course, but not its invitations, enrollment requests, task versions and views report
+	Role CollaboratorRole `json:"role"`
+}
+
+// Checker defines model for Checker.
+type Checker struct {
+	// EXACT compares outputs exactly, TOKENS ignores whitespace between tokens,
+	// FLOAT compares numbers with tolerance, UNORDERED_LINES ignores order of lines.
+	// EXACT by default
+	Mode CheckerMode `json:"mode"`
+
+	// allowed absolute difference of numbers, only for FLOAT mode
+	Tolerance *float64 `json:"tolerance,omitempty"`
+}
+
+// EXACT compares outputs exactly, TOKENS ignores whitespace between tokens,
+// FLOAT compares numbers with tolerance, UNORDERED_LINES ignores order of lines.
+// EXACT by default
+type CheckerMode string
+
+// CO_TEACHER can edit tasks, materials and members of course,
+// ASSISTANT can only review and grade solutions, so also read task versions and views report,
+// OBSERVER can only read course, but not its invitations, enrollment requests, task versions and views report
+type CollaboratorRole string
+
 // Course defines model for Course.
 type Course struct {
+	// maximum number of course students, absent when course has no limit
+	Capacity    *int         `json:"capacity,omitempty"`
 	CreatorId   string       `json:"creatorId"`
 	Id          string       `json:"id"`
 	Period      CoursePeriod `json:"period"`
@@ -113,6 +296,12 @@ type Course struct {
 	Title       string       `json:"title"`
 }
 
+// CourseCapacity defines model for CourseCapacity.
+type CourseCapacity struct {
+	// maximum number of course students, 0 means no limit
+	Capacity int `json:"capacity"`
+}
+
 // CoursePeriod defines model for CoursePeriod.
 type CoursePeriod struct {
 	AcademicEndYear   int      `json:"academicEndYear"`
@@ -120,6 +309,12 @@ type CoursePeriod struct {
 	Semester          Semester `json:"semester"`
 }
 
+// CourseViewsReportResponse defines model for CourseViewsReportResponse.
+type CourseViewsReportResponse struct {
+	AuxiliaryMaterials []AuxiliaryMaterialViewsReport `json:"auxiliaryMaterials"`
+	Tasks              []TaskViewsReport              `json:"tasks"`
+}
+
 // CreateCourseRequest defines model for CreateCourseRequest.
 type CreateCourseRequest struct {
 	Period  CoursePeriod `json:"period"`
@@ -127,12 +322,49 @@ type CreateCourseRequest struct {
 	Title   string       `json:"title"`
 }
 
+// CreateInvitationRequest defines model for CreateInvitationRequest.
+type CreateInvitationRequest struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	MaxUses   int       `json:"maxUses"`
+
+	// student joins as student and teacher joins as collaborator
+	Role InvitationRole `json:"role"`
+}
+
+// CreateInvitationResponse defines model for CreateInvitationResponse.
+type CreateInvitationResponse struct {
+	Code string `json:"code"`
+}
+
+// CreateTeamRequest defines model for CreateTeamRequest.
+type CreateTeamRequest struct {
+	MemberIds *[]string `json:"memberIds,omitempty"`
+	Name      string    `json:"name"`
+}
+
+// CreateTeamResponse defines model for CreateTeamResponse.
+type CreateTeamResponse struct {
+	Id string `json:"id"`
+}
+
 // Deadline defines model for Deadline.
 type Deadline struct {
 	ExcellentGradeTime openapi_types.Date `json:"excellentGradeTime"`
 	GoodGradeTime      openapi_types.Date `json:"goodGradeTime"`
 }
 
+// DiffLine defines model for DiffLine.
+type DiffLine struct {
+	Op   DiffLineOp `json:"op"`
+	Text string     `json:"text"`
+}
+
+// DiffLineOp defines model for DiffLine.Op.
+type DiffLineOp string
+
+// EditAuxiliaryMaterialRequest defines model for EditAuxiliaryMaterialRequest.
+type EditAuxiliaryMaterialRequest AuxiliaryMaterial
+
 // EditCourseRequest defines model for EditCourseRequest.
 type EditCourseRequest struct {
 	Period  *CoursePeriod `json:"period,omitempty"`
@@ -140,6 +372,65 @@ type EditCourseRequest struct {
 	Title   *string       `json:"title,omitempty"`
 }
 
+// only given properties are replaced, missing execution limits are default when any limit is given
+type EditTaskRequest struct {
+	Checker  *Checker  `json:"checker,omitempty"`
+	Deadline *Deadline `json:"deadline,omitempty"`
+
+	// task statement in Markdown
+	Description   *string      `json:"description,omitempty"`
+	Languages     *[]string    `json:"languages,omitempty"`
+	MaxSourceSize *int         `json:"maxSourceSize,omitempty"`
+	MemoryLimit   *int         `json:"memoryLimit,omitempty"`
+	Points        *[]TestPoint `json:"points,omitempty"`
+	TeamWork      *bool        `json:"teamWork,omitempty"`
+	TestData      *[]TestData  `json:"testData,omitempty"`
+	TimeLimit     *int         `json:"timeLimit,omitempty"`
+	Title         *string      `json:"title,omitempty"`
+}
+
+// EnrollStudentsRequest defines model for EnrollStudentsRequest.
+type EnrollStudentsRequest []string
+
+// EnrollStudentsResponse defines model for EnrollStudentsResponse.
+type EnrollStudentsResponse struct {
+	Rows []EnrollmentRow `json:"rows"`
+}
+
+// EnrollmentRequest defines model for EnrollmentRequest.
+type EnrollmentRequest struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	Id         string     `json:"id"`
+	Message    *string    `json:"message,omitempty"`
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+
+	// teacher who approved or rejected request
+	ReviewerId *string                 `json:"reviewerId,omitempty"`
+	Status     EnrollmentRequestStatus `json:"status"`
+	StudentId  string                  `json:"studentId"`
+}
+
+// EnrollmentRequestStatus defines model for EnrollmentRequestStatus.
+type EnrollmentRequestStatus string
+
+// EnrollmentRow defines model for EnrollmentRow.
+type EnrollmentRow struct {
+	// number of row starting from 1, CSV header isn't counted
+	Row int `json:"row"`
+
+	// in dry run ADDED means that student would be added,
+	// UNKNOWN is ID or email of student that academics service doesn't know,
+	// INVALID is neither ID nor email, e.g. empty or with spaces
+	Status    EnrollmentStatus `json:"status"`
+	StudentId *string          `json:"studentId,omitempty"`
+	Value     string           `json:"value"`
+}
+
+// in dry run ADDED means that student would be added,
+// UNKNOWN is ID or email of student that academics service doesn't know,
+// INVALID is neither ID nor email, e.g. empty or with spaces
+type EnrollmentStatus string
+
 // Error defines model for Error.
 type Error struct {
 	Details string `json:"details"`
@@ -165,9 +456,63 @@ type GetAllCourseStudentsResponse []Student
 // GetAllCoursesResponse defines model for GetAllCoursesResponse.
 type GetAllCoursesResponse []Course
 
+// GetAllInvitationsResponse defines model for GetAllInvitationsResponse.
+type GetAllInvitationsResponse []Invitation
+
+// GetAllTeamsResponse defines model for GetAllTeamsResponse.
+type GetAllTeamsResponse struct {
+	// Embedded struct due to allOf(#/components/schemas/TeamSettings)
+	TeamSettings `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Teams []Team `json:"teams"`
+}
+
 // GetCourseResponse defines model for GetCourseResponse.
 type GetCourseResponse Course
 
+// GetCourseWaitlistResponse defines model for GetCourseWaitlistResponse.
+type GetCourseWaitlistResponse struct {
+	// Embedded struct due to allOf(#/components/schemas/CourseCapacity)
+	CourseCapacity `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Entries    []WaitlistEntry     `json:"entries"`
+	Promotions []WaitlistPromotion `json:"promotions"`
+}
+
+// GetEnrollmentRequestsResponse defines model for GetEnrollmentRequestsResponse.
+type GetEnrollmentRequestsResponse []EnrollmentRequest
+
+// GetTaskVersionsResponse defines model for GetTaskVersionsResponse.
+type GetTaskVersionsResponse []TaskVersion
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	MaxUses   int       `json:"maxUses"`
+
+	// student joins as student and teacher joins as collaborator
+	Role InvitationRole `json:"role"`
+
+	// number of academics who have joined course with invitation
+	Uses int `json:"uses"`
+}
+
+// student joins as student and teacher joins as collaborator
+type InvitationRole string
+
+// ItemViewsReport defines model for ItemViewsReport.
+type ItemViewsReport struct {
+	// ids of current students who never opened item
+	NotViewedStudents []string `json:"notViewedStudents"`
+
+	// title of task or resource of auxiliary material
+	Title string `json:"title"`
+
+	// number of current students who opened item at least once
+	UniqueViewers int `json:"uniqueViewers"`
+}
+
 // ManualCheckingTaskPart defines model for ManualCheckingTaskPart.
 type ManualCheckingTaskPart struct {
 	Deadline *Deadline `json:"deadline,omitempty"`
@@ -181,51 +526,196 @@ type ManualCheckingTaskResponse struct {
 	ManualCheckingTaskPart `yaml:",inline"`
 }
 
+// uploaded file of auxiliary material, resource is file name then
+type MaterialFile struct {
+	// content type detected from file content
+	ContentType string `json:"contentType"`
+	Name        string `json:"name"`
+
+	// file size in bytes
+	Size int64 `json:"size"`
+}
+
+// whether students can get material, only released materials are shown to them
+type MaterialStatus string
+
+// RedeemInvitationRequest defines model for RedeemInvitationRequest.
+type RedeemInvitationRequest struct {
+	Code string `json:"code"`
+}
+
+// RedeemInvitationResponse defines model for RedeemInvitationResponse.
+type RedeemInvitationResponse struct {
+	CourseId string `json:"courseId"`
+
+	// student is put in waitlist instead of joining because course is full
+	Waitlisted bool `json:"waitlisted"`
+}
+
+// RenameTeamRequest defines model for RenameTeamRequest.
+type RenameTeamRequest struct {
+	Name string `json:"name"`
+}
+
+// ReorderAuxiliaryMaterialsRequest defines model for ReorderAuxiliaryMaterialsRequest.
+type ReorderAuxiliaryMaterialsRequest struct {
+	// IDs of all auxiliary materials of course in new order
+	MaterialIds []string `json:"materialIds"`
+}
+
+// RequestEnrollmentRequest defines model for RequestEnrollmentRequest.
+type RequestEnrollmentRequest struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// RequestEnrollmentResponse defines model for RequestEnrollmentResponse.
+type RequestEnrollmentResponse struct {
+	Id string `json:"id"`
+}
+
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// ReviewEnrollmentRequestsRequest defines model for ReviewEnrollmentRequestsRequest.
+type ReviewEnrollmentRequestsRequest struct {
+	Ids []string `json:"ids"`
+}
+
 // Semester defines model for Semester.
 type Semester string
 
 // Student defines model for Student.
 type Student struct {
-	FullName string `json:"fullName"`
-	Id       string `json:"id"`
+	// absent for students enrolled before enrollment history was kept
+	EnrolledAt       *time.Time     `json:"enrolledAt,omitempty"`
+	FullName         string         `json:"fullName"`
+	Id               string         `json:"id"`
+	Status           *StudentStatus `json:"status,omitempty"`
+	WithdrawalReason *string        `json:"withdrawalReason,omitempty"`
+	WithdrawnAt      *time.Time     `json:"withdrawnAt,omitempty"`
 }
 
+// StudentStatus defines model for StudentStatus.
+type StudentStatus string
+
 // Task defines model for Task.
 type Task struct {
-	Description string   `json:"description"`
-	Title       string   `json:"title"`
-	Type        TaskType `json:"type"`
+	// task statement in Markdown, formulas are written between $ or $$
+	Description string `json:"description"`
+
+	// solution of team task submitted by student counts for whole team of student
+	TeamWork *bool    `json:"teamWork,omitempty"`
+	Title    string   `json:"title"`
+	Type     TaskType `json:"type"`
 }
 
+// TaskField defines model for TaskField.
+type TaskField string
+
 // TaskResponse defines model for TaskResponse.
 type TaskResponse struct {
 	// Embedded struct due to allOf(#/components/schemas/Task)
 	Task `yaml:",inline"`
 	// Embedded fields due to inline allOf schema
-	Number int `json:"number"`
+	// auxiliary materials attached to task
+	AuxiliaryMaterials *[]AuxiliaryMaterial `json:"auxiliaryMaterials,omitempty"`
+
+	// sanitized HTML rendered from Markdown description, formulas are wrapped
+	// into span with math inline or math display class for KaTeX or MathJax
+	DescriptionHtml *string `json:"descriptionHtml,omitempty"`
+	Number          int     `json:"number"`
+
+	// number of task version, graded results should refer to it
+	Version *int `json:"version,omitempty"`
 }
 
 // TaskType defines model for TaskType.
 type TaskType string
 
+// TaskVersion defines model for TaskVersion.
+type TaskVersion struct {
+	// id of teacher who created or edited task
+	AuthorId  string    `json:"authorId"`
+	CreatedAt time.Time `json:"createdAt"`
+	Title     string    `json:"title"`
+	Version   int       `json:"version"`
+}
+
+// TaskVersionResponse defines model for TaskVersionResponse.
+type TaskVersionResponse struct {
+	// Embedded struct due to allOf(#/components/schemas/TaskVersion)
+	TaskVersion `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Task TaskResponse `json:"task"`
+}
+
+// TaskVersionsDiffResponse defines model for TaskVersionsDiffResponse.
+type TaskVersionsDiffResponse struct {
+	ChangedFields   []TaskField         `json:"changedFields"`
+	DescriptionDiff []DiffLine          `json:"descriptionDiff"`
+	From            TaskVersionResponse `json:"from"`
+	To              TaskVersionResponse `json:"to"`
+}
+
+// TaskViewsReport defines model for TaskViewsReport.
+type TaskViewsReport struct {
+	// Embedded fields due to inline allOf schema
+	TaskNumber int `json:"taskNumber"`
+	// Embedded struct due to allOf(#/components/schemas/ItemViewsReport)
+	ItemViewsReport `yaml:",inline"`
+}
+
 // Teacher defines model for Teacher.
 type Teacher struct {
 	FullName string `json:"fullName"`
 	Id       string `json:"id"`
+
+	// CO_TEACHER can edit tasks, materials and members of course,
+	// ASSISTANT can only review and grade solutions, so also read task versions and views report,
+	// OBSERVER can only read course, but not its invitations, enrollment requests, task versions and views report
+	Role CollaboratorRole `json:"role"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Id        string   `json:"id"`
+	MemberIds []string `json:"memberIds"`
+	Name      string   `json:"name"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// maximum number of team members, 0 means no limit
+	MaxSize int `json:"maxSize"`
+
+	// students create, rename, join and leave teams by themselves
+	SelfFormation bool `json:"selfFormation"`
 }
 
 // TestData defines model for TestData.
 type TestData struct {
-	// property not required in response for student, but required for creation
+	// optional explanation of test, for example of sample output
+	Explanation *string `json:"explanation,omitempty"`
+
+	// property required for creation unless inputDataId is given
 	InputData *string `json:"inputData,omitempty"`
 
-	// property not required in response for student, but required for creation
+	// id of uploaded test data used as input instead of inputData
+	InputDataId *string `json:"inputDataId,omitempty"`
+
+	// property required for creation unless outputDataId is given
 	OutputData *string `json:"outputData,omitempty"`
+
+	// id of uploaded test data used as output instead of outputData
+	OutputDataId *string `json:"outputDataId,omitempty"`
+
+	// sample test data are shown to students, hidden are used only for checking, HIDDEN by default
+	Visibility *TestDataVisibility `json:"visibility,omitempty"`
 }
 
+// sample test data are shown to students, hidden are used only for checking, HIDDEN by default
+type TestDataVisibility string
+
 // TestPoint defines model for TestPoint.
 type TestPoint struct {
 	// property not required in response for student, but required for creation
@@ -250,6 +740,34 @@ type TestingTaskResponse struct {
 	TestingTaskPart `yaml:",inline"`
 }
 
+// UploadAuxiliaryMaterialFileRequest defines model for UploadAuxiliaryMaterialFileRequest.
+type UploadAuxiliaryMaterialFileRequest struct {
+	File string `json:"file"`
+
+	// hidden material isn't released to students regardless of release time
+	Hidden *bool `json:"hidden,omitempty"`
+
+	// time material is released to students at, material is released immediately if missing
+	ReleaseTime  *time.Time   `json:"releaseTime,omitempty"`
+	ResourceType ResourceType `json:"resourceType"`
+
+	// number of task to attach material to, material is course-level if missing
+	TaskNumber *int `json:"taskNumber,omitempty"`
+}
+
+// WaitlistEntry defines model for WaitlistEntry.
+type WaitlistEntry struct {
+	StudentId    string    `json:"studentId"`
+	WaitlistedAt time.Time `json:"waitlistedAt"`
+}
+
+// WaitlistPromotion defines model for WaitlistPromotion.
+type WaitlistPromotion struct {
+	PromotedAt   time.Time `json:"promotedAt"`
+	StudentId    string    `json:"studentId"`
+	WaitlistedAt time.Time `json:"waitlistedAt"`
+}
+
 // GetAllCoursesParams defines parameters for GetAllCourses.
 type GetAllCoursesParams struct {
 	// course title substring for filtering
@@ -266,29 +784,95 @@ type EditCourseJSONBody EditCourseRequest
 type GetAllCourseAuxiliaryMaterialsParams struct {
 	// resource type for filtering
 	ResourceType *ResourceType `json:"resourceType,omitempty"`
+
+	// number of task for filtering materials attached to it
+	TaskNumber *int `json:"taskNumber,omitempty"`
 }
 
 // AttachAuxiliaryMaterialToCourseJSONBody defines parameters for AttachAuxiliaryMaterialToCourse.
 type AttachAuxiliaryMaterialToCourseJSONBody AddAuxiliaryMaterialRequest
 
+// ReorderAuxiliaryMaterialsJSONBody defines parameters for ReorderAuxiliaryMaterials.
+type ReorderAuxiliaryMaterialsJSONBody ReorderAuxiliaryMaterialsRequest
+
+// EditAuxiliaryMaterialJSONBody defines parameters for EditAuxiliaryMaterial.
+type EditAuxiliaryMaterialJSONBody EditAuxiliaryMaterialRequest
+
+// ChangeCourseCapacityJSONBody defines parameters for ChangeCourseCapacity.
+type ChangeCourseCapacityJSONBody CourseCapacity
+
+// GetAllCourseCollaboratorsParams defines parameters for GetAllCourseCollaborators.
+type GetAllCourseCollaboratorsParams struct {
+	// page number starting from 1
+	Page *int `json:"page,omitempty"`
+
+	// number of collaborators on page
+	PerPage *int `json:"perPage,omitempty"`
+}
+
 // AddCollaboratorToCourseJSONBody defines parameters for AddCollaboratorToCourse.
 type AddCollaboratorToCourseJSONBody AddCollaboratorToCourseRequest
 
+// ChangeCollaboratorRoleJSONBody defines parameters for ChangeCollaboratorRole.
+type ChangeCollaboratorRoleJSONBody ChangeCollaboratorRoleRequest
+
+// GetEnrollmentRequestsParams defines parameters for GetEnrollmentRequests.
+type GetEnrollmentRequestsParams struct {
+	// status of requests, requests of any status are returned if it's omitted
+	Status *EnrollmentRequestStatus `json:"status,omitempty"`
+}
+
+// RequestEnrollmentJSONBody defines parameters for RequestEnrollment.
+type RequestEnrollmentJSONBody RequestEnrollmentRequest
+
+// ApproveEnrollmentRequestsJSONBody defines parameters for ApproveEnrollmentRequests.
+type ApproveEnrollmentRequestsJSONBody ReviewEnrollmentRequestsRequest
+
+// RejectEnrollmentRequestsJSONBody defines parameters for RejectEnrollmentRequests.
+type RejectEnrollmentRequestsJSONBody ReviewEnrollmentRequestsRequest
+
 // ExtendCourseJSONBody defines parameters for ExtendCourse.
 type ExtendCourseJSONBody ExtendCourseRequest
 
 // AddGroupToCourseJSONBody defines parameters for AddGroupToCourse.
 type AddGroupToCourseJSONBody AddGroupToCourseRequest
 
+// CreateInvitationJSONBody defines parameters for CreateInvitation.
+type CreateInvitationJSONBody CreateInvitationRequest
+
 // GetAllCourseStudentsParams defines parameters for GetAllCourseStudents.
 type GetAllCourseStudentsParams struct {
 	// student full name substring for filtering
 	FullName *string `json:"fullName,omitempty"`
+
+	// include withdrawn students, ignored for students
+	IncludeWithdrawn *bool `json:"includeWithdrawn,omitempty"`
+
+	// page number starting from 1
+	Page *int `json:"page,omitempty"`
+
+	// number of students on page
+	PerPage *int `json:"perPage,omitempty"`
 }
 
 // AddStudentToCourseJSONBody defines parameters for AddStudentToCourse.
 type AddStudentToCourseJSONBody AddStudentToCourseRequest
 
+// EnrollStudentsToCourseJSONBody defines parameters for EnrollStudentsToCourse.
+type EnrollStudentsToCourseJSONBody EnrollStudentsRequest
+
+// EnrollStudentsToCourseParams defines parameters for EnrollStudentsToCourse.
+type EnrollStudentsToCourseParams struct {
+	// build report without changing course
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// RemoveStudentFromCourseParams defines parameters for RemoveStudentFromCourse.
+type RemoveStudentFromCourseParams struct {
+	// reason of withdrawal
+	Reason *string `json:"reason,omitempty"`
+}
+
 // GetCourseTasksParams defines parameters for GetCourseTasks.
 type GetCourseTasksParams struct {
 	// type of task for filtering
@@ -301,6 +885,33 @@ type GetCourseTasksParams struct {
 // AddTaskToCourseJSONBody defines parameters for AddTaskToCourse.
 type AddTaskToCourseJSONBody interface{}
 
+// EditCourseTaskJSONBody defines parameters for EditCourseTask.
+type EditCourseTaskJSONBody EditTaskRequest
+
+// GetCourseTaskVersionsDiffParams defines parameters for GetCourseTaskVersionsDiff.
+type GetCourseTaskVersionsDiffParams struct {
+	// number of older task version
+	From int `json:"from"`
+
+	// number of newer task version
+	To int `json:"to"`
+}
+
+// CreateTeamJSONBody defines parameters for CreateTeam.
+type CreateTeamJSONBody CreateTeamRequest
+
+// ChangeTeamSettingsJSONBody defines parameters for ChangeTeamSettings.
+type ChangeTeamSettingsJSONBody TeamSettings
+
+// RenameTeamJSONBody defines parameters for RenameTeam.
+type RenameTeamJSONBody RenameTeamRequest
+
+// AddTeamMembersJSONBody defines parameters for AddTeamMembers.
+type AddTeamMembersJSONBody AddTeamMembersRequest
+
+// RedeemInvitationJSONBody defines parameters for RedeemInvitation.
+type RedeemInvitationJSONBody RedeemInvitationRequest
+
 // CreateCourseJSONRequestBody defines body for CreateCourse for application/json ContentType.
 type CreateCourseJSONRequestBody CreateCourseJSONBody
 
@@ -310,17 +921,62 @@ type EditCourseJSONRequestBody EditCourseJSONBody
 // AttachAuxiliaryMaterialToCourseJSONRequestBody defines body for AttachAuxiliaryMaterialToCourse for application/json ContentType.
 type AttachAuxiliaryMaterialToCourseJSONRequestBody AttachAuxiliaryMaterialToCourseJSONBody
 
+// ReorderAuxiliaryMaterialsJSONRequestBody defines body for ReorderAuxiliaryMaterials for application/json ContentType.
+type ReorderAuxiliaryMaterialsJSONRequestBody ReorderAuxiliaryMaterialsJSONBody
+
+// EditAuxiliaryMaterialJSONRequestBody defines body for EditAuxiliaryMaterial for application/json ContentType.
+type EditAuxiliaryMaterialJSONRequestBody EditAuxiliaryMaterialJSONBody
+
+// ChangeCourseCapacityJSONRequestBody defines body for ChangeCourseCapacity for application/json ContentType.
+type ChangeCourseCapacityJSONRequestBody ChangeCourseCapacityJSONBody
+
 // AddCollaboratorToCourseJSONRequestBody defines body for AddCollaboratorToCourse for application/json ContentType.
 type AddCollaboratorToCourseJSONRequestBody AddCollaboratorToCourseJSONBody
 
+// ChangeCollaboratorRoleJSONRequestBody defines body for ChangeCollaboratorRole for application/json ContentType.
+type ChangeCollaboratorRoleJSONRequestBody ChangeCollaboratorRoleJSONBody
+
+// RequestEnrollmentJSONRequestBody defines body for RequestEnrollment for application/json ContentType.
+type RequestEnrollmentJSONRequestBody RequestEnrollmentJSONBody
+
+// ApproveEnrollmentRequestsJSONRequestBody defines body for ApproveEnrollmentRequests for application/json ContentType.
+type ApproveEnrollmentRequestsJSONRequestBody ApproveEnrollmentRequestsJSONBody
+
+// RejectEnrollmentRequestsJSONRequestBody defines body for RejectEnrollmentRequests for application/json ContentType.
+type RejectEnrollmentRequestsJSONRequestBody RejectEnrollmentRequestsJSONBody
+
 // ExtendCourseJSONRequestBody defines body for ExtendCourse for application/json ContentType.
 type ExtendCourseJSONRequestBody ExtendCourseJSONBody
 
 // AddGroupToCourseJSONRequestBody defines body for AddGroupToCourse for application/json ContentType.
 type AddGroupToCourseJSONRequestBody AddGroupToCourseJSONBody
 
+// CreateInvitationJSONRequestBody defines body for CreateInvitation for application/json ContentType.
+type CreateInvitationJSONRequestBody CreateInvitationJSONBody
+
 // AddStudentToCourseJSONRequestBody defines body for AddStudentToCourse for application/json ContentType.
 type AddStudentToCourseJSONRequestBody AddStudentToCourseJSONBody
 
+// EnrollStudentsToCourseJSONRequestBody defines body for EnrollStudentsToCourse for application/json ContentType.
+type EnrollStudentsToCourseJSONRequestBody EnrollStudentsToCourseJSONBody
+
 // AddTaskToCourseJSONRequestBody defines body for AddTaskToCourse for application/json ContentType.
 type AddTaskToCourseJSONRequestBody AddTaskToCourseJSONBody
+
+// EditCourseTaskJSONRequestBody defines body for EditCourseTask for application/json ContentType.
+type EditCourseTaskJSONRequestBody EditCourseTaskJSONBody
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody CreateTeamJSONBody
+
+// ChangeTeamSettingsJSONRequestBody defines body for ChangeTeamSettings for application/json ContentType.
+type ChangeTeamSettingsJSONRequestBody ChangeTeamSettingsJSONBody
+
+// RenameTeamJSONRequestBody defines body for RenameTeam for application/json ContentType.
+type RenameTeamJSONRequestBody RenameTeamJSONBody
+
+// AddTeamMembersJSONRequestBody defines body for AddTeamMembers for application/json ContentType.
+type AddTeamMembersJSONRequestBody AddTeamMembersJSONBody
+
+// RedeemInvitationJSONRequestBody defines body for RedeemInvitation for application/json ContentType.
+type RedeemInvitationJSONRequestBody RedeemInvitationJSONBody
@@ -10,8 +10,29 @@ import (
 	"github.com/authena-ru/courses-organization/pkg/httperr"
 )
 
-func (h handler) GetAllCourseStudents(w http.ResponseWriter, _ *http.Request, _ string, _ GetAllCourseStudentsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (h handler) GetAllCourseStudents(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetAllCourseStudentsParams,
+) {
+	qry, ok := unmarshalAllCourseStudentsQuery(w, r, courseID, params)
+	if !ok {
+		return
+	}
+
+	page, err := h.app.Queries.AllCourseStudents.Handle(r.Context(), qry)
+	if err == nil {
+		marshalStudentsPage(w, r, page)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
 }
 
 func (h handler) AddStudentToCourse(w http.ResponseWriter, r *http.Request, courseID string) {
@@ -40,6 +61,55 @@ func (h handler) AddStudentToCourse(w http.ResponseWriter, r *http.Request, cour
 		return
 	}
 
+	if course.IsCourseIsFullError(err) {
+		httperr.UnprocessableEntity("course-is-full", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) EnrollStudentsToCourse(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params EnrollStudentsToCourseParams,
+) {
+	cmd, ok := unmarshalEnrollStudentsCommand(w, r, courseID, params)
+	if !ok {
+		return
+	}
+
+	rows, err := h.app.Commands.EnrollStudents.Handle(r.Context(), cmd)
+	if err == nil {
+		marshalEnrollmentRows(w, r, rows)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrEnrollmentTooLarge) {
+		httperr.RequestEntityTooLarge("enrollment-too-large", err, w, r)
+
+		return
+	}
+
+	if course.IsCourseIsFullError(err) {
+		httperr.UnprocessableEntity("course-is-full", err, w, r)
+
+		return
+	}
+
 	if course.IsAcademicCantEditCourseError(err) {
 		httperr.Forbidden("academic-cant-edit-course", err, w, r)
 
@@ -49,8 +119,11 @@ func (h handler) AddStudentToCourse(w http.ResponseWriter, r *http.Request, cour
 	httperr.InternalServerError("unexpected-error", err, w, r)
 }
 
-func (h handler) RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request, courseID string, studentID string) {
-	cmd, ok := unmarshalRemoveStudentCommand(w, r, courseID, studentID)
+func (h handler) RemoveStudentFromCourse(
+	w http.ResponseWriter, r *http.Request,
+	courseID, studentID string, params RemoveStudentFromCourseParams,
+) {
+	cmd, ok := unmarshalRemoveStudentCommand(w, r, courseID, studentID, params)
 	if !ok {
 		return
 	}
@@ -62,6 +135,38 @@ func (h handler) RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	if errors.Is(err, course.ErrWithdrawalReasonTooLong) {
+		httperr.UnprocessableEntity("invalid-withdrawal-reason", err, w, r)
+
+		return
+	}
+
+	respondStudentCommandError(err, w, r)
+}
+
+func (h handler) CompleteCourseStudent(w http.ResponseWriter, r *http.Request, courseID, studentID string) {
+	cmd, ok := unmarshalCompleteStudentCommand(w, r, courseID, studentID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.CompleteStudent.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrStudentAlreadyCompleted) {
+		httperr.UnprocessableEntity("student-already-completed", err, w, r)
+
+		return
+	}
+
+	respondStudentCommandError(err, w, r)
+}
+
+func respondStudentCommandError(err error, w http.ResponseWriter, r *http.Request) {
 	if errors.Is(err, app.ErrCourseDoesntExist) {
 		httperr.NotFound("course-not-found", err, w, r)
 
@@ -82,7 +187,3 @@ func (h handler) RemoveStudentFromCourse(w http.ResponseWriter, r *http.Request,
 
 	httperr.InternalServerError("unexpected-error", err, w, r)
 }
-
-func (h handler) AddGroupToCourse(w http.ResponseWriter, _ *http.Request, _ string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
@@ -6,15 +6,188 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
 )
 
+func TestHandler_GetAllCourseStudents(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "0b6f7a4e-1c2d-4e3f-8a9b-5c6d7e8f9a0b"
+
+	testCases := []struct {
+		Name           string
+		Target         string
+		Authorized     course.Academic
+		Query          app.AllCourseStudentsQuery
+		PrepareHandler func(t *testing.T, expectedQuery app.AllCourseStudentsQuery) qmock.AllCourseStudentsHandler
+		StatusCode     int
+		ResponseBody   string
+		TotalCount     string
+	}{
+		{
+			Name:       "obtain_students",
+			Target:     fmt.Sprintf("/courses/%s/students?fullName=iv&page=2&perPage=1", courseID),
+			Authorized: course.MustNewAcademic("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b", course.TeacherType),
+			Query: app.AllCourseStudentsQuery{
+				Academic:   course.MustNewAcademic("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b", course.TeacherType),
+				CourseID:   courseID,
+				FullName:   "iv",
+				Pagination: app.Pagination{Page: 2, PerPage: 1},
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.AllCourseStudentsQuery,
+			) qmock.AllCourseStudentsHandler {
+				return func(_ context.Context, givenQuery app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.AcademicsPage{
+						Academics: []app.AcademicProfile{
+							{ID: "798155cb-91b7-41d4-9f91-a1970339707e", FullName: "Sergey Ivanov"},
+						},
+						Total: 2,
+					}, nil
+				}
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `[{"id": "798155cb-91b7-41d4-9f91-a1970339707e", "fullName": "Sergey Ivanov"}]`,
+			TotalCount:   "2",
+		},
+		{
+			Name:       "default_pagination",
+			Target:     fmt.Sprintf("/courses/%s/students", courseID),
+			Authorized: course.MustNewAcademic("4c3b2a19-0f8e-4d7c-9b6a-5f4e3d2c1b0a", course.StudentType),
+			Query: app.AllCourseStudentsQuery{
+				Academic:   course.MustNewAcademic("4c3b2a19-0f8e-4d7c-9b6a-5f4e3d2c1b0a", course.StudentType),
+				CourseID:   courseID,
+				Pagination: app.Pagination{Page: 1, PerPage: app.DefaultPerPage},
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.AllCourseStudentsQuery,
+			) qmock.AllCourseStudentsHandler {
+				return func(_ context.Context, givenQuery app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.AcademicsPage{
+						Academics: []app.AcademicProfile{
+							{ID: "4c3b2a19-0f8e-4d7c-9b6a-5f4e3d2c1b0a"},
+						},
+						Total: 1,
+					}, nil
+				}
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: `[{"id": "4c3b2a19-0f8e-4d7c-9b6a-5f4e3d2c1b0a", "fullName": ""}]`,
+			TotalCount:   "1",
+		},
+		{
+			Name:       "include_withdrawn_students",
+			Target:     fmt.Sprintf("/courses/%s/students?includeWithdrawn=true", courseID),
+			Authorized: course.MustNewAcademic("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b", course.TeacherType),
+			Query: app.AllCourseStudentsQuery{
+				Academic:         course.MustNewAcademic("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b", course.TeacherType),
+				CourseID:         courseID,
+				IncludeWithdrawn: true,
+				Pagination:       app.Pagination{Page: 1, PerPage: app.DefaultPerPage},
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.AllCourseStudentsQuery,
+			) qmock.AllCourseStudentsHandler {
+				return func(_ context.Context, givenQuery app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.AcademicsPage{
+						Academics: []app.AcademicProfile{
+							{
+								ID:       "2f3e4d5c-6b7a-4898-a7b6-c5d4e3f2a1b0",
+								FullName: "Anna Petrova",
+								Enrollment: &app.StudentEnrollment{
+									StudentID:   "2f3e4d5c-6b7a-4898-a7b6-c5d4e3f2a1b0",
+									Status:      course.WithdrawnStudent,
+									EnrolledAt:  time.Date(2021, time.September, 1, 9, 0, 0, 0, time.UTC),
+									WithdrawnAt: time.Date(2021, time.October, 15, 12, 30, 0, 0, time.UTC),
+									Reason:      "Moved to another university",
+								},
+							},
+						},
+						Total: 1,
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[{
+				"id": "2f3e4d5c-6b7a-4898-a7b6-c5d4e3f2a1b0",
+				"fullName": "Anna Petrova",
+				"status": "WITHDRAWN",
+				"enrolledAt": "2021-09-01T09:00:00Z",
+				"withdrawnAt": "2021-10-15T12:30:00Z",
+				"withdrawalReason": "Moved to another university"
+			}]`,
+			TotalCount: "1",
+		},
+		{
+			Name:       "invalid_pagination",
+			Target:     fmt.Sprintf("/courses/%s/students?perPage=1000", courseID),
+			Authorized: course.MustNewAcademic("1d2c3b4a-5e6f-4a7b-8c9d-0e1f2a3b4c5d", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ app.AllCourseStudentsQuery) qmock.AllCourseStudentsHandler {
+				return func(_ context.Context, _ app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+					return app.AcademicsPage{}, errors.New("query shouldn't be handled")
+				}
+			},
+			StatusCode: http.StatusBadRequest,
+			ResponseBody: `{
+				"slug": "invalid-pagination",
+				"details": "page should be positive and perPage should be from 1 to 100"
+			}`,
+		},
+		{
+			Name:       "course_not_found",
+			Target:     fmt.Sprintf("/courses/%s/students", courseID),
+			Authorized: course.MustNewAcademic("6a5b4c3d-2e1f-4a0b-9c8d-7e6f5a4b3c2d", course.StudentType),
+			PrepareHandler: func(_ *testing.T, _ app.AllCourseStudentsQuery) qmock.AllCourseStudentsHandler {
+				return func(_ context.Context, _ app.AllCourseStudentsQuery) (app.AcademicsPage, error) {
+					return app.AcademicsPage{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					AllCourseStudents: c.PrepareHandler(t, c.Query),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, c.Target, "", c.Authorized)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+			require.Equal(t, c.TotalCount, w.Header().Get("X-Total-Count"))
+		})
+	}
+}
+
 func TestHandler_AddStudentToCourse(t *testing.T) {
 	t.Parallel()
 
@@ -35,9 +208,9 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
 			CourseID:    "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
-				CourseID:  "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
-				StudentID: "473d6e1c-2d3d-4a40-9a95-4037edaa33e3",
+				Academic:   course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
+				CourseID:   "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
+				StudentIDs: []string{"473d6e1c-2d3d-4a40-9a95-4037edaa33e3"},
 			},
 			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
 				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
@@ -48,6 +221,46 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			},
 			StatusCode: http.StatusNoContent,
 		},
+		{
+			Name: "several_students_added_to_course",
+			RequestBody: `{
+				"id": "473d6e1c-2d3d-4a40-9a95-4037edaa33e3",
+				"ids": ["1b0e9c55-0e2f-4a68-9e3c-5f1b1ad2c7b4", "c5a7d3f2-9b8e-4c61-a0d4-2e7f6b5c3a19"]
+			}`,
+			Authorized: course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
+			CourseID:   "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
+			Command: app.AddStudentCommand{
+				Academic: course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
+				CourseID: "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
+				StudentIDs: []string{
+					"473d6e1c-2d3d-4a40-9a95-4037edaa33e3",
+					"1b0e9c55-0e2f-4a68-9e3c-5f1b1ad2c7b4",
+					"c5a7d3f2-9b8e-4c61-a0d4-2e7f6b5c3a19",
+				},
+			},
+			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
+				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "academic_ids_missing",
+			RequestBody: `{}`,
+			Authorized:  course.MustNewAcademic("70e8ed77-09d2-43c1-82da-deacd58facd6", course.TeacherType),
+			CourseID:    "2e5d112c-987c-4ac1-8c54-9ad1e54eb408",
+			PrepareHandler: func(_ app.AddStudentCommand) mock.AddStudentHandler {
+				return func(_ context.Context, _ app.AddStudentCommand) error {
+					return errors.New("command shouldn't be handled")
+				}
+			},
+			StatusCode:           http.StatusBadRequest,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-ids-missing", "details": "id or ids should be given"}`,
+		},
 		{
 			Name:        "bad_request",
 			RequestBody: `{"id": 123434}`,
@@ -71,9 +284,9 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("8994a75f-9706-4a95-8404-4fb894c5b23a", course.TeacherType),
 			CourseID:    "3e0126e1-891e-4813-b65f-4ab8147efdc9",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("8994a75f-9706-4a95-8404-4fb894c5b23a", course.TeacherType),
-				CourseID:  "3e0126e1-891e-4813-b65f-4ab8147efdc9",
-				StudentID: "7a91cda0-6097-44ae-a9c8-286a0121c6c2",
+				Academic:   course.MustNewAcademic("8994a75f-9706-4a95-8404-4fb894c5b23a", course.TeacherType),
+				CourseID:   "3e0126e1-891e-4813-b65f-4ab8147efdc9",
+				StudentIDs: []string{"7a91cda0-6097-44ae-a9c8-286a0121c6c2"},
 			},
 			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
 				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
@@ -92,9 +305,9 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("f83dc0a4-8839-44f5-9b5f-e21e29ff7b90", course.TeacherType),
 			CourseID:    "20757653-0def-4329-aac5-f80d5aff9f99",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("f83dc0a4-8839-44f5-9b5f-e21e29ff7b90", course.TeacherType),
-				CourseID:  "20757653-0def-4329-aac5-f80d5aff9f99",
-				StudentID: "e3b527cf-7acb-4b92-8773-b706eea40efa",
+				Academic:   course.MustNewAcademic("f83dc0a4-8839-44f5-9b5f-e21e29ff7b90", course.TeacherType),
+				CourseID:   "20757653-0def-4329-aac5-f80d5aff9f99",
+				StudentIDs: []string{"e3b527cf-7acb-4b92-8773-b706eea40efa"},
 			},
 			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
 				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
@@ -113,9 +326,9 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("ab3f3c6e-a2bf-4776-a0df-91941e62f1c7", course.TeacherType),
 			CourseID:    "e3f1b7cc-6c64-4454-9534-ffe5d254a7de",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("ab3f3c6e-a2bf-4776-a0df-91941e62f1c7", course.TeacherType),
-				CourseID:  "e3f1b7cc-6c64-4454-9534-ffe5d254a7de",
-				StudentID: "6f690f76-6e86-4201-a56b-d0ee270a9928",
+				Academic:   course.MustNewAcademic("ab3f3c6e-a2bf-4776-a0df-91941e62f1c7", course.TeacherType),
+				CourseID:   "e3f1b7cc-6c64-4454-9534-ffe5d254a7de",
+				StudentIDs: []string{"6f690f76-6e86-4201-a56b-d0ee270a9928"},
 			},
 			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
 				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
@@ -134,9 +347,9 @@ func TestHandler_AddStudentToCourse(t *testing.T) {
 			Authorized:  course.MustNewAcademic("ec24ea6f-3393-4372-80c7-77609450de9b", course.TeacherType),
 			CourseID:    "a913ad8a-e892-435d-8d91-5ca78fc8488d",
 			Command: app.AddStudentCommand{
-				Academic:  course.MustNewAcademic("ec24ea6f-3393-4372-80c7-77609450de9b", course.TeacherType),
-				CourseID:  "a913ad8a-e892-435d-8d91-5ca78fc8488d",
-				StudentID: "9ca7f110-ff81-4f11-a795-fa853c5aabcc",
+				Academic:   course.MustNewAcademic("ec24ea6f-3393-4372-80c7-77609450de9b", course.TeacherType),
+				CourseID:   "a913ad8a-e892-435d-8d91-5ca78fc8488d",
+				StudentIDs: []string{"9ca7f110-ff81-4f11-a795-fa853c5aabcc"},
 			},
 			PrepareHandler: func(expectedCommand app.AddStudentCommand) mock.AddStudentHandler {
 				return func(_ context.Context, givenCommand app.AddStudentCommand) error {
@@ -189,6 +402,7 @@ func TestHandler_RemoveStudentFromCourse(t *testing.T) {
 		Authorized           course.Academic
 		CourseID             string
 		StudentID            string
+		Query                string
 		Command              app.RemoveStudentCommand
 		PrepareHandler       func(expectedCommand app.RemoveStudentCommand) mock.RemoveStudentHandler
 		StatusCode           int
@@ -214,6 +428,41 @@ func TestHandler_RemoveStudentFromCourse(t *testing.T) {
 			},
 			StatusCode: http.StatusNoContent,
 		},
+		{
+			Name:       "student_withdrawn_with_reason",
+			Authorized: course.MustNewAcademic("4eff6499-fbde-4090-a552-293be8053e53", course.TeacherType),
+			CourseID:   "8c4d464c-420e-4eb3-9bfa-94cf8a97883a",
+			StudentID:  "7ef909eb-38be-4531-9055-2ed3ad79a260",
+			Query:      "?reason=Academic%20leave",
+			Command: app.RemoveStudentCommand{
+				Academic:  course.MustNewAcademic("4eff6499-fbde-4090-a552-293be8053e53", course.TeacherType),
+				CourseID:  "8c4d464c-420e-4eb3-9bfa-94cf8a97883a",
+				StudentID: "7ef909eb-38be-4531-9055-2ed3ad79a260",
+				Reason:    "Academic leave",
+			},
+			PrepareHandler: func(expectedCommand app.RemoveStudentCommand) mock.RemoveStudentHandler {
+				return func(_ context.Context, givenCommand app.RemoveStudentCommand) error {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "invalid_withdrawal_reason",
+			Authorized: course.MustNewAcademic("4eff6499-fbde-4090-a552-293be8053e53", course.TeacherType),
+			CourseID:   "8c4d464c-420e-4eb3-9bfa-94cf8a97883a",
+			StudentID:  "7ef909eb-38be-4531-9055-2ed3ad79a260",
+			PrepareHandler: func(_ app.RemoveStudentCommand) mock.RemoveStudentHandler {
+				return func(_ context.Context, _ app.RemoveStudentCommand) error {
+					return course.ErrWithdrawalReasonTooLong
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-withdrawal-reason", "details": "withdrawal reason too long"}`,
+		},
 		{
 			Name:       "course_not_found",
 			Authorized: course.MustNewAcademic("76d00409-08b1-4c3e-b81b-2a0bbb73683e", course.TeacherType),
@@ -313,7 +562,100 @@ func TestHandler_RemoveStudentFromCourse(t *testing.T) {
 			w := httptest.NewRecorder()
 			r := newHTTPRequest(
 				t,
-				http.MethodDelete, fmt.Sprintf("/courses/%s/students/%s",
+				http.MethodDelete, fmt.Sprintf("/courses/%s/students/%s%s",
+					c.CourseID, c.StudentID, c.Query), "", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equal(t, c.StatusCode, w.Code)
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_CompleteCourseStudent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		Authorized           course.Academic
+		CourseID             string
+		StudentID            string
+		Command              app.CompleteStudentCommand
+		PrepareHandler       func(expectedCommand app.CompleteStudentCommand) mock.CompleteStudentHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:       "student_completed_course",
+			Authorized: course.MustNewAcademic("0d9c8b7a-6f5e-4d3c-8b2a-1f0e9d8c7b6a", course.TeacherType),
+			CourseID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+			StudentID:  "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+			Command: app.CompleteStudentCommand{
+				Academic:  course.MustNewAcademic("0d9c8b7a-6f5e-4d3c-8b2a-1f0e9d8c7b6a", course.TeacherType),
+				CourseID:  "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				StudentID: "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+			},
+			PrepareHandler: func(expectedCommand app.CompleteStudentCommand) mock.CompleteStudentHandler {
+				return func(_ context.Context, givenCommand app.CompleteStudentCommand) error {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "student_already_completed",
+			Authorized: course.MustNewAcademic("0d9c8b7a-6f5e-4d3c-8b2a-1f0e9d8c7b6a", course.TeacherType),
+			CourseID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+			StudentID:  "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+			PrepareHandler: func(_ app.CompleteStudentCommand) mock.CompleteStudentHandler {
+				return func(_ context.Context, _ app.CompleteStudentCommand) error {
+					return course.ErrStudentAlreadyCompleted
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "student-already-completed", "details": "student has already completed course"}`,
+		},
+		{
+			Name:       "course_student_not_found",
+			Authorized: course.MustNewAcademic("0d9c8b7a-6f5e-4d3c-8b2a-1f0e9d8c7b6a", course.TeacherType),
+			CourseID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+			StudentID:  "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+			PrepareHandler: func(_ app.CompleteStudentCommand) mock.CompleteStudentHandler {
+				return func(_ context.Context, _ app.CompleteStudentCommand) error {
+					return course.ErrCourseHasNoSuchStudent
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "course-student-not-found", "details": "course has no such student"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					CompleteStudent: c.PrepareHandler(c.Command),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/students/%s/completed",
 					c.CourseID, c.StudentID), "", c.Authorized,
 			)
 
@@ -327,3 +669,167 @@ func TestHandler_RemoveStudentFromCourse(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_EnrollStudentsToCourse(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "5d0c3a7e-8f21-4b6a-9c3d-2e1f0a9b8c7d"
+
+	teacher := course.MustNewAcademic("c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		Target         string
+		ContentType    string
+		RequestBody    string
+		Command        app.EnrollStudentsCommand
+		PrepareHandler func(t *testing.T, expectedCommand app.EnrollStudentsCommand) mock.EnrollStudentsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "enroll_from_csv_with_header",
+			Target:      fmt.Sprintf("/courses/%s/students/enrollment", courseID),
+			ContentType: "text/csv; charset=utf-8",
+			RequestBody: "email,name\nivan@example.com,Ivan\n 4f3e2d1c-0b9a-4876-a5b4-c3d2e1f0a9b8 ,Petr\n",
+			Command: app.EnrollStudentsCommand{
+				Academic: teacher,
+				CourseID: courseID,
+				Rows:     []string{"ivan@example.com", "4f3e2d1c-0b9a-4876-a5b4-c3d2e1f0a9b8"},
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedCommand app.EnrollStudentsCommand,
+			) mock.EnrollStudentsHandler {
+				return func(_ context.Context, givenCommand app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return []app.EnrollmentRow{
+						{
+							Number:    1,
+							Value:     "ivan@example.com",
+							StudentID: "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+							Status:    app.EnrollmentAdded,
+						},
+						{Number: 2, Value: "4f3e2d1c-0b9a-4876-a5b4-c3d2e1f0a9b8", Status: app.EnrollmentUnknown},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{"rows": [
+				{
+					"row": 1,
+					"value": "ivan@example.com",
+					"studentId": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+					"status": "ADDED"
+				},
+				{"row": 2, "value": "4f3e2d1c-0b9a-4876-a5b4-c3d2e1f0a9b8", "status": "UNKNOWN"}
+			]}`,
+		},
+		{
+			Name:        "dry_run_from_json",
+			Target:      fmt.Sprintf("/courses/%s/students/enrollment?dryRun=true", courseID),
+			ContentType: "application/json",
+			RequestBody: `["2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e", "not an email"]`,
+			Command: app.EnrollStudentsCommand{
+				Academic: teacher,
+				CourseID: courseID,
+				Rows:     []string{"2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e", "not an email"},
+				DryRun:   true,
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedCommand app.EnrollStudentsCommand,
+			) mock.EnrollStudentsHandler {
+				return func(_ context.Context, givenCommand app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+					require.Equal(t, expectedCommand, givenCommand)
+
+					return []app.EnrollmentRow{
+						{
+							Number:    1,
+							Value:     "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+							StudentID: "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+							Status:    app.EnrollmentAlreadyEnrolled,
+						},
+						{Number: 2, Value: "not an email", Status: app.EnrollmentInvalid},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{"rows": [
+				{
+					"row": 1,
+					"value": "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+					"studentId": "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+					"status": "ALREADY_ENROLLED"
+				},
+				{"row": 2, "value": "not an email", "status": "INVALID"}
+			]}`,
+		},
+		{
+			Name:        "unsupported_rows_type",
+			Target:      fmt.Sprintf("/courses/%s/students/enrollment", courseID),
+			ContentType: "text/plain",
+			RequestBody: "ivan@example.com",
+			PrepareHandler: func(_ *testing.T, _ app.EnrollStudentsCommand) mock.EnrollStudentsHandler {
+				return func(_ context.Context, _ app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+					return nil, errors.New("command shouldn't be handled")
+				}
+			},
+			StatusCode: http.StatusUnsupportedMediaType,
+			ResponseBody: `{
+				"slug": "unsupported-rows-type",
+				"details": "enrollment rows should be text/csv or application/json"
+			}`,
+		},
+		{
+			Name:        "too_many_rows",
+			Target:      fmt.Sprintf("/courses/%s/students/enrollment", courseID),
+			ContentType: "application/json",
+			RequestBody: `["ivan@example.com"]`,
+			PrepareHandler: func(_ *testing.T, _ app.EnrollStudentsCommand) mock.EnrollStudentsHandler {
+				return func(_ context.Context, _ app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+					return nil, app.ErrEnrollmentTooLarge
+				}
+			},
+			StatusCode:   http.StatusRequestEntityTooLarge,
+			ResponseBody: `{"slug": "enrollment-too-large", "details": "bulk enrollment has too many rows"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			Target:      fmt.Sprintf("/courses/%s/students/enrollment", courseID),
+			ContentType: "application/json",
+			RequestBody: `["ivan@example.com"]`,
+			PrepareHandler: func(_ *testing.T, _ app.EnrollStudentsCommand) mock.EnrollStudentsHandler {
+				return func(_ context.Context, _ app.EnrollStudentsCommand) ([]app.EnrollmentRow, error) {
+					return nil, course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:   http.StatusForbidden,
+			ResponseBody: `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					EnrollStudents: c.PrepareHandler(t, c.Command),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodPost, c.Target, c.RequestBody, teacher)
+			r.Header.Set("Content-Type", c.ContentType)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) GetCourseTaskVersions(w http.ResponseWriter, r *http.Request, courseID string, taskNumber int) {
+	qry, ok := unmarshalTaskVersionsQuery(w, r, courseID, taskNumber)
+	if !ok {
+		return
+	}
+
+	versions, err := h.app.Queries.TaskVersions.Handle(r.Context(), qry)
+	if err == nil {
+		marshalGeneralTaskVersions(w, r, versions)
+
+		return
+	}
+
+	respondTaskVersionQueryError(err, w, r)
+}
+
+func (h handler) GetCourseTaskVersion(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, taskNumber int, version int,
+) {
+	qry, ok := unmarshalSpecificTaskVersionQuery(w, r, courseID, taskNumber, version)
+	if !ok {
+		return
+	}
+
+	taskVersion, err := h.app.Queries.SpecificTaskVersion.Handle(r.Context(), qry)
+	if err == nil {
+		marshalTaskVersion(w, r, taskVersion)
+
+		return
+	}
+
+	respondTaskVersionQueryError(err, w, r)
+}
+
+func (h handler) GetCourseTaskVersionsDiff(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, taskNumber int, params GetCourseTaskVersionsDiffParams,
+) {
+	qry, ok := unmarshalTaskVersionsDiffQuery(w, r, courseID, taskNumber, params)
+	if !ok {
+		return
+	}
+
+	diff, err := h.app.Queries.TaskVersionsDiff.Handle(r.Context(), qry)
+	if err == nil {
+		marshalTaskVersionsDiff(w, r, diff)
+
+		return
+	}
+
+	respondTaskVersionQueryError(err, w, r)
+}
+
+func respondTaskVersionQueryError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrTaskDoesntExist) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrTaskVersionDoesntExist) {
+		httperr.NotFound("task-version-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) RestoreCourseTaskVersion(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, taskNumber int, version int,
+) {
+	cmd, ok := unmarshalRestoreTaskVersionCommand(w, r, courseID, taskNumber, version)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RestoreTaskVersion.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTask) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrTaskHasNoSuchVersion) {
+		httperr.NotFound("task-version-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
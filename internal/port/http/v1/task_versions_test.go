@@ -0,0 +1,363 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	cmock "github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/textdiff"
+)
+
+func TestHandler_GetCourseTaskVersions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+		taskNumber = 1
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T) qmock.TaskVersionsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "versions_found",
+			Authorized: course.MustNewAcademic("8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e", course.TeacherType),
+			PrepareHandler: func(t *testing.T) qmock.TaskVersionsHandler {
+				return func(_ context.Context, qry app.TaskVersionsQuery) ([]app.GeneralTaskVersion, error) {
+					require.Equal(t, courseID, qry.CourseID)
+					require.Equal(t, taskNumber, qry.TaskNumber)
+
+					return []app.GeneralTaskVersion{
+						{
+							Version:   1,
+							AuthorID:  "8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e",
+							CreatedAt: time.Date(2043, time.September, 1, 10, 0, 0, 0, time.UTC),
+							Title:     "Binary search",
+						},
+						{
+							Version:   2,
+							AuthorID:  "9c0d1e2f-3a4b-4c5d-8e6f-7a8b9c0d1e2f",
+							CreatedAt: time.Date(2043, time.September, 3, 12, 30, 0, 0, time.UTC),
+							Title:     "Binary search on answer",
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `[
+				{
+					"version": 1,
+					"authorId": "8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e",
+					"createdAt": "2043-09-01T10:00:00Z",
+					"title": "Binary search"
+				},
+				{
+					"version": 2,
+					"authorId": "9c0d1e2f-3a4b-4c5d-8e6f-7a8b9c0d1e2f",
+					"createdAt": "2043-09-03T12:30:00Z",
+					"title": "Binary search on answer"
+				}
+			]`,
+		},
+		{
+			Name:       "student_cant_see_versions",
+			Authorized: course.MustNewAcademic("0d1e2f3a-4b5c-4d6e-9f7a-8b9c0d1e2f3a", course.StudentType),
+			PrepareHandler: func(_ *testing.T) qmock.TaskVersionsHandler {
+				return func(_ context.Context, _ app.TaskVersionsQuery) ([]app.GeneralTaskVersion, error) {
+					return nil, app.ErrTaskVersionDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "task-version-not-found", "details": "task version doesn't exist"}`,
+		},
+		{
+			Name:       "task_not_found",
+			Authorized: course.MustNewAcademic("1e2f3a4b-5c6d-4e7f-8a8b-9c0d1e2f3a4b", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) qmock.TaskVersionsHandler {
+				return func(_ context.Context, _ app.TaskVersionsQuery) ([]app.GeneralTaskVersion, error) {
+					return nil, app.ErrTaskDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-task-not-found", "details": "course task doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					TaskVersions: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/tasks/%d/versions", courseID, taskNumber),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_GetCourseTaskVersionsDiff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "2f3a4b5c-6d7e-4f8a-9b0c-1d2e3f4a5b6c"
+		taskNumber = 3
+		authorID   = "3a4b5c6d-7e8f-4a9b-8c1d-2e3f4a5b6c7d"
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		PrepareHandler func(t *testing.T) qmock.TaskVersionsDiffHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "versions_compared",
+			Authorized: course.MustNewAcademic(authorID, course.TeacherType),
+			PrepareHandler: func(t *testing.T) qmock.TaskVersionsDiffHandler {
+				return func(_ context.Context, qry app.TaskVersionsDiffQuery) (app.TaskVersionsDiff, error) {
+					require.Equal(t, courseID, qry.CourseID)
+					require.Equal(t, taskNumber, qry.TaskNumber)
+					require.Equal(t, 1, qry.FromVersion)
+					require.Equal(t, 2, qry.ToVersion)
+
+					createdAt := time.Date(2043, time.October, 5, 0, 0, 0, 0, time.UTC)
+
+					return app.TaskVersionsDiff{
+						From: app.TaskVersion{
+							Version:   1,
+							AuthorID:  authorID,
+							CreatedAt: createdAt,
+							Task: app.SpecificTask{
+								Number:          taskNumber,
+								Title:           "Graphs",
+								Description:     "Find path",
+								DescriptionHTML: "<p>Find path</p>\n",
+								Type:            course.ManualCheckingType,
+								Version:         1,
+							},
+						},
+						To: app.TaskVersion{
+							Version:   2,
+							AuthorID:  authorID,
+							CreatedAt: createdAt.Add(time.Hour),
+							Task: app.SpecificTask{
+								Number:          taskNumber,
+								Title:           "Graphs",
+								Description:     "Find shortest path",
+								DescriptionHTML: "<p>Find shortest path</p>\n",
+								Type:            course.ManualCheckingType,
+								Version:         2,
+							},
+						},
+						ChangedFields: []app.TaskField{app.TaskDescriptionField},
+						DescriptionDiff: []textdiff.Line{
+							{Op: textdiff.Delete, Text: "Find path"},
+							{Op: textdiff.Insert, Text: "Find shortest path"},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"from": {
+					"version": 1,
+					"authorId": "3a4b5c6d-7e8f-4a9b-8c1d-2e3f4a5b6c7d",
+					"createdAt": "2043-10-05T00:00:00Z",
+					"title": "Graphs",
+					"task": {
+						"number": 3,
+						"title": "Graphs",
+						"description": "Find path",
+						"descriptionHtml": "<p>Find path</p>\n",
+						"type": "MANUAL_CHECKING",
+						"version": 1
+					}
+				},
+				"to": {
+					"version": 2,
+					"authorId": "3a4b5c6d-7e8f-4a9b-8c1d-2e3f4a5b6c7d",
+					"createdAt": "2043-10-05T01:00:00Z",
+					"title": "Graphs",
+					"task": {
+						"number": 3,
+						"title": "Graphs",
+						"description": "Find shortest path",
+						"descriptionHtml": "<p>Find shortest path</p>\n",
+						"type": "MANUAL_CHECKING",
+						"version": 2
+					}
+				},
+				"changedFields": ["description"],
+				"descriptionDiff": [
+					{"op": "DELETE", "text": "Find path"},
+					{"op": "INSERT", "text": "Find shortest path"}
+				]
+			}`,
+		},
+		{
+			Name:       "version_not_found",
+			Authorized: course.MustNewAcademic(authorID, course.TeacherType),
+			PrepareHandler: func(_ *testing.T) qmock.TaskVersionsDiffHandler {
+				return func(_ context.Context, _ app.TaskVersionsDiffQuery) (app.TaskVersionsDiff, error) {
+					return app.TaskVersionsDiff{}, app.ErrTaskVersionDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "task-version-not-found", "details": "task version doesn't exist"}`,
+		},
+		{
+			Name:       "unexpected_error",
+			Authorized: course.MustNewAcademic(authorID, course.TeacherType),
+			PrepareHandler: func(_ *testing.T) qmock.TaskVersionsDiffHandler {
+				return func(_ context.Context, _ app.TaskVersionsDiffQuery) (app.TaskVersionsDiff, error) {
+					return app.TaskVersionsDiff{}, errors.New("unexpected error")
+				}
+			},
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"slug": "unexpected-error", "details": "unexpected error"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					TaskVersionsDiff: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/tasks/%d/versions-diff?from=1&to=2", courseID, taskNumber),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_RestoreCourseTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "4b5c6d7e-8f9a-4b0c-9d2e-3f4a5b6c7d8e"
+		taskNumber = 4
+		version    = 2
+	)
+
+	testCases := []struct {
+		Name                 string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.RestoreTaskVersionHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:       "version_restored",
+			Authorized: course.MustNewAcademic("5c6d7e8f-9a0b-4c1d-8e3f-4a5b6c7d8e9f", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.RestoreTaskVersionHandler {
+				return func(_ context.Context, cmd app.RestoreTaskVersionCommand) error {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, taskNumber, cmd.TaskNumber)
+					require.Equal(t, version, cmd.Version)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:       "version_not_found",
+			Authorized: course.MustNewAcademic("6d7e8f9a-0b1c-4d2e-9f4a-5b6c7d8e9f0a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.RestoreTaskVersionHandler {
+				return func(_ context.Context, _ app.RestoreTaskVersionCommand) error {
+					return course.ErrTaskHasNoSuchVersion
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "task-version-not-found", "details": "task has no such version"}`,
+		},
+		{
+			Name:       "academic_cant_edit_course",
+			Authorized: course.MustNewAcademic("7e8f9a0b-1c2d-4e3f-8a5b-6c7d8e9f0a1b", course.StudentType),
+			PrepareHandler: func(_ *testing.T) cmock.RestoreTaskVersionHandler {
+				return func(_ context.Context, _ app.RestoreTaskVersionCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					RestoreTaskVersion: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/tasks/%d/versions/%d/restored", courseID, taskNumber, version),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -31,6 +31,24 @@ func (h handler) AddTaskToCourse(w http.ResponseWriter, r *http.Request, courseI
 		return
 	}
 
+	if errors.Is(err, app.ErrTestDataDoesntExist) {
+		httperr.UnprocessableEntity("test-data-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrLanguageNotSupported) {
+		httperr.UnprocessableEntity("language-not-supported", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidLanguagesError(err) {
+		httperr.UnprocessableEntity("invalid-languages", err, w, r)
+
+		return
+	}
+
 	if course.IsInvalidTaskParametersError(err) {
 		httperr.UnprocessableEntity("invalid-task-parameters", err, w, r)
 
@@ -95,3 +113,67 @@ func (h handler) GetCourseTask(w http.ResponseWriter, r *http.Request, courseID
 
 	httperr.InternalServerError("unexpected-error", err, w, r)
 }
+
+func (h handler) EditCourseTask(w http.ResponseWriter, r *http.Request, courseID string, taskNumber int) {
+	cmd, ok := unmarshalEditTaskCommand(w, r, courseID, taskNumber)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.EditTask.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTask) {
+		httperr.NotFound("course-task-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrTestDataDoesntExist) {
+		httperr.UnprocessableEntity("test-data-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrLanguageNotSupported) {
+		httperr.UnprocessableEntity("language-not-supported", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidLanguagesError(err) {
+		httperr.UnprocessableEntity("invalid-languages", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidTaskParametersError(err) {
+		httperr.UnprocessableEntity("invalid-task-parameters", err, w, r)
+
+		return
+	}
+
+	if course.IsTaskPropertyMismatchError(err) {
+		httperr.UnprocessableEntity("task-property-mismatch", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
@@ -97,6 +97,7 @@ func TestHandler_AddTaskToCourse(t *testing.T) {
 				"title": "Testing task title",
 				"description": "Testing task description",
 				"type": "TESTING",
+				"teamWork": true,
 				"points": [
 					{
 						"description": "test point description", 
@@ -112,6 +113,7 @@ func TestHandler_AddTaskToCourse(t *testing.T) {
 				TaskTitle:       "Testing task title",
 				TaskDescription: "Testing task description",
 				TaskType:        course.TestingType,
+				TeamWork:        true,
 				TestPoints: []course.TestPoint{
 					course.MustNewTestPoint("test point description", []string{"Yes", "No"}, []int{0}),
 				},
@@ -150,7 +152,7 @@ func TestHandler_AddTaskToCourse(t *testing.T) {
 				TaskTitle:       "Auto code checking task title",
 				TaskDescription: "Auto code checking task description",
 				TaskType:        course.AutoCodeCheckingType,
-				TestData:        []course.TestData{course.MustNewTestData("1 + 1", "2")},
+				TestData:        []course.TestData{course.MustNewTestData("1 + 1", "2", course.HiddenTestData, "")},
 				Deadline: course.MustNewDeadline(
 					time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
 					time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
@@ -266,6 +268,224 @@ func TestHandler_AddTaskToCourse(t *testing.T) {
 			ShouldBeResponseBody: true,
 			ResponseBody:         `{"slug": "invalid-test-data", "details": "test output data too long"}`,
 		},
+		{
+			Name: "auto_code_checking_task_with_sample_test_data_added_to_course",
+			RequestBody: `{
+				"title": "Sum of two numbers",
+				"description": "Print sum of two numbers",
+				"type": "AUTO_CODE_CHECKING",
+				"testData": [
+					{
+						"inputData": "2 3",
+						"outputData": "5",
+						"visibility": "SAMPLE",
+						"explanation": "2 + 3 = 5"
+					},
+					{
+						"inputData": "-1 1",
+						"outputData": "0",
+						"visibility": "HIDDEN"
+					}
+				]
+			}`,
+			Authorized: course.MustNewAcademic("aa9e2cf2-1ad7-4a5c-bd78-3e29e1e3a1f5", course.TeacherType),
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("aa9e2cf2-1ad7-4a5c-bd78-3e29e1e3a1f5", course.TeacherType),
+				CourseID:        courseID,
+				TaskTitle:       "Sum of two numbers",
+				TaskDescription: "Print sum of two numbers",
+				TaskType:        course.AutoCodeCheckingType,
+				TestData: []course.TestData{
+					course.MustNewTestData("2 3", "5", course.SampleTestData, "2 + 3 = 5"),
+					course.MustNewTestData("-1 1", "0", course.HiddenTestData, ""),
+				},
+			},
+			PrepareHandler: func(expectedCommand app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, givenCommand app.AddTaskCommand) (int, error) {
+					requireAddTaskCommandsEquals(t, expectedCommand, givenCommand)
+
+					return 4, nil
+				}
+			},
+			StatusCode:         http.StatusCreated,
+			ExpectedTaskNumber: 4,
+		},
+		{
+			Name: "invalid_test_data_visibility",
+			RequestBody: `{
+				"title": "Test task",
+				"description": "Test task description",
+				"type": "AUTO_CODE_CHECKING",
+				"testData": [
+					{
+						"inputData": "2 * 2",
+						"outputData": "4",
+						"visibility": "PUBLIC"
+					}
+				]
+			}`,
+			Authorized: course.MustNewAcademic("7d1c1d34-7a8e-4f0e-9b0b-2b7c2cf0e5a8", course.TeacherType),
+			PrepareHandler: func(_ app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, _ app.AddTaskCommand) (int, error) {
+					return 0, nil
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-test-data", "details": "invalid test data visibility"}`,
+		},
+		{
+			Name: "auto_code_checking_task_with_stored_test_data_and_checker_added_to_course",
+			RequestBody: `{
+				"title": "Sum of many numbers",
+				"description": "Print sum of numbers with precision 0.001",
+				"type": "AUTO_CODE_CHECKING",
+				"testData": [
+					{
+						"inputDataId": "61a4e5a8f1f1d1b5f9a1c001",
+						"outputDataId": "61a4e5a8f1f1d1b5f9a1c002"
+					}
+				],
+				"checker": {
+					"mode": "FLOAT",
+					"tolerance": 0.001
+				}
+			}`,
+			Authorized: course.MustNewAcademic("5f8a7ad0-6c7e-4e57-bd4a-0a2d8a1b6e0c", course.TeacherType),
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("5f8a7ad0-6c7e-4e57-bd4a-0a2d8a1b6e0c", course.TeacherType),
+				CourseID:        courseID,
+				TaskTitle:       "Sum of many numbers",
+				TaskDescription: "Print sum of numbers with precision 0.001",
+				TaskType:        course.AutoCodeCheckingType,
+				TestData: []course.TestData{
+					course.MustNewStoredTestData(
+						"61a4e5a8f1f1d1b5f9a1c001", "61a4e5a8f1f1d1b5f9a1c002",
+						course.HiddenTestData, "",
+					),
+				},
+				Checker: course.MustNewChecker(course.FloatChecker, 0.001),
+			},
+			PrepareHandler: func(expectedCommand app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, givenCommand app.AddTaskCommand) (int, error) {
+					requireAddTaskCommandsEquals(t, expectedCommand, givenCommand)
+
+					return 5, nil
+				}
+			},
+			StatusCode:         http.StatusCreated,
+			ExpectedTaskNumber: 5,
+		},
+		{
+			Name: "stored_test_data_not_found",
+			RequestBody: `{
+				"title": "Task with lost tests",
+				"description": "Tests were never uploaded",
+				"type": "AUTO_CODE_CHECKING",
+				"testData": [
+					{
+						"inputDataId": "61a4e5a8f1f1d1b5f9a1c003",
+						"outputDataId": "61a4e5a8f1f1d1b5f9a1c004"
+					}
+				]
+			}`,
+			Authorized: course.MustNewAcademic("0b7f3c2e-41a5-4c0e-a6f4-5a3d2c1b0e9f", course.TeacherType),
+			PrepareHandler: func(_ app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, _ app.AddTaskCommand) (int, error) {
+					return 0, app.ErrTestDataDoesntExist
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "test-data-not-found", "details": "stored test data doesn't exist"}`,
+		},
+		{
+			Name: "invalid_checker",
+			RequestBody: `{
+				"title": "Task with strange checker",
+				"description": "Tolerance is only for floats",
+				"type": "AUTO_CODE_CHECKING",
+				"checker": {
+					"mode": "TOKENS",
+					"tolerance": 0.5
+				}
+			}`,
+			Authorized: course.MustNewAcademic("d2c4b6a8-0e1f-4a3b-9c5d-7e6f8a9b0c1d", course.TeacherType),
+			PrepareHandler: func(_ app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, _ app.AddTaskCommand) (int, error) {
+					return 0, nil
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-checker", "details": "checker tolerance is only for float mode"}`,
+		},
+		{
+			Name: "auto_code_checking_task_with_languages_and_limits_added_to_course",
+			RequestBody: `{
+				"title": "Shortest path",
+				"description": "Find shortest path in graph",
+				"type": "AUTO_CODE_CHECKING",
+				"languages": ["go", "cpp"],
+				"timeLimit": 2000,
+				"memoryLimit": 64
+			}`,
+			Authorized: course.MustNewAcademic("c7d8e9f0-1a2b-4c3d-8e4f-5a6b7c8d9e0f", course.TeacherType),
+			Command: app.AddTaskCommand{
+				Academic:        course.MustNewAcademic("c7d8e9f0-1a2b-4c3d-8e4f-5a6b7c8d9e0f", course.TeacherType),
+				CourseID:        courseID,
+				TaskTitle:       "Shortest path",
+				TaskDescription: "Find shortest path in graph",
+				TaskType:        course.AutoCodeCheckingType,
+				Languages:       []string{"go", "cpp"},
+				Limits:          course.MustNewExecutionLimits(2*time.Second, 64<<20, 64<<10),
+			},
+			PrepareHandler: func(expectedCommand app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, givenCommand app.AddTaskCommand) (int, error) {
+					requireAddTaskCommandsEquals(t, expectedCommand, givenCommand)
+
+					return 6, nil
+				}
+			},
+			StatusCode:         http.StatusCreated,
+			ExpectedTaskNumber: 6,
+		},
+		{
+			Name: "language_not_supported",
+			RequestBody: `{
+				"title": "Hello, world",
+				"description": "Print hello world",
+				"type": "AUTO_CODE_CHECKING",
+				"languages": ["brainfuck"]
+			}`,
+			Authorized: course.MustNewAcademic("1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6", course.TeacherType),
+			PrepareHandler: func(_ app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, _ app.AddTaskCommand) (int, error) {
+					return 0, app.ErrLanguageNotSupported
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "language-not-supported", "details": "language isn't supported"}`,
+		},
+		{
+			Name: "invalid_execution_limits",
+			RequestBody: `{
+				"title": "Endless task",
+				"description": "Runs forever",
+				"type": "AUTO_CODE_CHECKING",
+				"timeLimit": 3600000
+			}`,
+			Authorized: course.MustNewAcademic("8a9b0c1d-2e3f-4a5b-9c6d-7e8f9a0b1c2d", course.TeacherType),
+			PrepareHandler: func(_ app.AddTaskCommand) cmock.AddTaskHandler {
+				return func(_ context.Context, _ app.AddTaskCommand) (int, error) {
+					return 0, nil
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "invalid-execution-limits", "details": "invalid time limit"}`,
+		},
 		{
 			Name: "invalid_test_point",
 			RequestBody: `{
@@ -429,22 +649,25 @@ func TestHandler_GetCourseTasks(t *testing.T) {
 
 					return []app.GeneralTask{
 						{
-							Number:      1,
-							Title:       "Manual checking task #1",
-							Description: "Manual checking task #1 description",
-							Type:        course.ManualCheckingType,
+							Number:          1,
+							Title:           "Manual checking task #1",
+							Description:     "Manual checking task #1 description",
+							DescriptionHTML: "<p>Manual checking task #1 description</p>\n",
+							Type:            course.ManualCheckingType,
 						},
 						{
-							Number:      2,
-							Title:       "Testing task #2",
-							Description: "Testing task #2 description",
-							Type:        course.TestingType,
+							Number:          2,
+							Title:           "Testing task #2",
+							Description:     "Testing task #2 description",
+							DescriptionHTML: "<p>Testing task #2 description</p>\n",
+							Type:            course.TestingType,
 						},
 						{
-							Number:      3,
-							Title:       "Auto code checking task #3",
-							Description: "Auto code checking task #3 description",
-							Type:        course.AutoCodeCheckingType,
+							Number:          3,
+							Title:           "Auto code checking task #3",
+							Description:     "Auto code checking task #3 description",
+							DescriptionHTML: "<p>Auto code checking task #3 description</p>\n",
+							Type:            course.AutoCodeCheckingType,
 						},
 					}, nil
 				}
@@ -456,18 +679,21 @@ func TestHandler_GetCourseTasks(t *testing.T) {
 					"number": 1,
 					"title": "Manual checking task #1",
 					"description": "Manual checking task #1 description",
+					"descriptionHtml": "<p>Manual checking task #1 description</p>\n",
 					"type": "MANUAL_CHECKING"
 				},
 				{
 					"number": 2,
 					"title": "Testing task #2",
 					"description": "Testing task #2 description",
+					"descriptionHtml": "<p>Testing task #2 description</p>\n",
 					"type": "TESTING"
 				},
 				{
 					"number": 3,
 					"title": "Auto code checking task #3",
 					"description": "Auto code checking task #3 description",
+					"descriptionHtml": "<p>Auto code checking task #3 description</p>\n",
 					"type": "AUTO_CODE_CHECKING"
 				}
 			]`,
@@ -575,14 +801,23 @@ func TestHandler_GetCourseTask(t *testing.T) {
 					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
 
 					return app.SpecificTask{
-						Number:      12,
-						Title:       "Bruh task",
-						Description: "Some interesting task",
-						Type:        course.ManualCheckingType,
+						Number:          12,
+						Title:           "Bruh task",
+						Description:     "Some interesting task",
+						DescriptionHTML: "<p>Some interesting task</p>\n",
+						Type:            course.ManualCheckingType,
 						Deadline: &app.Deadline{
 							ExcellentGradeTime: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC),
 							GoodGradeTime:      time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC),
 						},
+						Materials: []app.AuxiliaryMaterial{
+							{
+								ID:           "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+								Resource:     "https://youtu.be/bruh-task",
+								ResourceType: course.VideoResource,
+								TaskNumber:   12,
+							},
+						},
 					}, nil
 				}
 			},
@@ -592,11 +827,20 @@ func TestHandler_GetCourseTask(t *testing.T) {
 				"number": 12,
 				"title": "Bruh task",
 				"description": "Some interesting task",
+				"descriptionHtml": "<p>Some interesting task</p>\n",
 				"type": "MANUAL_CHECKING",
 				"deadline": {
 					"excellentGradeTime": "2021-02-01",
 					"goodGradeTime": "2021-02-28"
-				}
+				},
+				"auxiliaryMaterials": [
+					{
+						"id": "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+						"resource": "https://youtu.be/bruh-task",
+						"resourceType": "VIDEO",
+						"taskNumber": 12
+					}
+				]
 			}`,
 		},
 		{
@@ -613,10 +857,11 @@ func TestHandler_GetCourseTask(t *testing.T) {
 					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
 
 					return app.SpecificTask{
-						Number:      34,
-						Title:       "Some task",
-						Description: "Not interesting task",
-						Type:        course.TestingType,
+						Number:          34,
+						Title:           "Some task",
+						Description:     "Not interesting task",
+						DescriptionHTML: "<p>Not interesting task</p>\n",
+						Type:            course.TestingType,
 						Points: []app.TestPoint{
 							{
 								Description:           "2 + 2",
@@ -646,6 +891,7 @@ func TestHandler_GetCourseTask(t *testing.T) {
 				"number": 34,
 				"title": "Some task",
 				"description": "Not interesting task",
+				"descriptionHtml": "<p>Not interesting task</p>\n",
 				"type": "TESTING",
 				"points": [
 					{
@@ -683,20 +929,37 @@ func TestHandler_GetCourseTask(t *testing.T) {
 					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
 
 					return app.SpecificTask{
-						Number:      22,
-						Title:       "Bad task",
-						Description: "Bad description",
-						Type:        course.AutoCodeCheckingType,
+						Number:          22,
+						Title:           "Bad task",
+						Description:     "Bad description",
+						DescriptionHTML: "<p>Bad description</p>\n",
+						Type:            course.AutoCodeCheckingType,
 						TestData: []app.TestData{
 							{
-								InputData:  "2 + 2",
-								OutputData: "4",
+								InputData:   "2 + 2",
+								OutputData:  "4",
+								Visibility:  course.SampleTestData,
+								Explanation: "Simple math",
 							},
 							{
 								InputData:  "'2' + '2'",
 								OutputData: "'22'",
+								Visibility: course.HiddenTestData,
+							},
+							{
+								InputDataID:  "61a4e5a8f1f1d1b5f9a1c005",
+								OutputDataID: "61a4e5a8f1f1d1b5f9a1c006",
+								Visibility:   course.HiddenTestData,
 							},
 						},
+						HiddenTestDataNumber: 2,
+						Checker:              &app.Checker{Mode: course.TokensChecker},
+						Languages:            []string{"python3"},
+						Limits: &app.ExecutionLimits{
+							TimeLimit:     1500 * time.Millisecond,
+							MemoryLimit:   512 << 20,
+							MaxSourceSize: 64 << 10,
+						},
 					}, nil
 				}
 			},
@@ -706,17 +969,82 @@ func TestHandler_GetCourseTask(t *testing.T) {
 				"number": 22,
 				"title": "Bad task",
 				"description": "Bad description",
+				"descriptionHtml": "<p>Bad description</p>\n",
 				"type": "AUTO_CODE_CHECKING",
 				"testData": [
 					{
 						"inputData": "2 + 2",
-						"outputData": "4"
+						"outputData": "4",
+						"visibility": "SAMPLE",
+						"explanation": "Simple math"
 					},
 					{
 						"inputData": "'2' + '2'",
-						"outputData": "'22'"
+						"outputData": "'22'",
+						"visibility": "HIDDEN"
+					},
+					{
+						"inputDataId": "61a4e5a8f1f1d1b5f9a1c005",
+						"outputDataId": "61a4e5a8f1f1d1b5f9a1c006",
+						"visibility": "HIDDEN"
 					}
-				]
+				],
+				"hiddenTestDataNumber": 2,
+				"checker": {
+					"mode": "TOKENS"
+				},
+				"languages": ["python3"],
+				"timeLimit": 1500,
+				"memoryLimit": 512,
+				"maxSourceSize": 64
+			}`,
+		},
+		{
+			Name:                "obtain_auto_code_checking_task_for_student",
+			Authorized:          course.MustNewAcademic("4a4c4b1e-3f65-4c8e-9d4e-6d1a1b3e7f20", course.StudentType),
+			TaskNumberPathParam: 23,
+			Query: app.SpecificTaskQuery{
+				Academic:   course.MustNewAcademic("4a4c4b1e-3f65-4c8e-9d4e-6d1a1b3e7f20", course.StudentType),
+				CourseID:   courseID,
+				TaskNumber: 23,
+			},
+			PrepareHandler: func(expectedQuery app.SpecificTaskQuery) qmock.SpecificTaskHandler {
+				return func(_ context.Context, givenQuery app.SpecificTaskQuery) (app.SpecificTask, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.SpecificTask{
+						Number:          23,
+						Title:           "Fibonacci",
+						Description:     "Print n-th Fibonacci number",
+						DescriptionHTML: "<p>Print n-th Fibonacci number</p>\n",
+						Type:            course.AutoCodeCheckingType,
+						TestData: []app.TestData{
+							{
+								InputData:  "10",
+								OutputData: "55",
+								Visibility: course.SampleTestData,
+							},
+						},
+						HiddenTestDataNumber: 5,
+					}, nil
+				}
+			},
+			StatusCode:           http.StatusOK,
+			ShouldBeResponseBody: true,
+			ResponseBody: `{
+				"number": 23,
+				"title": "Fibonacci",
+				"description": "Print n-th Fibonacci number",
+				"descriptionHtml": "<p>Print n-th Fibonacci number</p>\n",
+				"type": "AUTO_CODE_CHECKING",
+				"testData": [
+					{
+						"inputData": "10",
+						"outputData": "55",
+						"visibility": "SAMPLE"
+					}
+				],
+				"hiddenTestDataNumber": 5
 			}`,
 		},
 		{
@@ -835,3 +1163,126 @@ func createGetCourseTasksQuery(t *testing.T, courseID, typeQueryParam, textQuery
 
 	return baseURL.String()
 }
+
+func TestHandler_EditCourseTask(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "6f1e2d3c-4b5a-4978-8a6b-5c4d3e2f1a0b"
+		taskNumber = 2
+	)
+
+	testCases := []struct {
+		Name                 string
+		RequestBody          string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T) cmock.EditTaskHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+	}{
+		{
+			Name:        "task_edited",
+			RequestBody: `{"title": "Heap sort", "languages": ["go"], "timeLimit": 2000}`,
+			Authorized:  course.MustNewAcademic("0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.EditTaskHandler {
+				return func(_ context.Context, cmd app.EditTaskCommand) error {
+					require.Equal(t, courseID, cmd.CourseID)
+					require.Equal(t, taskNumber, cmd.TaskNumber)
+					require.Equal(t, "Heap sort", *cmd.TaskTitle)
+					require.Nil(t, cmd.TaskDescription)
+					require.Nil(t, cmd.Deadline)
+					require.Nil(t, cmd.TestData)
+					require.Nil(t, cmd.TestPoints)
+					require.Equal(t, []string{"go"}, cmd.Languages)
+					require.Equal(t, 2*time.Second, cmd.Limits.TimeLimit())
+					require.Equal(t, course.DefaultExecutionLimits().MemoryLimit(), cmd.Limits.MemoryLimit())
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "invalid_execution_limits",
+			RequestBody: `{"timeLimit": 1}`,
+			Authorized:  course.MustNewAcademic("1c2d3e4f-5a6b-4c7d-9e8f-0a1b2c3d4e5f", course.TeacherType),
+			PrepareHandler: func(t *testing.T) cmock.EditTaskHandler {
+				return func(_ context.Context, _ app.EditTaskCommand) error {
+					t.Fatal("handler shouldn't be called")
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			Name:        "task_not_found",
+			RequestBody: `{"title": "Quick sort"}`,
+			Authorized:  course.MustNewAcademic("2d3e4f5a-6b7c-4d8e-af9a-1b2c3d4e5f6a", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.EditTaskHandler {
+				return func(_ context.Context, _ app.EditTaskCommand) error {
+					return course.ErrCourseHasNoSuchTask
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "course-task-not-found", "details": "course has no such task"}`,
+		},
+		{
+			Name:        "task_property_mismatch",
+			RequestBody: `{"points": []}`,
+			Authorized:  course.MustNewAcademic("3e4f5a6b-7c8d-4e9f-b0a1-2c3d4e5f6a7b", course.TeacherType),
+			PrepareHandler: func(_ *testing.T) cmock.EditTaskHandler {
+				return func(_ context.Context, _ app.EditTaskCommand) error {
+					return course.ErrTaskHasNoTestPoints
+				}
+			},
+			StatusCode:           http.StatusUnprocessableEntity,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "task-property-mismatch", "details": "task has no test points"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: `{"title": "Merge sort"}`,
+			Authorized:  course.MustNewAcademic("4f5a6b7c-8d9e-4f0a-81b2-3d4e5f6a7b8c", course.StudentType),
+			PrepareHandler: func(_ *testing.T) cmock.EditTaskHandler {
+				return func(_ context.Context, _ app.EditTaskCommand) error {
+					return course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					EditTask: c.PrepareHandler(t),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPatch, fmt.Sprintf("/courses/%s/tasks/%d", courseID, taskNumber),
+				c.RequestBody, c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) GetAllTeams(w http.ResponseWriter, r *http.Request, courseID string) {
+	qry, ok := unmarshalAllTeamsQuery(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	teams, err := h.app.Queries.AllTeams.Handle(r.Context(), qry)
+	if err == nil {
+		marshalTeams(w, r, teams)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) CreateTeam(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalCreateTeamCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	teamID, err := h.app.Commands.CreateTeam.Handle(r.Context(), cmd)
+	if err == nil {
+		render.Status(r, http.StatusCreated)
+		render.Respond(w, r, CreateTeamResponse{Id: teamID})
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func (h handler) ChangeTeamSettings(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalChangeTeamSettingsCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.ChangeTeamSettings.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func (h handler) RenameTeam(w http.ResponseWriter, r *http.Request, courseID, teamID string) {
+	cmd, ok := unmarshalRenameTeamCommand(w, r, courseID, teamID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RenameTeam.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func (h handler) RemoveTeam(w http.ResponseWriter, r *http.Request, courseID, teamID string) {
+	cmd, ok := unmarshalRemoveTeamCommand(w, r, courseID, teamID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RemoveTeam.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func (h handler) AddTeamMembers(w http.ResponseWriter, r *http.Request, courseID, teamID string) {
+	cmd, ok := unmarshalAddTeamMembersCommand(w, r, courseID, teamID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.AddTeamMembers.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func (h handler) RemoveTeamMember(w http.ResponseWriter, r *http.Request, courseID, teamID, studentID string) {
+	cmd, ok := unmarshalRemoveTeamMemberCommand(w, r, courseID, teamID, studentID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RemoveTeamMember.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	respondTeamCommandError(err, w, r)
+}
+
+func respondTeamCommandError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchTeam) {
+		httperr.NotFound("course-team-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrTeamSelfFormationDisabled) {
+		httperr.Forbidden("team-self-formation-disabled", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	if course.IsInvalidTeamParametersError(err) {
+		httperr.UnprocessableEntity("invalid-team-parameters", err, w, r)
+
+		return
+	}
+
+	if course.IsTeamMembershipError(err) {
+		httperr.UnprocessableEntity("invalid-team-membership", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
@@ -0,0 +1,265 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_GetAllTeams(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "3c2b1a0f-9e8d-4c7b-a6f5-e4d3c2b1a0f9"
+
+	student := course.MustNewAcademic("7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b", course.StudentType)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) qmock.AllTeamsHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "obtain_teams",
+			PrepareHandler: func(t *testing.T) qmock.AllTeamsHandler {
+				return func(_ context.Context, qry app.AllTeamsQuery) (app.Teams, error) {
+					require.Equal(t, app.AllTeamsQuery{Academic: student, CourseID: courseID}, qry)
+
+					return app.Teams{
+						SelfFormation: true,
+						MaxSize:       3,
+						Teams: []app.Team{
+							{
+								ID:        "1d2c3b4a-5f6e-4d7c-8b9a-0f1e2d3c4b5a",
+								Name:      "Falcons",
+								MemberIDs: []string{student.ID()},
+							},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"selfFormation": true,
+				"maxSize": 3,
+				"teams": [
+					{
+						"id": "1d2c3b4a-5f6e-4d7c-8b9a-0f1e2d3c4b5a",
+						"name": "Falcons",
+						"memberIds": ["7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b"]
+					}
+				]
+			}`,
+		},
+		{
+			Name: "course_not_found",
+			PrepareHandler: func(_ *testing.T) qmock.AllTeamsHandler {
+				return func(_ context.Context, _ app.AllTeamsQuery) (app.Teams, error) {
+					return app.Teams{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{AllTeams: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, fmt.Sprintf("/courses/%s/teams", courseID), "", student)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "3c2b1a0f-9e8d-4c7b-a6f5-e4d3c2b1a0f9"
+
+	teacher := course.MustNewAcademic("5a4b3c2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		PrepareHandler func(t *testing.T) mock.CreateTeamHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "team_created",
+			RequestBody: `{"name": "Owls", "memberIds": ["7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b"]}`,
+			PrepareHandler: func(t *testing.T) mock.CreateTeamHandler {
+				return func(_ context.Context, cmd app.CreateTeamCommand) (string, error) {
+					require.Equal(t, app.CreateTeamCommand{
+						Academic:  teacher,
+						CourseID:  courseID,
+						Name:      "Owls",
+						MemberIDs: []string{"7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b"},
+					}, cmd)
+
+					return "1d2c3b4a-5f6e-4d7c-8b9a-0f1e2d3c4b5a", nil
+				}
+			},
+			StatusCode:   http.StatusCreated,
+			ResponseBody: `{"id": "1d2c3b4a-5f6e-4d7c-8b9a-0f1e2d3c4b5a"}`,
+		},
+		{
+			Name:        "invalid_team_parameters",
+			RequestBody: `{"name": ""}`,
+			PrepareHandler: func(_ *testing.T) mock.CreateTeamHandler {
+				return func(_ context.Context, _ app.CreateTeamCommand) (string, error) {
+					return "", course.ErrEmptyTeamName
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-team-parameters", "details": "empty team name"}`,
+		},
+		{
+			Name:        "student_already_in_team",
+			RequestBody: `{"name": "Owls", "memberIds": ["7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b"]}`,
+			PrepareHandler: func(_ *testing.T) mock.CreateTeamHandler {
+				return func(_ context.Context, _ app.CreateTeamCommand) (string, error) {
+					return "", course.ErrStudentAlreadyInTeam
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-team-membership", "details": "student is already member of team"}`,
+		},
+		{
+			Name:        "self_formation_disabled",
+			RequestBody: `{"name": "Owls"}`,
+			PrepareHandler: func(_ *testing.T) mock.CreateTeamHandler {
+				return func(_ context.Context, _ app.CreateTeamCommand) (string, error) {
+					return "", course.ErrTeamSelfFormationDisabled
+				}
+			},
+			StatusCode: http.StatusForbidden,
+			ResponseBody: `{
+				"slug": "team-self-formation-disabled",
+				"details": "students can't form teams by themselves"
+			}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{CreateTeam: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodPost, fmt.Sprintf("/courses/%s/teams", courseID), c.RequestBody, teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_AddTeamMembers(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID = "3c2b1a0f-9e8d-4c7b-a6f5-e4d3c2b1a0f9"
+		teamID   = "1d2c3b4a-5f6e-4d7c-8b9a-0f1e2d3c4b5a"
+	)
+
+	student := course.MustNewAcademic("7e6d5c4b-3a2f-4e1d-8c0b-9a8f7e6d5c4b", course.StudentType)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) mock.AddTeamMembersHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "student_joined_team",
+			PrepareHandler: func(t *testing.T) mock.AddTeamMembersHandler {
+				return func(_ context.Context, cmd app.AddTeamMembersCommand) error {
+					require.Equal(t, app.AddTeamMembersCommand{
+						Academic:   student,
+						CourseID:   courseID,
+						TeamID:     teamID,
+						StudentIDs: []string{student.ID()},
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name: "team_not_found",
+			PrepareHandler: func(_ *testing.T) mock.AddTeamMembersHandler {
+				return func(_ context.Context, _ app.AddTeamMembersCommand) error {
+					return course.ErrCourseHasNoSuchTeam
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-team-not-found", "details": "course has no such team"}`,
+		},
+		{
+			Name: "team_is_full",
+			PrepareHandler: func(_ *testing.T) mock.AddTeamMembersHandler {
+				return func(_ context.Context, _ app.AddTeamMembersCommand) error {
+					return course.ErrTeamIsFull
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-team-membership", "details": "team is full"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{AddTeamMembers: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/teams/%s/members", courseID, teamID)
+			body := fmt.Sprintf(`{"studentIds": [%q]}`, student.ID())
+			r := newHTTPRequest(t, http.MethodPost, target, body, student)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+	"github.com/authena-ru/courses-organization/pkg/logging"
+)
+
+func (h handler) UploadTestData(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalUploadTestDataCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	testDataID, err := h.app.Commands.UploadTestData.Handle(r.Context(), cmd)
+	if err == nil {
+		w.Header().Set("Content-Location", fmt.Sprintf("/courses/%s/test-data/%s", courseID, testDataID))
+		w.WriteHeader(http.StatusCreated)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrTestDataTooLarge) {
+		httperr.RequestEntityTooLarge("test-data-too-large", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) GetStoredTestData(w http.ResponseWriter, r *http.Request, courseID, testDataID string) {
+	qry, ok := unmarshalStoredTestDataQuery(w, r, courseID, testDataID)
+	if !ok {
+		return
+	}
+
+	data, err := h.app.Queries.StoredTestData.Handle(r.Context(), qry)
+	if err == nil {
+		defer data.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+
+		if _, err := io.Copy(w, data); err != nil {
+			logging.GetLogEntry(r).WithError(err).Warn("Failed to write stored test data")
+		}
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrTestDataDoesntExist) {
+		httperr.NotFound("test-data-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
@@ -0,0 +1,249 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	cmock "github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_UploadTestData(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "1f0b6e0a-9d1c-4a55-bf38-8d5e2b6f7c31"
+
+	testCases := []struct {
+		Name                 string
+		RequestBody          string
+		Authorized           course.Academic
+		PrepareHandler       func(t *testing.T, expectedData string) cmock.UploadTestDataHandler
+		StatusCode           int
+		ShouldBeResponseBody bool
+		ResponseBody         string
+		ExpectedTestDataID   string
+	}{
+		{
+			Name:        "test_data_uploaded",
+			RequestBody: "1 2 3 4 5\n",
+			Authorized:  course.MustNewAcademic("3a2cde67-25cb-4a1d-9a0c-5ff0bb1d0e24", course.TeacherType),
+			PrepareHandler: func(t *testing.T, expectedData string) cmock.UploadTestDataHandler {
+				return func(_ context.Context, cmd app.UploadTestDataCommand) (string, error) {
+					require.Equal(t, courseID, cmd.CourseID)
+					data, err := io.ReadAll(cmd.Data)
+					require.NoError(t, err)
+					require.Equal(t, expectedData, string(data))
+
+					return "61a4e5a8f1f1d1b5f9a1c007", nil
+				}
+			},
+			StatusCode:         http.StatusCreated,
+			ExpectedTestDataID: "61a4e5a8f1f1d1b5f9a1c007",
+		},
+		{
+			Name:        "test_data_too_large",
+			RequestBody: "0 0 0 0 0",
+			Authorized:  course.MustNewAcademic("0c3b4f57-6f9e-4b43-8f3a-41b2cb1f7f55", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ string) cmock.UploadTestDataHandler {
+				return func(_ context.Context, _ app.UploadTestDataCommand) (string, error) {
+					return "", app.ErrTestDataTooLarge
+				}
+			},
+			StatusCode:           http.StatusRequestEntityTooLarge,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "test-data-too-large", "details": "stored test data too large"}`,
+		},
+		{
+			Name:        "course_not_found",
+			RequestBody: "1",
+			Authorized:  course.MustNewAcademic("c1a5b4f2-1b6f-4a8e-9f3e-2c0a3b5d6e71", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ string) cmock.UploadTestDataHandler {
+				return func(_ context.Context, _ app.UploadTestDataCommand) (string, error) {
+					return "", app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:           http.StatusNotFound,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+		{
+			Name:        "academic_cant_edit_course",
+			RequestBody: "1",
+			Authorized:  course.MustNewAcademic("7a3d1c5e-8f2b-4e6a-b9c0-1d2e3f4a5b6c", course.StudentType),
+			PrepareHandler: func(_ *testing.T, _ string) cmock.UploadTestDataHandler {
+				return func(_ context.Context, _ app.UploadTestDataCommand) (string, error) {
+					return "", course.AcademicCantEditCourseError{}
+				}
+			},
+			StatusCode:           http.StatusForbidden,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "academic-cant-edit-course", "details": "academic can't edit course"}`,
+		},
+		{
+			Name:        "unexpected_error",
+			RequestBody: "1",
+			Authorized:  course.MustNewAcademic("e3f1a2b4-5c6d-4e7f-8a9b-0c1d2e3f4a5b", course.TeacherType),
+			PrepareHandler: func(_ *testing.T, _ string) cmock.UploadTestDataHandler {
+				return func(_ context.Context, _ app.UploadTestDataCommand) (string, error) {
+					return "", errors.New("unexpected error")
+				}
+			},
+			StatusCode:           http.StatusInternalServerError,
+			ShouldBeResponseBody: true,
+			ResponseBody:         `{"slug": "unexpected-error", "details": "unexpected error"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{
+					UploadTestData: c.PrepareHandler(t, c.RequestBody),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodPost, fmt.Sprintf("/courses/%s/test-data", courseID),
+				c.RequestBody, c.Authorized,
+			)
+			r.Header.Set("Content-Type", "application/octet-stream")
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ShouldBeResponseBody {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+
+			if c.ExpectedTestDataID != "" {
+				expectedLocation := fmt.Sprintf("/courses/%s/test-data/%s", courseID, c.ExpectedTestDataID)
+				require.Equal(t, expectedLocation, w.Header().Get("Content-Location"))
+			}
+		})
+	}
+}
+
+func TestHandler_GetStoredTestData(t *testing.T) {
+	t.Parallel()
+
+	const (
+		courseID   = "a6f1e0c4-2b3d-4e5f-9a8b-7c6d5e4f3a2b"
+		testDataID = "61a4e5a8f1f1d1b5f9a1c008"
+	)
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		Query          app.StoredTestDataQuery
+		PrepareHandler func(t *testing.T, expectedQuery app.StoredTestDataQuery) qmock.StoredTestDataHandler
+		StatusCode     int
+		ResponseBody   string
+		IsJSONResponse bool
+	}{
+		{
+			Name:       "obtain_stored_test_data",
+			Authorized: course.MustNewAcademic("9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d", course.TeacherType),
+			Query: app.StoredTestDataQuery{
+				Academic:   course.MustNewAcademic("9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d", course.TeacherType),
+				CourseID:   courseID,
+				TestDataID: testDataID,
+			},
+			PrepareHandler: func(t *testing.T, expectedQuery app.StoredTestDataQuery) qmock.StoredTestDataHandler {
+				return func(_ context.Context, givenQuery app.StoredTestDataQuery) (io.ReadCloser, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return io.NopCloser(strings.NewReader("1000000\n")), nil
+				}
+			},
+			StatusCode:   http.StatusOK,
+			ResponseBody: "1000000\n",
+		},
+		{
+			Name:       "test_data_not_found",
+			Authorized: course.MustNewAcademic("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a", course.StudentType),
+			Query: app.StoredTestDataQuery{
+				Academic:   course.MustNewAcademic("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a", course.StudentType),
+				CourseID:   courseID,
+				TestDataID: testDataID,
+			},
+			PrepareHandler: func(t *testing.T, expectedQuery app.StoredTestDataQuery) qmock.StoredTestDataHandler {
+				return func(_ context.Context, givenQuery app.StoredTestDataQuery) (io.ReadCloser, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return nil, app.ErrTestDataDoesntExist
+				}
+			},
+			StatusCode:     http.StatusNotFound,
+			ResponseBody:   `{"slug": "test-data-not-found", "details": "stored test data doesn't exist"}`,
+			IsJSONResponse: true,
+		},
+		{
+			Name:       "course_not_found",
+			Authorized: course.MustNewAcademic("6f5e4d3c-2b1a-4098-8f7e-6d5c4b3a2918", course.TeacherType),
+			Query: app.StoredTestDataQuery{
+				Academic:   course.MustNewAcademic("6f5e4d3c-2b1a-4098-8f7e-6d5c4b3a2918", course.TeacherType),
+				CourseID:   courseID,
+				TestDataID: testDataID,
+			},
+			PrepareHandler: func(t *testing.T, expectedQuery app.StoredTestDataQuery) qmock.StoredTestDataHandler {
+				return func(_ context.Context, givenQuery app.StoredTestDataQuery) (io.ReadCloser, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return nil, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:     http.StatusNotFound,
+			ResponseBody:   `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+			IsJSONResponse: true,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					StoredTestData: c.PrepareHandler(t, c.Query),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(
+				t,
+				http.MethodGet, fmt.Sprintf("/courses/%s/test-data/%s", courseID, testDataID),
+				"", c.Authorized,
+			)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.IsJSONResponse {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+
+				return
+			}
+			require.Equal(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
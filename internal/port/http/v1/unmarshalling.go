@@ -1,9 +1,16 @@
 package v1
 
 import (
+	"encoding/csv"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/render"
+	"github.com/pkg/errors"
 
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/domain/course"
@@ -11,6 +18,26 @@ import (
 	"github.com/authena-ru/courses-organization/pkg/httperr"
 )
 
+// formFieldMaxLen is enough for value of any multipart form field except file.
+const formFieldMaxLen = 64
+
+var (
+	errMaterialFileMissing   = errors.New("multipart form has no file")
+	errResourceTypeAfterFile = errors.New("resourceType field should precede file")
+	errInvalidPagination     = errors.New("page should be positive and perPage should be from 1 to 100")
+	errUnsupportedRowsType   = errors.New("enrollment rows should be text/csv or application/json")
+	errAcademicIDsMissing    = errors.New("id or ids should be given")
+)
+
+// enrollmentCSVHeaders are first column names that mark first CSV row as header.
+var enrollmentCSVHeaders = map[string]bool{
+	"id":         true,
+	"email":      true,
+	"student":    true,
+	"student_id": true,
+	"studentid":  true,
+}
+
 func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
 	if err := render.Decode(r, v); err != nil {
 		httperr.BadRequest("bad-request", err, w, r)
@@ -56,236 +83,1361 @@ func unmarshalSpecificCourseQuery(
 	}, true
 }
 
-func unmarshalAllTasksQuery(
+func unmarshalCourseViewsReportQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (qry app.CourseViewsReportQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.CourseViewsReportQuery{
+		Academic: academic,
+		CourseID: courseID,
+	}, true
+}
+
+func unmarshalAllCourseStudentsQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetAllCourseStudentsParams,
+) (qry app.AllCourseStudentsQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	pagination, ok := unmarshalPagination(w, r, params.Page, params.PerPage)
+	if !ok {
+		return
+	}
+
+	var fullName string
+	if params.FullName != nil {
+		fullName = *params.FullName
+	}
+
+	return app.AllCourseStudentsQuery{
+		Academic:         academic,
+		CourseID:         courseID,
+		FullName:         fullName,
+		IncludeWithdrawn: params.IncludeWithdrawn != nil && *params.IncludeWithdrawn,
+		Pagination:       pagination,
+	}, true
+}
+
+func unmarshalAllCourseCollaboratorsQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetAllCourseCollaboratorsParams,
+) (qry app.AllCourseCollaboratorsQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	pagination, ok := unmarshalPagination(w, r, params.Page, params.PerPage)
+	if !ok {
+		return
+	}
+
+	return app.AllCourseCollaboratorsQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		Pagination: pagination,
+	}, true
+}
+
+func unmarshalPagination(w http.ResponseWriter, r *http.Request, page, perPage *int) (app.Pagination, bool) {
+	pagination := app.Pagination{Page: 1, PerPage: app.DefaultPerPage}
+
+	if page != nil {
+		pagination.Page = *page
+	}
+
+	if perPage != nil {
+		pagination.PerPage = *perPage
+	}
+
+	if !pagination.IsValid() {
+		httperr.BadRequest("invalid-pagination", errInvalidPagination, w, r)
+
+		return app.Pagination{}, false
+	}
+
+	return pagination, true
+}
+
+func unmarshalAllTasksQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetCourseTasksParams,
+) (qry app.AllTasksQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var text string
+	if params.Text != nil {
+		text = *params.Text
+	}
+
+	var taskType course.TaskType
+
+	if params.Type != nil {
+		switch *params.Type {
+		case TaskTypeMANUALCHECKING:
+			taskType = course.ManualCheckingType
+		case TaskTypeAUTOCODECHECKING:
+			taskType = course.AutoCodeCheckingType
+		case TaskTypeTESTING:
+			taskType = course.TestingType
+		default:
+			taskType = course.TaskType(0)
+		}
+	}
+
+	return app.AllTasksQuery{
+		Academic: academic,
+		CourseID: courseID,
+		Type:     taskType,
+		Text:     text,
+	}, true
+}
+
+func unmarshalSpecificTaskQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, taskNumber int,
+) (qry app.SpecificTaskQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.SpecificTaskQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		TaskNumber: taskNumber,
+	}, true
+}
+
+func unmarshalUploadTestDataCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.UploadTestDataCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.UploadTestDataCommand{
+		Academic: academic,
+		CourseID: courseID,
+		Data:     r.Body,
+	}, true
+}
+
+func unmarshalStoredTestDataQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID, testDataID string,
+) (qry app.StoredTestDataQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.StoredTestDataQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		TestDataID: testDataID,
+	}, true
+}
+
+func unmarshalAddStudentCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.AddStudentCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb AddStudentToCourseRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	studentIDs, ok := unmarshalAcademicIDs(w, r, rb.Id, rb.Ids)
+	if !ok {
+		return
+	}
+
+	return app.AddStudentCommand{
+		Academic:   academic,
+		CourseID:   courseID,
+		StudentIDs: studentIDs,
+	}, true
+}
+
+// unmarshalAcademicIDs joins single id and several ids of request,
+// at least one of them should be given.
+func unmarshalAcademicIDs(w http.ResponseWriter, r *http.Request, id *string, ids *[]string) ([]string, bool) {
+	var academicIDs []string
+	if id != nil {
+		academicIDs = append(academicIDs, *id)
+	}
+
+	if ids != nil {
+		academicIDs = append(academicIDs, *ids...)
+	}
+
+	if len(academicIDs) == 0 {
+		httperr.BadRequest("academic-ids-missing", errAcademicIDsMissing, w, r)
+
+		return nil, false
+	}
+
+	return academicIDs, true
+}
+
+func unmarshalEnrollStudentsCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params EnrollStudentsToCourseParams,
+) (cmd app.EnrollStudentsCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	rows, ok := unmarshalEnrollmentRows(w, r)
+	if !ok {
+		return
+	}
+
+	return app.EnrollStudentsCommand{
+		Academic: academic,
+		CourseID: courseID,
+		Rows:     rows,
+		DryRun:   params.DryRun != nil && *params.DryRun,
+	}, true
+}
+
+func unmarshalEnrollmentRows(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		httperr.UnsupportedMediaType("unsupported-rows-type", errUnsupportedRowsType, w, r)
+
+		return nil, false
+	}
+
+	switch mediaType {
+	case "application/json":
+		var rb EnrollStudentsRequest
+		if ok := decode(w, r, &rb); !ok {
+			return nil, false
+		}
+
+		return rb, true
+	case "text/csv":
+		rows, err := unmarshalEnrollmentCSV(r.Body)
+		if err != nil {
+			httperr.BadRequest("invalid-csv", err, w, r)
+
+			return nil, false
+		}
+
+		return rows, true
+	}
+
+	httperr.UnsupportedMediaType("unsupported-rows-type", errUnsupportedRowsType, w, r)
+
+	return nil, false
+}
+
+// unmarshalEnrollmentCSV takes first column of every record,
+// first record is skipped if it looks like header.
+func unmarshalEnrollmentCSV(body io.Reader) ([]string, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows := make([]string, 0)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		value := strings.TrimSpace(record[0])
+		if len(rows) == 0 && enrollmentCSVHeaders[strings.ToLower(value)] {
+			continue
+		}
+
+		rows = append(rows, value)
+	}
+}
+
+func unmarshalAllInvitationsQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (qry app.AllInvitationsQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.AllInvitationsQuery{Academic: academic, CourseID: courseID}, true
+}
+
+func unmarshalCreateInvitationCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.CreateInvitationCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb CreateInvitationRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	role, ok := unmarshalInvitationRole(w, r, rb.Role)
+	if !ok {
+		return
+	}
+
+	return app.CreateInvitationCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		Role:      role,
+		ExpiresAt: rb.ExpiresAt,
+		MaxUses:   rb.MaxUses,
+	}, true
+}
+
+func unmarshalInvitationRole(w http.ResponseWriter, r *http.Request, apiRole InvitationRole) (course.AcademicType, bool) {
+	switch apiRole {
+	case InvitationRoleSTUDENT:
+		return course.StudentType, true
+	case InvitationRoleCOLLABORATOR:
+		return course.TeacherType, true
+	}
+
+	httperr.UnprocessableEntity("invalid-invitation-role", nil, w, r)
+
+	return course.AcademicType(0), false
+}
+
+func unmarshalRevokeInvitationCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, code string,
+) (cmd app.RevokeInvitationCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RevokeInvitationCommand{
+		Academic: academic,
+		CourseID: courseID,
+		Code:     code,
+	}, true
+}
+
+func unmarshalRedeemInvitationCommand(w http.ResponseWriter, r *http.Request) (cmd app.RedeemInvitationCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb RedeemInvitationRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.RedeemInvitationCommand{Academic: academic, Code: rb.Code}, true
+}
+
+func unmarshalEnrollmentRequestsQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, params GetEnrollmentRequestsParams,
+) (qry app.EnrollmentRequestsQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var status course.EnrollmentRequestStatus
+
+	if params.Status != nil {
+		switch *params.Status {
+		case EnrollmentRequestStatusPENDING:
+			status = course.PendingRequest
+		case EnrollmentRequestStatusAPPROVED:
+			status = course.ApprovedRequest
+		case EnrollmentRequestStatusREJECTED:
+			status = course.RejectedRequest
+		default:
+			status = course.EnrollmentRequestStatus(0)
+		}
+	}
+
+	return app.EnrollmentRequestsQuery{
+		Academic: academic,
+		CourseID: courseID,
+		Status:   status,
+	}, true
+}
+
+func unmarshalRequestEnrollmentCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.RequestEnrollmentCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb RequestEnrollmentRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	var message string
+	if rb.Message != nil {
+		message = *rb.Message
+	}
+
+	return app.RequestEnrollmentCommand{
+		Academic: academic,
+		CourseID: courseID,
+		Message:  message,
+	}, true
+}
+
+func unmarshalApproveEnrollmentRequestsCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.ApproveEnrollmentRequestsCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb ReviewEnrollmentRequestsRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.ApproveEnrollmentRequestsCommand{
+		Academic:   academic,
+		CourseID:   courseID,
+		RequestIDs: rb.Ids,
+	}, true
+}
+
+func unmarshalRejectEnrollmentRequestsCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.RejectEnrollmentRequestsCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb ReviewEnrollmentRequestsRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.RejectEnrollmentRequestsCommand{
+		Academic:   academic,
+		CourseID:   courseID,
+		RequestIDs: rb.Ids,
+	}, true
+}
+
+func unmarshalRemoveStudentCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, studentID string, params RemoveStudentFromCourseParams,
+) (cmd app.RemoveStudentCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var reason string
+	if params.Reason != nil {
+		reason = *params.Reason
+	}
+
+	return app.RemoveStudentCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		StudentID: studentID,
+		Reason:    reason,
+	}, true
+}
+
+func unmarshalCompleteStudentCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, studentID string,
+) (cmd app.CompleteStudentCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.CompleteStudentCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		StudentID: studentID,
+	}, true
+}
+
+func unmarshalAddGroupCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.AddGroupCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb AddGroupToCourseRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.AddGroupCommand{
+		Academic: academic,
+		CourseID: courseID,
+		GroupID:  rb.Id,
+	}, true
+}
+
+func unmarshalRemoveGroupCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, groupID string,
+) (cmd app.RemoveGroupCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RemoveGroupCommand{
+		Academic: academic,
+		CourseID: courseID,
+		GroupID:  groupID,
+	}, true
+}
+
+func unmarshalSyncGroupCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, groupID string,
+) (cmd app.SyncGroupCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.SyncGroupCommand{
+		Academic: academic,
+		CourseID: courseID,
+		GroupID:  groupID,
+	}, true
+}
+
+func unmarshalAllTeamsQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (qry app.AllTeamsQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.AllTeamsQuery{Academic: academic, CourseID: courseID}, true
+}
+
+func unmarshalCreateTeamCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.CreateTeamCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb CreateTeamRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	var memberIDs []string
+	if rb.MemberIds != nil {
+		memberIDs = *rb.MemberIds
+	}
+
+	return app.CreateTeamCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		Name:      rb.Name,
+		MemberIDs: memberIDs,
+	}, true
+}
+
+func unmarshalChangeTeamSettingsCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.ChangeTeamSettingsCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb TeamSettings
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.ChangeTeamSettingsCommand{
+		Academic:      academic,
+		CourseID:      courseID,
+		SelfFormation: rb.SelfFormation,
+		MaxSize:       rb.MaxSize,
+	}, true
+}
+
+func unmarshalRenameTeamCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, teamID string,
+) (cmd app.RenameTeamCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb RenameTeamRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.RenameTeamCommand{
+		Academic: academic,
+		CourseID: courseID,
+		TeamID:   teamID,
+		Name:     rb.Name,
+	}, true
+}
+
+func unmarshalRemoveTeamCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, teamID string,
+) (cmd app.RemoveTeamCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RemoveTeamCommand{
+		Academic: academic,
+		CourseID: courseID,
+		TeamID:   teamID,
+	}, true
+}
+
+func unmarshalAddTeamMembersCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, teamID string,
+) (cmd app.AddTeamMembersCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb AddTeamMembersRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.AddTeamMembersCommand{
+		Academic:   academic,
+		CourseID:   courseID,
+		TeamID:     teamID,
+		StudentIDs: rb.StudentIds,
+	}, true
+}
+
+func unmarshalRemoveTeamMemberCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, teamID, studentID string,
+) (cmd app.RemoveTeamMemberCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RemoveTeamMemberCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		TeamID:    teamID,
+		StudentID: studentID,
+	}, true
+}
+
+func unmarshalChangeCourseCapacityCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.ChangeCourseCapacityCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb CourseCapacity
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	return app.ChangeCourseCapacityCommand{Academic: academic, CourseID: courseID, Capacity: rb.Capacity}, true
+}
+
+func unmarshalCourseWaitlistQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (qry app.CourseWaitlistQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.CourseWaitlistQuery{Academic: academic, CourseID: courseID}, true
+}
+
+func unmarshalRemoveFromWaitlistCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, studentID string,
+) (cmd app.RemoveFromWaitlistCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RemoveFromWaitlistCommand{
+		Academic:  academic,
+		CourseID:  courseID,
+		StudentID: studentID,
+	}, true
+}
+
+func unmarshalAddCollaboratorCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.AddCollaboratorCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb AddCollaboratorToCourseRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	collaboratorIDs, ok := unmarshalAcademicIDs(w, r, rb.Id, rb.Ids)
+	if !ok {
+		return
+	}
+
+	return app.AddCollaboratorCommand{
+		Academic:        academic,
+		CourseID:        courseID,
+		CollaboratorIDs: collaboratorIDs,
+	}, true
+}
+
+func unmarshalRemoveCollaboratorCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, collaboratorID string,
+) (cmd app.RemoveCollaboratorCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	return app.RemoveCollaboratorCommand{
+		Academic:       academic,
+		CourseID:       courseID,
+		CollaboratorID: collaboratorID,
+	}, true
+}
+
+func unmarshalChangeCollaboratorRoleCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, collaboratorID string,
+) (cmd app.ChangeCollaboratorRoleCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb ChangeCollaboratorRoleRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	role, ok := unmarshalCollaboratorRole(w, r, rb.Role)
+	if !ok {
+		return
+	}
+
+	return app.ChangeCollaboratorRoleCommand{
+		Academic:       academic,
+		CourseID:       courseID,
+		CollaboratorID: collaboratorID,
+		Role:           role,
+	}, true
+}
+
+func unmarshalCollaboratorRole(
+	w http.ResponseWriter, r *http.Request,
+	apiRole CollaboratorRole,
+) (course.CollaboratorRole, bool) {
+	switch apiRole {
+	case CollaboratorRoleCOTEACHER:
+		return course.CoTeacherRole, true
+	case CollaboratorRoleASSISTANT:
+		return course.AssistantRole, true
+	case CollaboratorRoleOBSERVER:
+		return course.ObserverRole, true
+	}
+
+	httperr.UnprocessableEntity("invalid-collaborator-role", nil, w, r)
+
+	return course.CollaboratorRole(0), false
+}
+
+func unmarshalCreateCourseCommand(w http.ResponseWriter, r *http.Request) (cmd app.CreateCourseCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb CreateCourseRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	period, ok := unmarshalPeriod(w, r, &rb.Period)
+	if !ok {
+		return
+	}
+
+	return app.CreateCourseCommand{
+		Academic:      academic,
+		CourseStarted: rb.Started,
+		CourseTitle:   rb.Title,
+		CoursePeriod:  period,
+	}, true
+}
+
+func unmarshalExtendCourseCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.ExtendCourseCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	var rb ExtendCourseRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	period, ok := unmarshalPeriod(w, r, rb.Period)
+	if !ok {
+		return
+	}
+
+	return app.ExtendCourseCommand{
+		Academic:       academic,
+		OriginCourseID: courseID,
+		CourseStarted:  rb.Started,
+		CourseTitle:    rb.Title,
+		CoursePeriod:   period,
+	}, true
+}
+
+func unmarshalAddTaskCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID string,
+) (cmd app.AddTaskCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
+		return
+	}
+
+	rb := struct {
+		Task
+		Deadline *Deadline
+		TestData []TestData
+		Checker  *Checker
+		Points   []TestPoint
+
+		Languages     []string
+		TimeLimit     *int
+		MemoryLimit   *int
+		MaxSourceSize *int
+	}{}
+	if ok = decode(w, r, &rb); !ok {
+		return
+	}
+
+	taskType, ok := unmarshalTaskType(w, r, rb.Type)
+	if !ok {
+		return
+	}
+
+	deadline, ok := unmarshalDeadline(w, r, rb.Deadline)
+	if !ok {
+		return
+	}
+
+	testData, ok := unmarshalTestData(w, r, &rb.TestData)
+	if !ok {
+		return
+	}
+
+	checker, ok := unmarshalChecker(w, r, rb.Checker)
+	if !ok {
+		return
+	}
+
+	limits, ok := unmarshalExecutionLimits(w, r, rb.TimeLimit, rb.MemoryLimit, rb.MaxSourceSize)
+	if !ok {
+		return
+	}
+
+	testPoints, ok := unmarshalTestPoints(w, r, &rb.Points)
+	if !ok {
+		return
+	}
+
+	return app.AddTaskCommand{
+		Academic:        academic,
+		CourseID:        courseID,
+		TaskTitle:       rb.Title,
+		TaskDescription: rb.Description,
+		TaskType:        taskType,
+		TeamWork:        rb.TeamWork != nil && *rb.TeamWork,
+		Deadline:        deadline,
+		TestPoints:      testPoints,
+		TestData:        testData,
+		Checker:         checker,
+		Languages:       rb.Languages,
+		Limits:          limits,
+	}, true
+}
+
+func unmarshalEditTaskCommand(
 	w http.ResponseWriter, r *http.Request,
-	courseID string, params GetCourseTasksParams,
-) (qry app.AllTasksQuery, ok bool) {
+	courseID string, taskNumber int,
+) (cmd app.EditTaskCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	var text string
-	if params.Text != nil {
-		text = *params.Text
+	var rb EditTaskRequest
+	if ok = decode(w, r, &rb); !ok {
+		return
 	}
 
-	var taskType course.TaskType
+	cmd = app.EditTaskCommand{
+		Academic:        academic,
+		CourseID:        courseID,
+		TaskNumber:      taskNumber,
+		TaskTitle:       rb.Title,
+		TaskDescription: rb.Description,
+		TeamWork:        rb.TeamWork,
+	}
 
-	if params.Type != nil {
-		switch *params.Type {
-		case TaskTypeMANUALCHECKING:
-			taskType = course.ManualCheckingType
-		case TaskTypeAUTOCODECHECKING:
-			taskType = course.AutoCodeCheckingType
-		case TaskTypeTESTING:
-			taskType = course.TestingType
-		default:
-			taskType = course.TaskType(0)
+	if rb.Deadline != nil {
+		deadline, ok := unmarshalDeadline(w, r, rb.Deadline)
+		if !ok {
+			return app.EditTaskCommand{}, false
 		}
+
+		cmd.Deadline = &deadline
 	}
 
-	return app.AllTasksQuery{
-		Academic: academic,
-		CourseID: courseID,
-		Type:     taskType,
-		Text:     text,
-	}, true
+	if cmd.TestData, ok = unmarshalTestData(w, r, rb.TestData); !ok {
+		return app.EditTaskCommand{}, false
+	}
+
+	if rb.Checker != nil {
+		checker, ok := unmarshalChecker(w, r, rb.Checker)
+		if !ok {
+			return app.EditTaskCommand{}, false
+		}
+
+		cmd.Checker = &checker
+	}
+
+	if rb.Languages != nil {
+		cmd.Languages = append([]string{}, *rb.Languages...)
+	}
+
+	if rb.TimeLimit != nil || rb.MemoryLimit != nil || rb.MaxSourceSize != nil {
+		limits, ok := unmarshalExecutionLimits(w, r, rb.TimeLimit, rb.MemoryLimit, rb.MaxSourceSize)
+		if !ok {
+			return app.EditTaskCommand{}, false
+		}
+
+		cmd.Limits = &limits
+	}
+
+	if cmd.TestPoints, ok = unmarshalTestPoints(w, r, rb.Points); !ok {
+		return app.EditTaskCommand{}, false
+	}
+
+	return cmd, true
 }
 
-func unmarshalSpecificTaskQuery(
+func unmarshalRestoreTaskVersionCommand(
 	w http.ResponseWriter, r *http.Request,
-	courseID string, taskNumber int,
-) (qry app.SpecificTaskQuery, ok bool) {
+	courseID string, taskNumber, version int,
+) (cmd app.RestoreTaskVersionCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	return app.SpecificTaskQuery{
+	return app.RestoreTaskVersionCommand{
 		Academic:   academic,
 		CourseID:   courseID,
 		TaskNumber: taskNumber,
+		Version:    version,
 	}, true
 }
 
-func unmarshalAddStudentCommand(
+func unmarshalTaskVersionsQuery(
 	w http.ResponseWriter, r *http.Request,
-	courseID string,
-) (cmd app.AddStudentCommand, ok bool) {
+	courseID string, taskNumber int,
+) (qry app.TaskVersionsQuery, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	var rb AddStudentToCourseRequest
-	if ok = decode(w, r, &rb); !ok {
+	return app.TaskVersionsQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		TaskNumber: taskNumber,
+	}, true
+}
+
+func unmarshalSpecificTaskVersionQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID string, taskNumber, version int,
+) (qry app.SpecificTaskVersionQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
+	if !ok {
 		return
 	}
 
-	return app.AddStudentCommand{
-		Academic:  academic,
-		CourseID:  courseID,
-		StudentID: rb.Id,
+	return app.SpecificTaskVersionQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		TaskNumber: taskNumber,
+		Version:    version,
 	}, true
 }
 
-func unmarshalRemoveStudentCommand(
+func unmarshalTaskVersionsDiffQuery(
 	w http.ResponseWriter, r *http.Request,
-	courseID, studentID string,
-) (cmd app.RemoveStudentCommand, ok bool) {
+	courseID string, taskNumber int, params GetCourseTaskVersionsDiffParams,
+) (qry app.TaskVersionsDiffQuery, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	return app.RemoveStudentCommand{
-		Academic:  academic,
-		CourseID:  courseID,
-		StudentID: studentID,
+	return app.TaskVersionsDiffQuery{
+		Academic:    academic,
+		CourseID:    courseID,
+		TaskNumber:  taskNumber,
+		FromVersion: params.From,
+		ToVersion:   params.To,
 	}, true
 }
 
-func unmarshalAddCollaboratorCommand(
+func unmarshalAttachAuxiliaryMaterialCommand(
 	w http.ResponseWriter, r *http.Request,
 	courseID string,
-) (cmd app.AddCollaboratorCommand, ok bool) {
+) (cmd app.AttachAuxiliaryMaterialCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	var rb AddCollaboratorToCourseRequest
+	var rb AttachAuxiliaryMaterialToCourseJSONRequestBody
 	if ok = decode(w, r, &rb); !ok {
 		return
 	}
 
-	return app.AddCollaboratorCommand{
-		Academic:       academic,
-		CourseID:       courseID,
-		CollaboratorID: rb.Id,
+	return app.AttachAuxiliaryMaterialCommand{
+		Academic:     academic,
+		CourseID:     courseID,
+		TaskNumber:   unmarshalMaterialTaskNumber(rb.TaskNumber),
+		Resource:     rb.Resource,
+		ResourceType: unmarshalResourceType(rb.ResourceType),
+		ReleaseTime:  unmarshalReleaseTime(rb.ReleaseTime),
+		Hidden:       rb.Hidden != nil && *rb.Hidden,
 	}, true
 }
 
-func unmarshalRemoveCollaboratorCommand(
+// unmarshalUploadAuxiliaryMaterialCommand streams multipart form,
+// so all other fields should precede file that isn't buffered.
+func unmarshalUploadAuxiliaryMaterialCommand(
 	w http.ResponseWriter, r *http.Request,
-	courseID, collaboratorID string,
-) (cmd app.RemoveCollaboratorCommand, ok bool) {
+	courseID string,
+) (cmd app.UploadAuxiliaryMaterialCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	return app.RemoveCollaboratorCommand{
-		Academic:       academic,
-		CourseID:       courseID,
-		CollaboratorID: collaboratorID,
-	}, true
+	form, err := r.MultipartReader()
+	if err != nil {
+		httperr.BadRequest("invalid-multipart-form", err, w, r)
+
+		return cmd, false
+	}
+
+	cmd = app.UploadAuxiliaryMaterialCommand{Academic: academic, CourseID: courseID}
+	resourceTypeGiven := false
+
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			httperr.BadRequest("material-file-missing", errMaterialFileMissing, w, r)
+
+			return cmd, false
+		}
+
+		if err != nil {
+			httperr.BadRequest("invalid-multipart-form", err, w, r)
+
+			return cmd, false
+		}
+
+		if part.FormName() == "file" {
+			if !resourceTypeGiven {
+				httperr.BadRequest("invalid-multipart-form", errResourceTypeAfterFile, w, r)
+
+				return cmd, false
+			}
+
+			cmd.FileName = part.FileName()
+			cmd.Data = part
+
+			return cmd, true
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, formFieldMaxLen))
+		if err == nil {
+			err = unmarshalUploadFormField(&cmd, part.FormName(), string(value))
+		}
+
+		if err != nil {
+			httperr.BadRequest("invalid-multipart-form", err, w, r)
+
+			return cmd, false
+		}
+
+		resourceTypeGiven = resourceTypeGiven || part.FormName() == "resourceType"
+	}
 }
 
-func unmarshalCreateCourseCommand(w http.ResponseWriter, r *http.Request) (cmd app.CreateCourseCommand, ok bool) {
+// unmarshalUploadFormField sets command field by form field name, unknown fields are ignored.
+func unmarshalUploadFormField(cmd *app.UploadAuxiliaryMaterialCommand, name, value string) (err error) {
+	switch name {
+	case "resourceType":
+		cmd.ResourceType = unmarshalResourceType(ResourceType(value))
+	case "taskNumber":
+		cmd.TaskNumber, err = strconv.Atoi(value)
+	case "releaseTime":
+		cmd.ReleaseTime, err = time.Parse(time.RFC3339, value)
+	case "hidden":
+		cmd.Hidden, err = strconv.ParseBool(value)
+	}
+
+	return err
+}
+
+func unmarshalEditAuxiliaryMaterialCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, materialID string,
+) (cmd app.EditAuxiliaryMaterialCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	var rb CreateCourseRequest
+	var rb EditAuxiliaryMaterialJSONRequestBody
 	if ok = decode(w, r, &rb); !ok {
 		return
 	}
 
-	period, ok := unmarshalPeriod(w, r, &rb.Period)
-	if !ok {
-		return
-	}
-
-	return app.CreateCourseCommand{
-		Academic:      academic,
-		CourseStarted: rb.Started,
-		CourseTitle:   rb.Title,
-		CoursePeriod:  period,
+	return app.EditAuxiliaryMaterialCommand{
+		Academic:     academic,
+		CourseID:     courseID,
+		MaterialID:   materialID,
+		TaskNumber:   unmarshalMaterialTaskNumber(rb.TaskNumber),
+		Resource:     rb.Resource,
+		ResourceType: unmarshalResourceType(rb.ResourceType),
+		ReleaseTime:  unmarshalReleaseTime(rb.ReleaseTime),
+		Hidden:       rb.Hidden != nil && *rb.Hidden,
 	}, true
 }
 
-func unmarshalExtendCourseCommand(
+func unmarshalReorderAuxiliaryMaterialsCommand(
 	w http.ResponseWriter, r *http.Request,
 	courseID string,
-) (cmd app.ExtendCourseCommand, ok bool) {
+) (cmd app.ReorderAuxiliaryMaterialsCommand, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	var rb ExtendCourseRequest
+	var rb ReorderAuxiliaryMaterialsJSONRequestBody
 	if ok = decode(w, r, &rb); !ok {
 		return
 	}
 
-	period, ok := unmarshalPeriod(w, r, rb.Period)
+	return app.ReorderAuxiliaryMaterialsCommand{
+		Academic:    academic,
+		CourseID:    courseID,
+		MaterialIDs: rb.MaterialIds,
+	}, true
+}
+
+func unmarshalRemoveAuxiliaryMaterialCommand(
+	w http.ResponseWriter, r *http.Request,
+	courseID, materialID string,
+) (cmd app.RemoveAuxiliaryMaterialCommand, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	return app.ExtendCourseCommand{
-		Academic:       academic,
-		OriginCourseID: courseID,
-		CourseStarted:  rb.Started,
-		CourseTitle:    rb.Title,
-		CoursePeriod:   period,
+	return app.RemoveAuxiliaryMaterialCommand{
+		Academic:   academic,
+		CourseID:   courseID,
+		MaterialID: materialID,
 	}, true
 }
 
-func unmarshalAddTaskCommand(
+func unmarshalAllAuxiliaryMaterialsQuery(
 	w http.ResponseWriter, r *http.Request,
-	courseID string,
-) (cmd app.AddTaskCommand, ok bool) {
+	courseID string, params GetAllCourseAuxiliaryMaterialsParams,
+) (qry app.AllAuxiliaryMaterialsQuery, ok bool) {
 	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	rb := struct {
-		Task
-		Deadline *Deadline
-		TestData []TestData
-		Points   []TestPoint
-	}{}
-	if ok = decode(w, r, &rb); !ok {
-		return
+	var resourceType course.ResourceType
+	if params.ResourceType != nil {
+		resourceType = unmarshalResourceType(*params.ResourceType)
 	}
 
-	taskType, ok := unmarshalTaskType(w, r, rb.Type)
-	if !ok {
-		return
+	return app.AllAuxiliaryMaterialsQuery{
+		Academic:     academic,
+		CourseID:     courseID,
+		ResourceType: resourceType,
+		TaskNumber:   unmarshalMaterialTaskNumber(params.TaskNumber),
+	}, true
+}
+
+// unmarshalReleaseTime returns zero release time
+// of immediately released material for missing time.
+func unmarshalReleaseTime(releaseTime *time.Time) time.Time {
+	if releaseTime == nil {
+		return time.Time{}
 	}
 
-	deadline, ok := unmarshalDeadline(w, r, rb.Deadline)
-	if !ok {
-		return
+	return *releaseTime
+}
+
+// unmarshalMaterialTaskNumber returns zero task number
+// of course-level material for missing task number.
+func unmarshalMaterialTaskNumber(taskNumber *int) int {
+	if taskNumber == nil {
+		return 0
 	}
 
-	testData, ok := unmarshalTestData(w, r, &rb.TestData)
+	return *taskNumber
+}
+
+func unmarshalAuxiliaryMaterialFileQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID, materialID string,
+) (qry app.AuxiliaryMaterialFileQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	testPoints, ok := unmarshalTestPoints(w, r, &rb.Points)
+	return app.AuxiliaryMaterialFileQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		MaterialID: materialID,
+	}, true
+}
+
+func unmarshalSpecificAuxiliaryMaterialQuery(
+	w http.ResponseWriter, r *http.Request,
+	courseID, materialID string,
+) (qry app.SpecificAuxiliaryMaterialQuery, ok bool) {
+	academic, ok := unmarshalAcademic(w, r)
 	if !ok {
 		return
 	}
 
-	return app.AddTaskCommand{
-		Academic:        academic,
-		CourseID:        courseID,
-		TaskTitle:       rb.Title,
-		TaskDescription: rb.Description,
-		TaskType:        taskType,
-		Deadline:        deadline,
-		TestPoints:      testPoints,
-		TestData:        testData,
+	return app.SpecificAuxiliaryMaterialQuery{
+		Academic:   academic,
+		CourseID:   courseID,
+		MaterialID: materialID,
 	}, true
 }
 
+// unmarshalResourceType returns zero resource type for unknown
+// values, so domain rejects them as invalid auxiliary material.
+func unmarshalResourceType(apiResourceType ResourceType) course.ResourceType {
+	switch apiResourceType {
+	case ResourceTypeTRAININGMANUAL:
+		return course.TrainingManualResource
+	case ResourceTypeVIDEO:
+		return course.VideoResource
+	case ResourceTypePRESENTATION:
+		return course.PresentationResource
+	case ResourceTypeOTHER:
+		return course.OtherResource
+	}
+
+	return course.ResourceType(0)
+}
+
 func unmarshalTaskType(w http.ResponseWriter, r *http.Request, apiTaskType TaskType) (course.TaskType, bool) {
 	switch apiTaskType {
 	case TaskTypeMANUALCHECKING:
@@ -360,7 +1512,7 @@ func unmarshalTestData(w http.ResponseWriter, r *http.Request, apiTestData *[]Te
 	testData := make([]course.TestData, 0, len(apiTestDataValue))
 
 	for _, atd := range apiTestDataValue {
-		var inputData, outputData string
+		var inputData, outputData, explanation string
 
 		if atd.InputData != nil {
 			inputData = *atd.InputData
@@ -370,7 +1522,11 @@ func unmarshalTestData(w http.ResponseWriter, r *http.Request, apiTestData *[]Te
 			outputData = *atd.OutputData
 		}
 
-		td, err := course.NewTestData(inputData, outputData)
+		if atd.Explanation != nil {
+			explanation = *atd.Explanation
+		}
+
+		td, err := newTestData(atd, inputData, outputData, explanation)
 		if err != nil {
 			httperr.UnprocessableEntity("invalid-test-data", err, w, r)
 
@@ -383,6 +1539,113 @@ func unmarshalTestData(w http.ResponseWriter, r *http.Request, apiTestData *[]Te
 	return testData, true
 }
 
+func newTestData(apiTestData TestData, inputData, outputData, explanation string) (course.TestData, error) {
+	visibility := unmarshalTestDataVisibility(apiTestData.Visibility)
+
+	if apiTestData.InputDataId == nil && apiTestData.OutputDataId == nil {
+		return course.NewTestData(inputData, outputData, visibility, explanation)
+	}
+
+	var inputDataID, outputDataID string
+
+	if apiTestData.InputDataId != nil {
+		inputDataID = *apiTestData.InputDataId
+	}
+
+	if apiTestData.OutputDataId != nil {
+		outputDataID = *apiTestData.OutputDataId
+	}
+
+	return course.NewStoredTestData(inputDataID, outputDataID, visibility, explanation)
+}
+
+func unmarshalChecker(w http.ResponseWriter, r *http.Request, apiChecker *Checker) (course.Checker, bool) {
+	if apiChecker == nil {
+		return course.Checker{}, true
+	}
+
+	var tolerance float64
+	if apiChecker.Tolerance != nil {
+		tolerance = *apiChecker.Tolerance
+	}
+
+	checker, err := course.NewChecker(unmarshalCheckerMode(apiChecker.Mode), tolerance)
+	if err != nil {
+		httperr.UnprocessableEntity("invalid-checker", err, w, r)
+
+		return course.Checker{}, false
+	}
+
+	return checker, true
+}
+
+// unmarshalExecutionLimits takes time limit in milliseconds, memory limit
+// in megabytes and max source size in kilobytes, missing limits are default.
+func unmarshalExecutionLimits(
+	w http.ResponseWriter, r *http.Request,
+	apiTimeLimit, apiMemoryLimit, apiMaxSourceSize *int,
+) (course.ExecutionLimits, bool) {
+	if apiTimeLimit == nil && apiMemoryLimit == nil && apiMaxSourceSize == nil {
+		return course.ExecutionLimits{}, true
+	}
+
+	defaultLimits := course.DefaultExecutionLimits()
+	timeLimit := defaultLimits.TimeLimit()
+	memoryLimit := defaultLimits.MemoryLimit()
+	maxSourceSize := defaultLimits.MaxSourceSize()
+
+	if apiTimeLimit != nil {
+		timeLimit = time.Duration(*apiTimeLimit) * time.Millisecond
+	}
+
+	if apiMemoryLimit != nil {
+		memoryLimit = int64(*apiMemoryLimit) << 20
+	}
+
+	if apiMaxSourceSize != nil {
+		maxSourceSize = *apiMaxSourceSize << 10
+	}
+
+	limits, err := course.NewExecutionLimits(timeLimit, memoryLimit, maxSourceSize)
+	if err != nil {
+		httperr.UnprocessableEntity("invalid-execution-limits", err, w, r)
+
+		return course.ExecutionLimits{}, false
+	}
+
+	return limits, true
+}
+
+func unmarshalCheckerMode(apiMode CheckerMode) course.CheckerMode {
+	switch apiMode {
+	case CheckerModeEXACT:
+		return course.ExactChecker
+	case CheckerModeTOKENS:
+		return course.TokensChecker
+	case CheckerModeFLOAT:
+		return course.FloatChecker
+	case CheckerModeUNORDEREDLINES:
+		return course.UnorderedLinesChecker
+	}
+
+	return course.CheckerMode(0)
+}
+
+func unmarshalTestDataVisibility(apiVisibility *TestDataVisibility) course.TestDataVisibility {
+	if apiVisibility == nil {
+		return course.HiddenTestData
+	}
+
+	switch *apiVisibility {
+	case TestDataVisibilitySAMPLE:
+		return course.SampleTestData
+	case TestDataVisibilityHIDDEN:
+		return course.HiddenTestData
+	}
+
+	return course.TestDataVisibility(0)
+}
+
 func unmarshalTestPoints(w http.ResponseWriter, r *http.Request, apiTestPoints *[]TestPoint) ([]course.TestPoint, bool) {
 	if apiTestPoints == nil {
 		return nil, true
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) GetCourseViewsReport(w http.ResponseWriter, r *http.Request, courseID string) {
+	qry, ok := unmarshalCourseViewsReportQuery(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	report, err := h.app.Queries.CourseViewsReport.Handle(r.Context(), qry)
+	if err == nil {
+		marshalCourseViewsReport(w, r, report)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
@@ -0,0 +1,123 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_GetCourseViewsReport(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "5c2f8d0e-3a41-4b7e-9c6d-2e1f0a9b8c7d"
+
+	testCases := []struct {
+		Name           string
+		Authorized     course.Academic
+		Query          app.CourseViewsReportQuery
+		PrepareHandler func(t *testing.T, expectedQuery app.CourseViewsReportQuery) qmock.CourseViewsReportHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:       "obtain_views_report",
+			Authorized: course.MustNewAcademic("b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e", course.TeacherType),
+			Query: app.CourseViewsReportQuery{
+				Academic: course.MustNewAcademic("b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e", course.TeacherType),
+				CourseID: courseID,
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.CourseViewsReportQuery,
+			) qmock.CourseViewsReportHandler {
+				return func(_ context.Context, givenQuery app.CourseViewsReportQuery) (app.CourseViewsReport, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.CourseViewsReport{
+						Tasks: []app.ItemViewsReport{
+							{
+								Item:              app.TaskItem(1),
+								Title:             "Binary search",
+								UniqueViewers:     1,
+								NotViewedStudents: []string{"0d9c8b7a-6f5e-4d3c-2b1a-098f7e6d5c4b"},
+							},
+						},
+						Materials: []app.ItemViewsReport{
+							{
+								Item:              app.MaterialItem("e7a1b2c3-d4e5-4f6a-8b9c-0d1e2f3a4b5c"),
+								Title:             "https://go.dev/tour",
+								UniqueViewers:     2,
+								NotViewedStudents: []string{},
+							},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"tasks": [{
+					"taskNumber": 1,
+					"title": "Binary search",
+					"uniqueViewers": 1,
+					"notViewedStudents": ["0d9c8b7a-6f5e-4d3c-2b1a-098f7e6d5c4b"]
+				}],
+				"auxiliaryMaterials": [{
+					"materialId": "e7a1b2c3-d4e5-4f6a-8b9c-0d1e2f3a4b5c",
+					"title": "https://go.dev/tour",
+					"uniqueViewers": 2,
+					"notViewedStudents": []
+				}]
+			}`,
+		},
+		{
+			Name:       "course_not_found",
+			Authorized: course.MustNewAcademic("3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7", course.StudentType),
+			Query: app.CourseViewsReportQuery{
+				Academic: course.MustNewAcademic("3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7", course.StudentType),
+				CourseID: courseID,
+			},
+			PrepareHandler: func(
+				t *testing.T,
+				expectedQuery app.CourseViewsReportQuery,
+			) qmock.CourseViewsReportHandler {
+				return func(_ context.Context, givenQuery app.CourseViewsReportQuery) (app.CourseViewsReport, error) {
+					require.Equalf(t, expectedQuery, givenQuery, "queries are not equal")
+
+					return app.CourseViewsReport{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{
+					CourseViewsReport: c.PrepareHandler(t, c.Query),
+				},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, fmt.Sprintf("/courses/%s/views-report", courseID), "", c.Authorized)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+)
+
+func (h handler) ChangeCourseCapacity(w http.ResponseWriter, r *http.Request, courseID string) {
+	cmd, ok := unmarshalChangeCourseCapacityCommand(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.ChangeCapacity.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrNegativeCourseCapacity) {
+		httperr.UnprocessableEntity("invalid-course-capacity", err, w, r)
+
+		return
+	}
+
+	respondWaitlistCommandError(err, w, r)
+}
+
+func (h handler) GetCourseWaitlist(w http.ResponseWriter, r *http.Request, courseID string) {
+	qry, ok := unmarshalCourseWaitlistQuery(w, r, courseID)
+	if !ok {
+		return
+	}
+
+	waitlist, err := h.app.Queries.CourseWaitlist.Handle(r.Context(), qry)
+	if err == nil {
+		marshalWaitlist(w, r, waitlist)
+
+		return
+	}
+
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) RemoveFromWaitlist(w http.ResponseWriter, r *http.Request, courseID, studentID string) {
+	cmd, ok := unmarshalRemoveFromWaitlistCommand(w, r, courseID, studentID)
+	if !ok {
+		return
+	}
+
+	err := h.app.Commands.RemoveFromWaitlist.Handle(r.Context(), cmd)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrCourseHasNoSuchWaitlisted) {
+		httperr.NotFound("course-waitlisted-student-not-found", err, w, r)
+
+		return
+	}
+
+	respondWaitlistCommandError(err, w, r)
+}
+
+func respondWaitlistCommandError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, app.ErrCourseDoesntExist) {
+		httperr.NotFound("course-not-found", err, w, r)
+
+		return
+	}
+
+	if course.IsAcademicCantEditCourseError(err) {
+		httperr.Forbidden("academic-cant-edit-course", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
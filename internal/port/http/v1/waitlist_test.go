@@ -0,0 +1,244 @@
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	qmock "github.com/authena-ru/courses-organization/internal/app/query/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+)
+
+func TestHandler_GetCourseWaitlist(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "6f5e4d3c-2b1a-4f0e-9d8c-7b6a5f4e3d2c"
+
+	teacher := course.MustNewAcademic("2c1b0a9f-8e7d-4c6b-a5f4-e3d2c1b0a9f8", course.TeacherType)
+	waitlistedAt := time.Date(2025, time.February, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) qmock.CourseWaitlistHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "obtain_waitlist",
+			PrepareHandler: func(t *testing.T) qmock.CourseWaitlistHandler {
+				return func(_ context.Context, qry app.CourseWaitlistQuery) (app.Waitlist, error) {
+					require.Equal(t, app.CourseWaitlistQuery{Academic: teacher, CourseID: courseID}, qry)
+
+					return app.Waitlist{
+						Capacity: 30,
+						Entries: []app.WaitlistEntry{
+							{StudentID: "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d", WaitlistedAt: waitlistedAt},
+						},
+						Promotions: []app.WaitlistPromotion{
+							{
+								StudentID:    "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0",
+								WaitlistedAt: waitlistedAt,
+								PromotedAt:   waitlistedAt.Add(time.Hour),
+							},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"capacity": 30,
+				"entries": [
+					{"studentId": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d", "waitlistedAt": "2025-02-01T12:00:00Z"}
+				],
+				"promotions": [
+					{
+						"studentId": "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0",
+						"waitlistedAt": "2025-02-01T12:00:00Z",
+						"promotedAt": "2025-02-01T13:00:00Z"
+					}
+				]
+			}`,
+		},
+		{
+			Name: "course_not_found",
+			PrepareHandler: func(_ *testing.T) qmock.CourseWaitlistHandler {
+				return func(_ context.Context, _ app.CourseWaitlistQuery) (app.Waitlist, error) {
+					return app.Waitlist{}, app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Queries: app.Queries{CourseWaitlist: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			r := newHTTPRequest(t, http.MethodGet, fmt.Sprintf("/courses/%s/waitlist", courseID), "", teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_ChangeCourseCapacity(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "6f5e4d3c-2b1a-4f0e-9d8c-7b6a5f4e3d2c"
+
+	teacher := course.MustNewAcademic("2c1b0a9f-8e7d-4c6b-a5f4-e3d2c1b0a9f8", course.TeacherType)
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		PrepareHandler func(t *testing.T) mock.ChangeCourseCapacityHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "capacity_changed",
+			RequestBody: `{"capacity": 25}`,
+			PrepareHandler: func(t *testing.T) mock.ChangeCourseCapacityHandler {
+				return func(_ context.Context, cmd app.ChangeCourseCapacityCommand) error {
+					require.Equal(t, app.ChangeCourseCapacityCommand{
+						Academic: teacher,
+						CourseID: courseID,
+						Capacity: 25,
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name:        "negative_capacity",
+			RequestBody: `{"capacity": -1}`,
+			PrepareHandler: func(_ *testing.T) mock.ChangeCourseCapacityHandler {
+				return func(_ context.Context, _ app.ChangeCourseCapacityCommand) error {
+					return course.ErrNegativeCourseCapacity
+				}
+			},
+			StatusCode:   http.StatusUnprocessableEntity,
+			ResponseBody: `{"slug": "invalid-course-capacity", "details": "course capacity is negative"}`,
+		},
+		{
+			Name:        "course_not_found",
+			RequestBody: `{"capacity": 25}`,
+			PrepareHandler: func(_ *testing.T) mock.ChangeCourseCapacityHandler {
+				return func(_ context.Context, _ app.ChangeCourseCapacityCommand) error {
+					return app.ErrCourseDoesntExist
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-not-found", "details": "course doesn't exist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{ChangeCapacity: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/capacity", courseID)
+			r := newHTTPRequest(t, http.MethodPut, target, c.RequestBody, teacher)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_RemoveFromWaitlist(t *testing.T) {
+	t.Parallel()
+
+	const courseID = "6f5e4d3c-2b1a-4f0e-9d8c-7b6a5f4e3d2c"
+
+	student := course.MustNewAcademic("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d", course.StudentType)
+
+	testCases := []struct {
+		Name           string
+		PrepareHandler func(t *testing.T) mock.RemoveFromWaitlistHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name: "student_left_waitlist",
+			PrepareHandler: func(t *testing.T) mock.RemoveFromWaitlistHandler {
+				return func(_ context.Context, cmd app.RemoveFromWaitlistCommand) error {
+					require.Equal(t, app.RemoveFromWaitlistCommand{
+						Academic:  student,
+						CourseID:  courseID,
+						StudentID: student.ID(),
+					}, cmd)
+
+					return nil
+				}
+			},
+			StatusCode: http.StatusNoContent,
+		},
+		{
+			Name: "waitlisted_student_not_found",
+			PrepareHandler: func(_ *testing.T) mock.RemoveFromWaitlistHandler {
+				return func(_ context.Context, _ app.RemoveFromWaitlistCommand) error {
+					return course.ErrCourseHasNoSuchWaitlisted
+				}
+			},
+			StatusCode:   http.StatusNotFound,
+			ResponseBody: `{"slug": "course-waitlisted-student-not-found", "details": "course has no such student in waitlist"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{RemoveFromWaitlist: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+
+			w := httptest.NewRecorder()
+			target := fmt.Sprintf("/courses/%s/waitlist/%s", courseID, student.ID())
+			r := newHTTPRequest(t, http.MethodDelete, target, "", student)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+
+			if c.ResponseBody != "" {
+				require.JSONEq(t, c.ResponseBody, w.Body.String())
+			}
+		})
+	}
+}
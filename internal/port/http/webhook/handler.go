@@ -0,0 +1,182 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/pkg/httperr"
+	"github.com/authena-ru/courses-organization/pkg/logging"
+)
+
+const (
+	// SignatureHeader keeps hex encoded HMAC-SHA256 of request body
+	// made with shared secret, e.g. sha256=5d41402abc4b2a76b9719d911017c592.
+	SignatureHeader = "X-Signature"
+
+	signaturePrefix = "sha256="
+	maxBodySize     = 64 << 10
+)
+
+type handler struct {
+	app    app.Application
+	secret []byte
+}
+
+// NewHandler returns handler of events of academics service,
+// every event should be signed with secret.
+func NewHandler(app app.Application, secret string, r chi.Router) http.Handler {
+	if secret == "" {
+		panic("webhook secret is empty")
+	}
+
+	h := handler{app: app, secret: []byte(secret)}
+	r.Post("/academics/deactivated", h.AcademicDeactivated)
+
+	return r
+}
+
+type academicDeactivatedEvent struct {
+	AcademicID string `json:"academicId"`
+}
+
+type deactivationReportResponse struct {
+	AcademicID string                       `json:"academicId"`
+	Courses    []courseDeactivationResponse `json:"courses"`
+}
+
+type courseDeactivationResponse struct {
+	CourseID            string `json:"courseId"`
+	StudentWithdrawn    bool   `json:"studentWithdrawn"`
+	WaitlistLeft        bool   `json:"waitlistLeft"`
+	RejectedRequests    int    `json:"rejectedRequests"`
+	CollaboratorRemoved bool   `json:"collaboratorRemoved"`
+	NewCreatorID        string `json:"newCreatorId,omitempty"`
+	CreatorOrphaned     bool   `json:"creatorOrphaned"`
+}
+
+var (
+	errInvalidSignature = errors.New("invalid signature")
+	errEventTooLarge    = errors.New("event too large")
+)
+
+// AcademicDeactivated removes academic deactivated in academics service from all
+// courses and responds with report of changed courses. Academics service may send
+// the same event again, e.g. when response is lost.
+func (h handler) AcademicDeactivated(w http.ResponseWriter, r *http.Request) {
+	var event academicDeactivatedEvent
+	if !h.decodeSigned(w, r, &event) {
+		return
+	}
+
+	report, err := h.app.Commands.DeactivateAcademic.Handle(r.Context(), app.DeactivateAcademicCommand{
+		AcademicID: event.AcademicID,
+	})
+	if err == nil {
+		logOrphanedCourses(r, report)
+		marshalDeactivationReport(w, r, report)
+
+		return
+	}
+
+	if errors.Is(err, course.ErrEmptyAcademicID) {
+		httperr.BadRequest("empty-academic-id", err, w, r)
+
+		return
+	}
+
+	httperr.InternalServerError("unexpected-error", err, w, r)
+}
+
+func (h handler) decodeSigned(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		httperr.BadRequest("bad-request", err, w, r)
+
+		return false
+	}
+
+	if len(body) > maxBodySize {
+		httperr.RequestEntityTooLarge("event-too-large", errEventTooLarge, w, r)
+
+		return false
+	}
+
+	if !h.validSignature(r.Header.Get(SignatureHeader), body) {
+		httperr.Unauthorized("invalid-signature", errInvalidSignature, w, r)
+
+		return false
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		httperr.BadRequest("bad-request", err, w, r)
+
+		return false
+	}
+
+	return true
+}
+
+func (h handler) validSignature(header string, body []byte) bool {
+	if !strings.HasPrefix(header, signaturePrefix) {
+		return false
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(header, signaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(signature, Sign(h.secret, body))
+}
+
+// Sign returns HMAC-SHA256 of body, academics service sends it hex
+// encoded with sha256= prefix in SignatureHeader.
+func Sign(secret, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+func logOrphanedCourses(r *http.Request, report app.DeactivationReport) {
+	for _, c := range report.Courses {
+		if c.CreatorOrphaned {
+			logging.GetLogEntry(r).
+				WithField("course-id", c.CourseID).
+				WithField("creator-id", report.AcademicID).
+				Warn("Creator of course is deactivated and course has no co-teacher to replace creator")
+		}
+	}
+}
+
+func marshalDeactivationReport(w http.ResponseWriter, r *http.Request, report app.DeactivationReport) {
+	response := deactivationReportResponse{
+		AcademicID: report.AcademicID,
+		Courses:    make([]courseDeactivationResponse, 0, len(report.Courses)),
+	}
+
+	for _, c := range report.Courses {
+		response.Courses = append(response.Courses, courseDeactivationResponse{
+			CourseID:            c.CourseID,
+			StudentWithdrawn:    c.StudentWithdrawn,
+			WaitlistLeft:        c.WaitlistLeft,
+			RejectedRequests:    c.RejectedRequests,
+			CollaboratorRemoved: c.CollaboratorRemoved,
+			NewCreatorID:        c.NewCreatorID,
+			CreatorOrphaned:     c.CreatorOrphaned,
+		})
+	}
+
+	render.Respond(w, r, response)
+}
@@ -0,0 +1,164 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/internal/app"
+	"github.com/authena-ru/courses-organization/internal/app/command/mock"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
+	"github.com/authena-ru/courses-organization/internal/port/http/webhook"
+	"github.com/authena-ru/courses-organization/pkg/logging"
+)
+
+const secret = "webhook-secret"
+
+func TestHandler_AcademicDeactivated(t *testing.T) {
+	t.Parallel()
+
+	const academicID = "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94"
+
+	testCases := []struct {
+		Name           string
+		RequestBody    string
+		Signature      string
+		PrepareHandler func(t *testing.T) mock.DeactivateAcademicHandler
+		StatusCode     int
+		ResponseBody   string
+	}{
+		{
+			Name:        "academic_deactivated",
+			RequestBody: `{"academicId": "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94"}`,
+			PrepareHandler: func(t *testing.T) mock.DeactivateAcademicHandler {
+				return func(_ context.Context, cmd app.DeactivateAcademicCommand) (app.DeactivationReport, error) {
+					require.Equal(t, app.DeactivateAcademicCommand{AcademicID: academicID}, cmd)
+
+					return app.DeactivationReport{
+						AcademicID: academicID,
+						Courses: []app.CourseDeactivation{
+							{CourseID: "5a8c2e1f-7b3d-4e9a-8c6f-1d0b2a4e6c8f", NewCreatorID: "7c2e9a1b-3f5d-4b8e-9a6c-2e4f8b1d3a5c"},
+							{CourseID: "9e4b1c7a-2d8f-4a3e-b5c9-6f0a1d2e3b4c", CreatorOrphaned: true},
+						},
+					}, nil
+				}
+			},
+			StatusCode: http.StatusOK,
+			ResponseBody: `{
+				"academicId": "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94",
+				"courses": [
+					{
+						"courseId": "5a8c2e1f-7b3d-4e9a-8c6f-1d0b2a4e6c8f",
+						"studentWithdrawn": false,
+						"waitlistLeft": false,
+						"rejectedRequests": 0,
+						"collaboratorRemoved": false,
+						"newCreatorId": "7c2e9a1b-3f5d-4b8e-9a6c-2e4f8b1d3a5c",
+						"creatorOrphaned": false
+					},
+					{
+						"courseId": "9e4b1c7a-2d8f-4a3e-b5c9-6f0a1d2e3b4c",
+						"studentWithdrawn": false,
+						"waitlistLeft": false,
+						"rejectedRequests": 0,
+						"collaboratorRemoved": false,
+						"creatorOrphaned": true
+					}
+				]
+			}`,
+		},
+		{
+			Name:        "invalid_signature",
+			RequestBody: `{"academicId": "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94"}`,
+			Signature:   "sha256=" + hex.EncodeToString(webhook.Sign([]byte("other-secret"), []byte(`{}`))),
+			PrepareHandler: func(t *testing.T) mock.DeactivateAcademicHandler {
+				return func(_ context.Context, _ app.DeactivateAcademicCommand) (app.DeactivationReport, error) {
+					t.Fatal("event with invalid signature shouldn't be handled")
+
+					return app.DeactivationReport{}, nil
+				}
+			},
+			StatusCode:   http.StatusUnauthorized,
+			ResponseBody: `{"slug": "invalid-signature", "details": "invalid signature"}`,
+		},
+		{
+			Name:        "no_signature",
+			RequestBody: `{"academicId": "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94"}`,
+			Signature:   "none",
+			PrepareHandler: func(t *testing.T) mock.DeactivateAcademicHandler {
+				return func(_ context.Context, _ app.DeactivateAcademicCommand) (app.DeactivationReport, error) {
+					t.Fatal("event without signature shouldn't be handled")
+
+					return app.DeactivationReport{}, nil
+				}
+			},
+			StatusCode:   http.StatusUnauthorized,
+			ResponseBody: `{"slug": "invalid-signature", "details": "invalid signature"}`,
+		},
+		{
+			Name:        "empty_academic_id",
+			RequestBody: `{}`,
+			PrepareHandler: func(_ *testing.T) mock.DeactivateAcademicHandler {
+				return func(_ context.Context, _ app.DeactivateAcademicCommand) (app.DeactivationReport, error) {
+					return app.DeactivationReport{}, course.ErrEmptyAcademicID
+				}
+			},
+			StatusCode:   http.StatusBadRequest,
+			ResponseBody: `{"slug": "empty-academic-id", "details": "empty academic id"}`,
+		},
+		{
+			Name:        "database_problems",
+			RequestBody: `{"academicId": "3e1f7a2c-9b4d-4c8e-a6f5-0d2b8c7e1a94"}`,
+			PrepareHandler: func(_ *testing.T) mock.DeactivateAcademicHandler {
+				return func(_ context.Context, _ app.DeactivateAcademicCommand) (app.DeactivationReport, error) {
+					return app.DeactivationReport{}, app.Wrap(app.ErrDatabaseProblems, errors.New("timeout"))
+				}
+			},
+			StatusCode:   http.StatusInternalServerError,
+			ResponseBody: `{"slug": "unexpected-error", "details": "database problems: timeout"}`,
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			application := app.Application{
+				Commands: app.Commands{DeactivateAcademic: c.PrepareHandler(t)},
+			}
+			h := newHTTPHandler(t, application)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/academics/deactivated", bytes.NewBufferString(c.RequestBody))
+
+			signature := c.Signature
+			if signature == "" {
+				signature = "sha256=" + hex.EncodeToString(webhook.Sign([]byte(secret), []byte(c.RequestBody)))
+			}
+
+			r.Header.Set(webhook.SignatureHeader, signature)
+
+			h.ServeHTTP(w, r)
+
+			require.Equalf(t, c.StatusCode, w.Code, "codes are not equal")
+			require.JSONEq(t, c.ResponseBody, w.Body.String())
+		})
+	}
+}
+
+func newHTTPHandler(t *testing.T, application app.Application) http.Handler {
+	t.Helper()
+
+	router := chi.NewRouter()
+	router.Use(logging.NewStructuredLogger(logrus.StandardLogger()))
+
+	return webhook.NewHandler(application, secret, router)
+}
@@ -1,27 +1,55 @@
 package runner
 
 import (
+	"context"
+	"expvar"
 	"fmt"
+	"io"
+	nethttp "net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/authena-ru/courses-organization/internal/app/query"
 	"github.com/authena-ru/courses-organization/internal/port/http"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/authena-ru/courses-organization/internal/adapter/academics"
+	"github.com/authena-ru/courses-organization/internal/adapter/academics/pb"
+	"github.com/authena-ru/courses-organization/internal/adapter/markdown"
+	"github.com/authena-ru/courses-organization/internal/adapter/registry"
+	memoryrepo "github.com/authena-ru/courses-organization/internal/adapter/repository/memory"
 	mongorepo "github.com/authena-ru/courses-organization/internal/adapter/repository/mongodb"
+	localstorage "github.com/authena-ru/courses-organization/internal/adapter/storage/local"
+	memorystorage "github.com/authena-ru/courses-organization/internal/adapter/storage/memory"
+	mongostorage "github.com/authena-ru/courses-organization/internal/adapter/storage/mongodb"
+	s3storage "github.com/authena-ru/courses-organization/internal/adapter/storage/s3"
+	"github.com/authena-ru/courses-organization/internal/adapter/tracking"
 	"github.com/authena-ru/courses-organization/internal/app"
 	"github.com/authena-ru/courses-organization/internal/app/command"
-	"github.com/authena-ru/courses-organization/internal/app/command/mock"
 	"github.com/authena-ru/courses-organization/internal/config"
+	"github.com/authena-ru/courses-organization/internal/domain/course"
 	"github.com/authena-ru/courses-organization/internal/server"
 	"github.com/authena-ru/courses-organization/pkg/database/mongodb"
 )
 
+const shutdownTimeout = 10 * time.Second
+
 func Start(configsDir string) {
 	cfg := newConfig(configsDir)
-	db := newMongoDatabase(cfg)
-	application := newApplication(db)
-	startServer(cfg, application)
+	db := newDatabase(cfg)
+	viewsRecorder := tracking.NewViewsRecorder(db.views, cfg.Views.BufferSize, cfg.Views.FlushInterval)
+	application := newApplication(cfg, db, viewsRecorder)
+	httpServer := startServer(cfg, application)
+	debugServer := startDebugServer(cfg)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	shutdown(db, viewsRecorder, httpServer, debugServer)
 }
 
 func newConfig(configsDir string) *config.Config {
@@ -33,47 +61,343 @@ func newConfig(configsDir string) *config.Config {
 	return cfg
 }
 
-func newMongoDatabase(cfg *config.Config) *mongo.Database {
+// database keeps courses, large test data and view events,
+// both command and query sides of application use it.
+type database struct {
+	courses  coursesRepository
+	testData testDataStorage
+	views    viewsStorage
+	close    func(ctx context.Context) error
+}
+
+type coursesRepository interface {
+	AddCourse(ctx context.Context, crs *course.Course) error
+	GetCourse(ctx context.Context, courseID string) (*course.Course, error)
+	UpdateCourse(ctx context.Context, courseID string, updateFn command.UpdateFunction) error
+	GetTaskVersion(ctx context.Context, courseID string, taskNumber, version int) (course.TaskVersion, error)
+	FindInvitationCourseID(ctx context.Context, code string) (string, error)
+	FindAcademicCourseIDs(ctx context.Context, academicID string) ([]string, error)
+
+	FindCourse(ctx context.Context, academic course.Academic, courseID string) (app.CommonCourse, error)
+	FindAllCourses(
+		ctx context.Context,
+		academic course.Academic,
+		params query.CoursesFilterParams,
+	) ([]app.CommonCourse, error)
+	FindTask(ctx context.Context, academic course.Academic, courseID string, taskNumber int) (app.SpecificTask, error)
+	FindSampleTestDataIDs(ctx context.Context, academic course.Academic, courseID string) ([]string, error)
+	FindTaskVersions(
+		ctx context.Context,
+		academic course.Academic, courseID string, taskNumber int,
+	) ([]app.GeneralTaskVersion, error)
+	FindTaskVersion(
+		ctx context.Context,
+		academic course.Academic, courseID string, taskNumber, version int,
+	) (app.TaskVersion, error)
+	FindAllTasks(
+		ctx context.Context,
+		academic course.Academic, courseID string,
+		filterParams query.TasksFilterParams,
+	) ([]app.GeneralTask, error)
+	FindAllAuxiliaryMaterials(
+		ctx context.Context,
+		academic course.Academic, courseID string,
+		filterParams query.AuxiliaryMaterialsFilterParams,
+	) ([]app.AuxiliaryMaterial, error)
+	FindAuxiliaryMaterial(
+		ctx context.Context,
+		academic course.Academic, courseID, materialID string,
+	) (app.AuxiliaryMaterial, error)
+	FindCourseAcademics(ctx context.Context, academic course.Academic, courseID string) (app.CourseAcademics, error)
+	FindCourseContent(ctx context.Context, academic course.Academic, courseID string) (app.CourseContent, error)
+	FindCourseInvitations(ctx context.Context, academic course.Academic, courseID string) ([]app.Invitation, error)
+	FindEnrollmentRequests(
+		ctx context.Context,
+		academic course.Academic, courseID string,
+		status course.EnrollmentRequestStatus,
+	) ([]app.EnrollmentRequest, error)
+	FindCourseTeams(ctx context.Context, academic course.Academic, courseID string) (app.Teams, error)
+	FindCourseWaitlist(ctx context.Context, academic course.Academic, courseID string) (app.Waitlist, error)
+}
+
+type testDataStorage interface {
+	SaveTestData(ctx context.Context, courseID string, data io.Reader) (string, error)
+	TestDataExists(ctx context.Context, courseID, testDataID string) error
+	ShareTestData(ctx context.Context, fromCourseID, toCourseID string, testDataIDs []string) error
+	OpenTestData(ctx context.Context, courseID, testDataID string) (io.ReadCloser, error)
+}
+
+type viewsStorage interface {
+	AppendViews(ctx context.Context, events []app.ViewEvent) error
+	FindCourseViewers(ctx context.Context, courseID string) ([]app.ItemViewers, error)
+}
+
+func newDatabase(cfg *config.Config) database {
+	switch cfg.Database {
+	case config.MongoDatabase:
+		return newMongoDatabase(cfg)
+	case config.MemoryDatabase:
+		logrus.Warn("Memory database is used, courses are lost when service stops")
+
+		return database{
+			courses:  memoryrepo.NewCoursesRepository(),
+			testData: memorystorage.NewTestDataStorage(),
+			views:    memorystorage.NewViewsStorage(),
+			close: func(context.Context) error {
+				return nil
+			},
+		}
+	}
+
+	logrus.WithField("database", cfg.Database).Fatal("Unknown database")
+
+	return database{}
+}
+
+func newMongoDatabase(cfg *config.Config) database {
 	client, err := mongodb.NewClient(cfg.Mongo.URI, cfg.Mongo.Username, cfg.Mongo.Password)
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to connect to MongoDB")
 	}
 
-	return client.Database(cfg.Mongo.DatabaseName)
+	db := client.Database(cfg.Mongo.DatabaseName)
+
+	return database{
+		courses:  mongorepo.NewCoursesRepository(db),
+		testData: mongostorage.NewTestDataStorage(db),
+		views:    mongostorage.NewViewsStorage(db),
+		close:    client.Disconnect,
+	}
 }
 
-func newApplication(db *mongo.Database) app.Application {
-	coursesRepository := mongorepo.NewCoursesRepository(db)
-	academicsService := mock.NewAcademicsService(
-		[]string{"d3e2490f-5944-4a87-b29a-94177d1caaed", "4edefb83-4b6b-479d-9ce2-60cd465630b6"},
-		[]string{"798155cb-91b7-41d4-9f91-a1970339707e"},
-		[]string{"95dca190-f307-4954-8700-f992f8c12a86"},
+func newApplication(cfg *config.Config, db database, viewsRecorder *tracking.ViewsRecorder) app.Application {
+	coursesRepository := db.courses
+	testDataStorage := db.testData
+	blobStorage := newBlobStorage(cfg)
+	languageRegistry := registry.NewLanguageRegistry(cfg.Languages)
+	descriptionRenderer := markdown.NewRenderer()
+	viewsStorage := db.views
+	addTaskHandler := command.NewAddTaskHandler(
+		coursesRepository, testDataStorage, languageRegistry,
+		cfg.Tasks.DescriptionMaxLen,
 	)
+	editTaskHandler := command.NewEditTaskHandler(
+		coursesRepository, testDataStorage, languageRegistry,
+		cfg.Tasks.DescriptionMaxLen,
+	)
+	academicsService := newAcademicsService(cfg)
 
 	return app.Application{
 		Commands: app.Commands{
 			CreateCourse:       command.NewCreateCourseHandler(coursesRepository),
-			ExtendCourse:       command.NewExtendCourseHandler(coursesRepository),
+			ExtendCourse:       command.NewExtendCourseHandler(coursesRepository, testDataStorage),
 			AddCollaborator:    command.NewAddCollaboratorHandler(coursesRepository, academicsService),
 			RemoveCollaborator: command.NewRemoveCollaboratorHandler(coursesRepository),
+			ChangeRole:         command.NewChangeCollaboratorRoleHandler(coursesRepository),
 			AddStudent:         command.NewAddStudentHandler(coursesRepository, academicsService),
 			RemoveStudent:      command.NewRemoveStudentHandler(coursesRepository),
-			AddTask:            command.NewAddTaskHandler(coursesRepository),
+			CompleteStudent:    command.NewCompleteStudentHandler(coursesRepository),
+			AddGroup:           command.NewAddGroupHandler(coursesRepository, academicsService),
+			RemoveGroup:        command.NewRemoveGroupHandler(coursesRepository),
+			SyncGroup:          command.NewSyncGroupHandler(coursesRepository, academicsService),
+			EnrollStudents: command.NewEnrollStudentsHandler(
+				coursesRepository, academicsService,
+				cfg.Enrollment.Concurrency, cfg.Enrollment.MaxRows,
+			),
+			CreateInvitation:   command.NewCreateInvitationHandler(coursesRepository),
+			RevokeInvitation:   command.NewRevokeInvitationHandler(coursesRepository),
+			RedeemInvitation:   command.NewRedeemInvitationHandler(coursesRepository),
+			RequestEnrollment:  command.NewRequestEnrollmentHandler(coursesRepository),
+			ApproveEnrollment:  command.NewApproveEnrollmentRequestsHandler(coursesRepository),
+			RejectEnrollment:   command.NewRejectEnrollmentRequestsHandler(coursesRepository),
+			AddTask:            addTaskHandler,
+			EditTask:           editTaskHandler,
+			RestoreTaskVersion: command.NewRestoreTaskVersionHandler(coursesRepository),
+			UploadTestData:     command.NewUploadTestDataHandler(coursesRepository, testDataStorage),
+
+			AttachAuxiliaryMaterial:   command.NewAttachAuxiliaryMaterialHandler(coursesRepository),
+			EditAuxiliaryMaterial:     command.NewEditAuxiliaryMaterialHandler(coursesRepository),
+			RemoveAuxiliaryMaterial:   command.NewRemoveAuxiliaryMaterialHandler(coursesRepository),
+			ReorderAuxiliaryMaterials: command.NewReorderAuxiliaryMaterialsHandler(coursesRepository),
+			UploadAuxiliaryMaterial: command.NewUploadAuxiliaryMaterialHandler(
+				coursesRepository, blobStorage,
+				cfg.Materials.FileMaxSize,
+			),
+
+			ChangeTeamSettings: command.NewChangeTeamSettingsHandler(coursesRepository),
+			CreateTeam:         command.NewCreateTeamHandler(coursesRepository),
+			RenameTeam:         command.NewRenameTeamHandler(coursesRepository),
+			RemoveTeam:         command.NewRemoveTeamHandler(coursesRepository),
+			AddTeamMembers:     command.NewAddTeamMembersHandler(coursesRepository),
+			RemoveTeamMember:   command.NewRemoveTeamMemberHandler(coursesRepository),
+
+			ChangeCapacity:     command.NewChangeCourseCapacityHandler(coursesRepository),
+			RemoveFromWaitlist: command.NewRemoveFromWaitlistHandler(coursesRepository),
+
+			DeactivateAcademic: command.NewDeactivateAcademicHandler(coursesRepository, academicsService),
 		},
 		Queries: app.Queries{
-			SpecificCourse: query.NewSpecificCourseHandler(coursesRepository),
-			AllCourses:     query.NewAllCoursesHandler(coursesRepository),
-			SpecificTask:   query.NewSpecificTaskHandler(coursesRepository),
-			AllTasks:       query.NewAllTasksHandler(coursesRepository),
+			SpecificCourse:      query.NewSpecificCourseHandler(coursesRepository),
+			AllCourses:          query.NewAllCoursesHandler(coursesRepository),
+			SpecificTask:        query.NewSpecificTaskHandler(coursesRepository, descriptionRenderer, viewsRecorder),
+			AllTasks:            query.NewAllTasksHandler(coursesRepository, descriptionRenderer),
+			TaskVersions:        query.NewTaskVersionsHandler(coursesRepository),
+			SpecificTaskVersion: query.NewSpecificTaskVersionHandler(coursesRepository, descriptionRenderer),
+			TaskVersionsDiff:    query.NewTaskVersionsDiffHandler(coursesRepository, descriptionRenderer),
+			StoredTestData:      query.NewStoredTestDataHandler(coursesRepository, testDataStorage),
+
+			AllAuxiliaryMaterials:     query.NewAllAuxiliaryMaterialsHandler(coursesRepository),
+			SpecificAuxiliaryMaterial: query.NewSpecificAuxiliaryMaterialHandler(coursesRepository, viewsRecorder),
+			AuxiliaryMaterialFile: query.NewAuxiliaryMaterialFileHandler(
+				coursesRepository, blobStorage, viewsRecorder,
+			),
+
+			AllCourseStudents:      query.NewAllCourseStudentsHandler(coursesRepository, academicsService),
+			AllCourseCollaborators: query.NewAllCourseCollaboratorsHandler(coursesRepository, academicsService),
+
+			CourseViewsReport: query.NewCourseViewsReportHandler(coursesRepository, viewsStorage),
+			AllInvitations:    query.NewAllInvitationsHandler(coursesRepository),
+
+			EnrollmentRequests: query.NewEnrollmentRequestsHandler(coursesRepository),
+
+			AllTeams: query.NewAllTeamsHandler(coursesRepository),
+
+			CourseWaitlist: query.NewCourseWaitlistHandler(coursesRepository),
 		},
 	}
 }
 
-func startServer(cfg *config.Config, application app.Application) {
+// blobStorage is storage of uploaded files, both
+// command and query sides of application use it.
+type blobStorage interface {
+	SaveBlob(ctx context.Context, data io.Reader) (app.Blob, error)
+	OpenBlob(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+func newBlobStorage(cfg *config.Config) blobStorage {
+	switch cfg.Materials.Storage {
+	case config.LocalStorage:
+		return localstorage.NewBlobStorage(cfg.Materials.LocalDir)
+	case config.S3Storage:
+		return s3storage.NewBlobStorage(cfg.Materials.S3, &nethttp.Client{})
+	}
+
+	logrus.WithField("storage", cfg.Materials.Storage).Fatal("Unknown materials storage")
+
+	return nil
+}
+
+// academicsService is directory of teachers, students and groups,
+// both command and query sides of application use it.
+type academicsService interface {
+	TeacherExists(ctx context.Context, teacherID string) error
+	StudentExists(ctx context.Context, studentID string) error
+	MissingTeachers(ctx context.Context, teacherIDs []string) ([]string, error)
+	MissingStudents(ctx context.Context, studentIDs []string) ([]string, error)
+	StudentByEmail(ctx context.Context, email string) (string, error)
+	GroupExists(ctx context.Context, groupID string) error
+	GroupStudents(ctx context.Context, groupID string) ([]string, error)
+	AcademicProfiles(ctx context.Context, academicIDs []string) ([]app.AcademicProfile, error)
+	InvalidateAcademic(academicID string)
+}
+
+func newAcademicsService(cfg *config.Config) academicsService {
+	switch cfg.Academics.Source {
+	case config.RosterAcademics:
+		return newRosterAcademicsService(cfg)
+	case config.GRPCAcademics:
+		return newGRPCAcademicsService(cfg)
+	}
+
+	logrus.WithField("source", cfg.Academics.Source).Fatal("Unknown academics source")
+
+	return nil
+}
+
+func newRosterAcademicsService(cfg *config.Config) academicsService {
+	service, err := academics.NewRosterService(cfg.Academics.Roster.File, cfg.Academics.Roster.ReloadInterval)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to load academics roster")
+	}
+
+	return service
+}
+
+func newGRPCAcademicsService(cfg *config.Config) academicsService {
+	conn, err := academics.Dial(cfg.Academics)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to connect to academics service")
+	}
+
+	service := academics.NewResilientService(
+		academics.NewGRPCService(pb.NewAcademicsServiceClient(conn), cfg.Academics.RequestTimeout),
+		cfg.Academics.Resilience, academics.SystemClock{},
+	)
+	expvar.Publish("academicsService", expvar.Func(func() interface{} {
+		return service.Stats()
+	}))
+
+	return service
+}
+
+func startServer(cfg *config.Config, application app.Application) *server.Server {
 	logrus.Info(fmt.Sprintf("Starting HTTP server on address :%s", cfg.HTTP.Port))
 
-	httpServer := server.New(cfg, http.NewHandler(application))
-	err := httpServer.Run()
+	if cfg.Academics.WebhookSecret == "" {
+		logrus.Warn("Academics webhook secret is empty, deactivated academics aren't removed from courses")
+	}
+
+	httpServer := server.New(cfg, http.NewHandler(application, cfg.Academics.WebhookSecret))
+
+	go func() {
+		if err := httpServer.Run(); !errors.Is(err, nethttp.ErrServerClosed) {
+			logrus.WithError(err).Fatal("HTTP server stopped")
+		}
+	}()
+
+	return httpServer
+}
+
+// startDebugServer returns nil when debug address is empty.
+func startDebugServer(cfg *config.Config) *server.Server {
+	if cfg.HTTP.DebugAddress == "" {
+		return nil
+	}
+
+	logrus.Info(fmt.Sprintf("Starting debug HTTP server on address %s", cfg.HTTP.DebugAddress))
+
+	debugServer := server.NewDebug(cfg)
 
-	logrus.WithError(err).Fatal("HTTP server stopped")
+	go func() {
+		if err := debugServer.Run(); !errors.Is(err, nethttp.ErrServerClosed) {
+			logrus.WithError(err).Error("Debug HTTP server stopped")
+		}
+	}()
+
+	return debugServer
+}
+
+// shutdown stops servers before views recorder, so no views are recorded
+// after it's closed, and closes database last, recorder appends buffered views to it.
+func shutdown(db database, viewsRecorder *tracking.ViewsRecorder, httpServer, debugServer *server.Server) {
+	logrus.Info("Shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		logrus.WithError(err).Error("Failed to shut down HTTP server")
+	}
+
+	if debugServer != nil {
+		if err := debugServer.Shutdown(ctx); err != nil {
+			logrus.WithError(err).Error("Failed to shut down debug HTTP server")
+		}
+	}
+
+	viewsRecorder.Close()
+
+	if err := db.close(ctx); err != nil {
+		logrus.WithError(err).Error("Failed to close database")
+	}
 }
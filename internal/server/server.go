@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"expvar"
 	"net/http"
 
 	"github.com/authena-ru/courses-organization/internal/config"
@@ -21,6 +23,28 @@ func New(cfg *config.Config, handler http.Handler) *Server {
 	}
 }
 
+// NewDebug returns server of expvar metrics on internal debug
+// address, it's separate from API server that is exposed.
+func NewDebug(cfg *config.Config) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return &Server{
+		standardServer: &http.Server{
+			Addr:         cfg.HTTP.DebugAddress,
+			Handler:      mux,
+			ReadTimeout:  cfg.HTTP.ReadTimeout,
+			WriteTimeout: cfg.HTTP.WriteTimeout,
+		},
+	}
+}
+
 func (s *Server) Run() error {
 	return s.standardServer.ListenAndServe()
 }
+
+// Shutdown stops accepting connections and waits for active
+// requests, Run returns http.ErrServerClosed after it.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.standardServer.Shutdown(ctx)
+}
@@ -24,6 +24,14 @@ func NotFound(slug string, err error, w http.ResponseWriter, r *http.Request) {
 	httpRespondWithError(err, slug, w, r, "Not Found", http.StatusNotFound)
 }
 
+func RequestEntityTooLarge(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Request Entity Too Large", http.StatusRequestEntityTooLarge)
+}
+
+func UnsupportedMediaType(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Unsupported Media Type", http.StatusUnsupportedMediaType)
+}
+
 func UnprocessableEntity(slug string, err error, w http.ResponseWriter, r *http.Request) {
 	httpRespondWithError(err, slug, w, r, "Unprocessable Entity", http.StatusUnprocessableEntity)
 }
@@ -0,0 +1,122 @@
+// Package textdiff computes line based differences of texts.
+package textdiff
+
+import "strings"
+
+type Op uint8
+
+const (
+	Equal Op = iota + 1
+	Insert
+	Delete
+)
+
+func (o Op) String() string {
+	switch o {
+	case Equal:
+		return "equal"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+
+	return "unknown"
+}
+
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxTableSize bounds memory used for longest common subsequence,
+// changed parts of larger texts are reported as replaced entirely.
+const maxTableSize = 4 << 20
+
+// Lines returns shortest line edit script that turns old text into new one.
+func Lines(oldText, newText string) []Line {
+	oldLines, newLines := splitLines(oldText), splitLines(newText)
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]Line, 0, len(oldLines)+len(newLines))
+	diff = appendLines(diff, Equal, oldLines[:prefix])
+	diff = append(diff, middle(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	diff = appendLines(diff, Equal, oldLines[len(oldLines)-suffix:])
+
+	return diff
+}
+
+func middle(oldLines, newLines []string) []Line {
+	diff := make([]Line, 0, len(oldLines)+len(newLines))
+
+	if (len(oldLines)+1)*(len(newLines)+1) > maxTableSize {
+		diff = appendLines(diff, Delete, oldLines)
+
+		return appendLines(diff, Insert, newLines)
+	}
+
+	// lcs[i][j] is length of common subsequence of oldLines[i:] and newLines[j:].
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			switch {
+			case oldLines[i] == newLines[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(oldLines) && j < len(newLines) {
+		switch {
+		case oldLines[i] == newLines[j]:
+			diff = append(diff, Line{Op: Equal, Text: oldLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, Line{Op: Delete, Text: oldLines[i]})
+			i++
+		default:
+			diff = append(diff, Line{Op: Insert, Text: newLines[j]})
+			j++
+		}
+	}
+
+	diff = appendLines(diff, Delete, oldLines[i:])
+
+	return appendLines(diff, Insert, newLines[j:])
+}
+
+func appendLines(diff []Line, op Op, lines []string) []Line {
+	for _, l := range lines {
+		diff = append(diff, Line{Op: op, Text: l})
+	}
+
+	return diff
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
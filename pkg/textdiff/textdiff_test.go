@@ -0,0 +1,68 @@
+package textdiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authena-ru/courses-organization/pkg/textdiff"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		OldText  string
+		NewText  string
+		Expected []textdiff.Line
+	}{
+		{
+			Name:     "equal_texts",
+			OldText:  "a\nb",
+			NewText:  "a\nb\n",
+			Expected: []textdiff.Line{{Op: textdiff.Equal, Text: "a"}, {Op: textdiff.Equal, Text: "b"}},
+		},
+		{
+			Name:     "empty_texts",
+			Expected: []textdiff.Line{},
+		},
+		{
+			Name:    "replaced_middle_line",
+			OldText: "a\nb\nc",
+			NewText: "a\nx\nc",
+			Expected: []textdiff.Line{
+				{Op: textdiff.Equal, Text: "a"},
+				{Op: textdiff.Delete, Text: "b"},
+				{Op: textdiff.Insert, Text: "x"},
+				{Op: textdiff.Equal, Text: "c"},
+			},
+		},
+		{
+			Name:    "inserted_and_deleted_lines",
+			OldText: "a\nb\nc\nd",
+			NewText: "b\nc\ne\nd",
+			Expected: []textdiff.Line{
+				{Op: textdiff.Delete, Text: "a"},
+				{Op: textdiff.Equal, Text: "b"},
+				{Op: textdiff.Equal, Text: "c"},
+				{Op: textdiff.Insert, Text: "e"},
+				{Op: textdiff.Equal, Text: "d"},
+			},
+		},
+		{
+			Name:     "text_added",
+			NewText:  "a",
+			Expected: []textdiff.Line{{Op: textdiff.Insert, Text: "a"}},
+		},
+	}
+
+	for i := range testCases {
+		c := testCases[i]
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, c.Expected, textdiff.Lines(c.OldText, c.NewText))
+		})
+	}
+}